@@ -40,6 +40,7 @@ import (
 	"go/build"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -94,7 +95,8 @@ type Extractor struct {
 	// context's GOROOT or GOPATH or the current working directory.
 	DirToImport func(path string) (string, error)
 
-	pmap map[string]*build.Package // Map of import path to build package
+	pmap map[string]*build.Package  // Map of import path to build package
+	mmap map[string]*govname.Module // Map of import path to module, if known
 }
 
 // addPackage imports the specified package, if it has not already been
@@ -119,6 +121,16 @@ func (e *Extractor) mapPackage(importPath string, bp *build.Package) {
 	}
 }
 
+func (e *Extractor) mapModule(importPath string, mod *govname.Module) {
+	if mod == nil {
+		return
+	} else if e.mmap == nil {
+		e.mmap = map[string]*govname.Module{importPath: mod}
+	} else {
+		e.mmap[importPath] = mod
+	}
+}
+
 // findPackages returns the first *Package value in Packages having the given
 // import path, or nil if none is found.
 func (e *Extractor) findPackage(importPath string) *Package {
@@ -132,11 +144,42 @@ func (e *Extractor) findPackage(importPath string) *Package {
 
 // vnameFor returns a vname for the specified package.
 func (e *Extractor) vnameFor(bp *build.Package) *spb.VName {
-	v := govname.ForPackage(bp, &e.PackageVNameOptions)
+	v := govname.ForModulePackage(bp, e.mmap[bp.ImportPath], &e.PackageVNameOptions)
 	v.Signature = "" // not useful in this context
 	return v
 }
 
+// packageInfo returns a GoPackageInfo details message for the package bp if
+// its VName v does not determine its import path or if its module is known.
+// Otherwise, it returns nil.
+func (e *Extractor) packageInfo(bp *build.Package, v *spb.VName) *anypb.Any {
+	mod := e.mmap[bp.ImportPath]
+	if mod == nil && govname.ImportPath(v, e.BuildContext.GOROOT) == bp.ImportPath {
+		return nil
+	}
+	info, err := ptypes.MarshalAny(&gopb.GoPackageInfo{
+		ImportPath: bp.ImportPath,
+		Module:     moduleProto(mod),
+	})
+	if err != nil {
+		log.Printf("WARNING: failed to marshal GoPackageInfo: %v", err)
+		return nil
+	}
+	return info
+}
+
+func moduleProto(mod *govname.Module) *gopb.GoModule {
+	if mod == nil {
+		return nil
+	}
+	return &gopb.GoModule{
+		Path:    mod.Path,
+		Version: mod.Version,
+		Main:    mod.Main,
+		Replace: moduleProto(mod.Replace),
+	}
+}
+
 // dirToImport converts a directory name to an import path, if possible.
 func (e *Extractor) dirToImport(dir string) (string, error) {
 	if conv := e.DirToImport; conv != nil {
@@ -179,9 +222,11 @@ func (e *Extractor) Locate(importPath string) ([]*Package, error) {
 				Path:         importPath,
 				DepOnly:      pkg.DepOnly,
 				BuildPackage: pkg.buildPackage(),
+				Module:       pkg.Module.module(),
 			}
 			e.Packages = append(e.Packages, p)
 			e.mapPackage(importPath, p.BuildPackage)
+			e.mapModule(importPath, p.Module)
 		}
 		if !pkg.DepOnly {
 			pkgs = append(pkgs, p)
@@ -243,6 +288,7 @@ type Package struct {
 	DepOnly      bool                   // Whether the package is only seen as a dependency
 	Err          error                  // Error discovered during processing
 	BuildPackage *build.Package         // Package info from the go/build library
+	Module       *govname.Module        // The package's module, if built in module mode
 	VName        *spb.VName             // The package's Kythe vname
 	Units        []*apb.CompilationUnit // Compilations generated from Package
 }
@@ -255,7 +301,9 @@ type Package struct {
 // by the Store method.
 func (p *Package) Extract() error {
 	p.VName = p.ext.vnameFor(p.BuildPackage)
-	if r, err := govname.RepoRoot(p.Path); err == nil {
+	if p.Module != nil {
+		p.CorpusRoot = p.Module.Path
+	} else if r, err := govname.RepoRoot(p.Path); err == nil {
 		p.CorpusRoot = r.Root
 	} else {
 		p.CorpusRoot = p.VName.GetCorpus()
@@ -276,15 +324,10 @@ func (p *Package) Extract() error {
 		cu.Details = append(cu.Details, info)
 	}
 
-	if govname.ImportPath(cu.VName, bc.GOROOT) != p.Path {
-		// Add GoPackageInfo if constructed VName differs from actual ImportPath.
-		if info, err := ptypes.MarshalAny(&gopb.GoPackageInfo{
-			ImportPath: p.Path,
-		}); err == nil {
-			cu.Details = append(cu.Details, info)
-		} else {
-			log.Printf("WARNING: failed to marshal GoPackageInfo for CompilationUnit: %v", err)
-		}
+	// Add GoPackageInfo if constructed VName differs from actual ImportPath or
+	// to record the package's module.
+	if info := p.ext.packageInfo(p.BuildPackage, cu.VName); info != nil {
+		cu.Details = append(cu.Details, info)
 	}
 
 	// Add required inputs from this package (source files of various kinds).
@@ -418,7 +461,12 @@ func (p *Package) addFiles(cu *apb.CompilationUnit, root, base string, names []s
 			}
 		}
 
-		if vn.Corpus == "" {
+		if vn.Corpus == "" && p.Module != nil {
+			// In module mode, the package's corpus is its module path and each
+			// file's path is relative to the module's root directory.
+			vn.Corpus = p.VName.Corpus
+			vn.Path = p.modulePath(path)
+		} else if vn.Corpus == "" {
 			// If no default corpus is specified, use the package's corpus for each of
 			// its files.  The package corpus is based on the rules in
 			// kythe/go/extractors/govname and is usually the package's
@@ -441,6 +489,20 @@ func (p *Package) addFiles(cu *apb.CompilationUnit, root, base string, names []s
 	}
 }
 
+// modulePath returns the VName path of the named file of p, which must be in
+// module mode: the file's path relative to the module's root directory.  A
+// file outside of the module's directory (e.g. one generated by cgo into the
+// build cache) is placed in the package's directory within the module.
+func (p *Package) modulePath(file string) string {
+	if dir := p.Module.SourceDir(); dir != "" {
+		rel, err := filepath.Rel(dir, file)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(rel)
+		}
+	}
+	return path.Join(p.VName.GetPath(), filepath.Base(file))
+}
+
 // addSource acts as addFiles, and in addition marks each trimmed path as a
 // source input for the compilation.
 func (p *Package) addSource(cu *apb.CompilationUnit, root, base string, names []string) {
@@ -464,15 +526,10 @@ func (p *Package) addInput(cu *apb.CompilationUnit, bp *build.Package) {
 		// any) is no longer valid.
 		fi.Details = nil
 
-		if govname.ImportPath(fi.VName, p.ext.BuildContext.GOROOT) != bp.ImportPath {
-			// Add GoPackageInfo if constructed VName differs from actual ImportPath.
-			if info, err := ptypes.MarshalAny(&gopb.GoPackageInfo{
-				ImportPath: bp.ImportPath,
-			}); err == nil {
-				fi.Details = append(fi.Details, info)
-			} else {
-				log.Printf("WARNING: failed to marshal GoPackageInfo for input: %v", err)
-			}
+		// Add GoPackageInfo if constructed VName differs from actual ImportPath or
+		// to record the package's module.
+		if info := p.ext.packageInfo(bp, fi.VName); info != nil {
+			fi.Details = append(fi.Details, info)
 		}
 	}
 }
@@ -0,0 +1,170 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package golang
+
+import (
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"kythe.io/kythe/go/extractors/govname"
+	"kythe.io/kythe/go/util/compare"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// setenv sets the environment variable key to value and returns a function
+// that restores its previous setting.
+func setenv(t *testing.T, key, value string) (restore func()) {
+	t.Helper()
+	old, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		t.Fatal(err)
+	}
+	return func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	}
+}
+
+// writeFiles writes each of the given files, relative to dir.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestModuleVNames(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go tool not available")
+	}
+	dir, err := ioutil.TempDir("", "module")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFiles(t, dir, map[string]string{
+		"go.mod":        "module example.com/mod\n\ngo 1.13\n",
+		"a.go":          "package mod\n\nimport _ \"example.com/mod/sub\"\n",
+		"sub/b.go":      "package sub\n",
+		"sub/b_test.go": "package sub\n",
+	})
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	defer setenv(t, "GO111MODULE", "on")()
+	defer setenv(t, "GOFLAGS", "-mod=mod")()
+	defer setenv(t, "GOPACKAGESDRIVER", "off")()
+
+	e := &Extractor{BuildContext: build.Default}
+	pkgs, err := e.Locate("./...")
+	if err != nil {
+		t.Fatalf("Locate error: %v", err)
+	}
+	if len(pkgs) != 2 {
+		t.Fatalf("Locate returned %d packages; want 2", len(pkgs))
+	}
+	if err := e.Extract(); err != nil {
+		t.Fatalf("Extract error: %v", err)
+	}
+
+	type result struct {
+		VName  *spb.VName
+		Inputs []*spb.VName
+	}
+	found := make(map[string]result)
+	for _, p := range pkgs {
+		r := result{VName: p.VName}
+		for _, ri := range p.Units[0].RequiredInput {
+			if filepath.Ext(ri.Info.Path) != ".go" {
+				continue // skip dependency export data
+			}
+			r.Inputs = append(r.Inputs, ri.VName)
+		}
+		found[p.Path] = r
+	}
+	pkgVName := func(path string) *spb.VName {
+		return &spb.VName{Corpus: "example.com/mod", Path: path, Language: govname.Language}
+	}
+	expected := map[string]result{
+		"example.com/mod": {
+			VName:  pkgVName(""),
+			Inputs: []*spb.VName{{Corpus: "example.com/mod", Path: "a.go"}},
+		},
+		"example.com/mod/sub": {
+			VName: pkgVName("sub"),
+			Inputs: []*spb.VName{
+				{Corpus: "example.com/mod", Path: "sub/b.go"},
+				{Corpus: "example.com/mod", Path: "sub/b_test.go"},
+			},
+		},
+	}
+	if diff := compare.ProtoDiff(expected, found); diff != "" {
+		t.Errorf("Unexpected VNames: (- expected; + found)\n%s", diff)
+	}
+}
+
+func TestModulePath(t *testing.T) {
+	p := &Package{
+		ext:    &Extractor{},
+		Module: &govname.Module{Path: "example.com/mod", Dir: "/src/mod"},
+		VName:  &spb.VName{Corpus: "example.com/mod", Path: "sub"},
+	}
+	tests := []struct {
+		file, want string
+	}{
+		{"/src/mod/sub/a.go", "sub/a.go"},
+		{"/src/mod/..gen/a.go", "..gen/a.go"},
+		{"/cache/f0/_cgo_gotypes.go", "sub/_cgo_gotypes.go"}, // outside of the module
+		{"/src/module/sub/a.go", "sub/a.go"},
+		{"relative/a.go", "sub/a.go"}, // filepath.Rel fails
+	}
+	for _, test := range tests {
+		if got := p.modulePath(test.file); got != test.want {
+			t.Errorf("modulePath(%q): got %q; want %q", test.file, got, test.want)
+		}
+	}
+
+	// Without a known module directory, each file is placed in its package.
+	p.Module = &govname.Module{Path: "example.com/mod"}
+	var cu apb.CompilationUnit
+	p.addFiles(&cu, "/", "/some/where", []string{"a.go"})
+	expected := &spb.VName{Corpus: "example.com/mod", Path: "sub/a.go"}
+	if diff := compare.ProtoDiff(expected, cu.RequiredInput[0].VName); diff != "" {
+		t.Errorf("Unexpected input VName: (- expected; + found)\n%s", diff)
+	}
+}
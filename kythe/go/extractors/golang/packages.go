@@ -19,12 +19,16 @@ package golang
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"go/build"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+
+	"kythe.io/kythe/go/extractors/govname"
 )
 
 // Fields must match go list;
@@ -67,9 +71,34 @@ type jsonPackage struct {
 	ForTest string // q in a "p [q.test]" package, else ""
 	DepOnly bool
 
+	Module *jsonModule
+
 	Error *jsonPackageError
 }
 
+// Fields must match go list;
+// see $GOROOT/src/cmd/go/internal/modinfo/info.go.
+type jsonModule struct {
+	Path    string
+	Version string
+	Main    bool
+	Dir     string
+	Replace *jsonModule
+}
+
+func (m *jsonModule) module() *govname.Module {
+	if m == nil {
+		return nil
+	}
+	return &govname.Module{
+		Path:    m.Path,
+		Version: m.Version,
+		Main:    m.Main,
+		Dir:     m.Dir,
+		Replace: m.Replace.module(),
+	}
+}
+
 func (pkg *jsonPackage) buildPackage() *build.Package {
 	bp := &build.Package{
 		Dir:        pkg.Dir,
@@ -137,17 +166,37 @@ func buildContextEnv(bc build.Context) ([]string, error) {
 		"GOPATH": bc.GOPATH,
 	}
 	for name, path := range envPaths {
-		abs, err := filepath.Abs(path)
-		if err != nil {
-			return nil, fmt.Errorf("error finding absolute path for %q: %v", path, err)
+		if path == "" {
+			// Leave the variable to the environment (or the go tool's default)
+			// rather than forcing it to the working directory.
+			continue
 		}
-		vars = append(vars, fmt.Sprintf("%s=%s", name, abs))
+		var abs []string
+		for _, dir := range filepath.SplitList(path) {
+			a, err := filepath.Abs(dir)
+			if err != nil {
+				return nil, fmt.Errorf("error finding absolute path for %q: %v", dir, err)
+			}
+			abs = append(abs, a)
+		}
+		vars = append(vars, fmt.Sprintf("%s=%s", name, strings.Join(abs, string(filepath.ListSeparator))))
 	}
 	return vars, nil
 }
 
 func (e *Extractor) listPackages(query ...string) ([]*jsonPackage, error) {
-	// TODO(schroederc): support GOPACKAGESDRIVER
+	env, err := buildContextEnv(e.BuildContext)
+	if err != nil {
+		return nil, err
+	}
+	env = append(os.Environ(), env...)
+	if driver := findPackagesDriver(env); driver != "" {
+		pkgs, err := e.listDriverPackages(driver, env, query...)
+		if err != errNotHandled {
+			return pkgs, err
+		}
+	}
+
 	args := append([]string{"list",
 		"-compiler=" + e.BuildContext.Compiler,
 		"-tags=" + strings.Join(e.BuildContext.BuildTags, " "),
@@ -164,11 +213,7 @@ func (e *Extractor) listPackages(query ...string) ([]*jsonPackage, error) {
 		goTool = filepath.Join(e.BuildContext.GOROOT, "bin/go")
 	}
 	cmd := exec.Command(goTool, args...)
-	env, err := buildContextEnv(e.BuildContext)
-	if err != nil {
-		return nil, err
-	}
-	cmd.Env = append(os.Environ(), env...)
+	cmd.Env = env
 	var out bytes.Buffer
 	cmd.Stdout = &out
 	cmd.Stderr = os.Stderr
@@ -184,3 +229,179 @@ func (e *Extractor) listPackages(query ...string) ([]*jsonPackage, error) {
 	}
 	return pkgs, listErr
 }
+
+// errNotHandled is returned by listDriverPackages if the GOPACKAGESDRIVER
+// declines to handle a query, in which case "go list" is used instead.
+var errNotHandled = errors.New("query not handled by GOPACKAGESDRIVER")
+
+// findPackagesDriver returns the path of the external package driver named by
+// GOPACKAGESDRIVER in env.  It returns "" if no driver is named or if the
+// setting is "off"; a "gopackagesdriver" binary in the PATH is not used unless
+// named explicitly.
+func findPackagesDriver(env []string) string {
+	var driver string
+	for _, kv := range env {
+		if v := strings.TrimPrefix(kv, "GOPACKAGESDRIVER="); v != kv {
+			driver = v // the last setting wins, as with exec.Cmd
+		}
+	}
+	if driver == "off" {
+		return ""
+	}
+	return driver
+}
+
+// Load modes requested from a GOPACKAGESDRIVER;
+// see golang.org/x/tools/go/packages.LoadMode.
+const (
+	driverNeedName = 1 << iota
+	driverNeedFiles
+	driverNeedCompiledGoFiles
+	driverNeedImports
+	driverNeedDeps
+	driverNeedExportsFile
+)
+
+// Fields must match golang.org/x/tools/go/packages.driverRequest.
+type driverRequest struct {
+	Mode       int               `json:"mode"`
+	Env        []string          `json:"env"`
+	BuildFlags []string          `json:"build_flags"`
+	Tests      bool              `json:"tests"`
+	Overlay    map[string][]byte `json:"overlay"`
+}
+
+// Fields must match golang.org/x/tools/go/packages.driverResponse.
+type driverResponse struct {
+	NotHandled bool
+	Roots      []string
+	Packages   []*driverPackage
+}
+
+// Fields must match golang.org/x/tools/go/packages.flatPackage.
+type driverPackage struct {
+	ID         string
+	Name       string
+	PkgPath    string
+	Errors     []struct{ Pos, Msg string }
+	GoFiles    []string
+	OtherFiles []string
+	ExportFile string
+	Imports    map[string]string
+}
+
+// listDriverPackages resolves query with the given GOPACKAGESDRIVER, returning
+// the packages as if they had been listed by "go list".  Module information is
+// not available from package drivers.
+func (e *Extractor) listDriverPackages(driver string, env []string, query ...string) ([]*jsonPackage, error) {
+	req, err := json.Marshal(&driverRequest{
+		Mode:       driverNeedName | driverNeedFiles | driverNeedCompiledGoFiles | driverNeedImports | driverNeedDeps | driverNeedExportsFile,
+		Env:        env,
+		BuildFlags: []string{"-tags=" + strings.Join(e.BuildContext.BuildTags, " ")},
+		Tests:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(driver, query...)
+	cmd.Env = env
+	cmd.Stdin = bytes.NewReader(req)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running GOPACKAGESDRIVER %q: %v", driver, err)
+	}
+
+	var resp driverResponse
+	if err := json.Unmarshal(out.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("decoding GOPACKAGESDRIVER response: %v", err)
+	} else if resp.NotHandled {
+		return nil, errNotHandled
+	}
+
+	roots := make(map[string]bool)
+	for _, id := range resp.Roots {
+		roots[id] = true
+	}
+	goroot := e.BuildContext.GOROOT
+	if goroot != "" && !strings.HasSuffix(goroot, string(filepath.Separator)) {
+		goroot += string(filepath.Separator)
+	}
+	var pkgs []*jsonPackage
+	for _, dp := range resp.Packages {
+		pkg := &jsonPackage{
+			ImportPath: dp.PkgPath,
+			Name:       dp.Name,
+			Export:     dp.ExportFile,
+			DepOnly:    !roots[dp.ID],
+		}
+		if i := strings.Index(dp.ID, " ["); i >= 0 {
+			// Test variants have IDs of the form "p [q.test]".
+			pkg.ForTest = strings.TrimSuffix(dp.ID[i+2:], ".test]")
+		}
+		for _, f := range dp.GoFiles {
+			if pkg.Dir == "" {
+				pkg.Dir = filepath.Dir(f)
+			}
+			pkg.GoFiles = append(pkg.GoFiles, filepath.Base(f))
+		}
+		for _, f := range dp.OtherFiles {
+			switch filepath.Ext(f) {
+			case ".c":
+				pkg.CFiles = append(pkg.CFiles, filepath.Base(f))
+			case ".h":
+				pkg.HFiles = append(pkg.HFiles, filepath.Base(f))
+			case ".cc", ".cpp", ".cxx":
+				pkg.CXXFiles = append(pkg.CXXFiles, filepath.Base(f))
+			case ".s":
+				pkg.SFiles = append(pkg.SFiles, filepath.Base(f))
+			}
+		}
+		pkg.Goroot = goroot != "" && strings.HasPrefix(pkg.Dir, goroot)
+		for path := range dp.Imports {
+			pkg.Imports = append(pkg.Imports, path)
+		}
+		sort.Strings(pkg.Imports)
+		if len(dp.Errors) > 0 {
+			pkg.Error = &jsonPackageError{Pos: dp.Errors[0].Pos, Err: dp.Errors[0].Msg}
+		}
+		pkgs = append(pkgs, pkg)
+	}
+
+	// Drivers report in-package tests as a variant of the package under test
+	// rather than as part of it, as "go list" does; fold them back in.
+	byPath := make(map[string]*jsonPackage)
+	for _, pkg := range pkgs {
+		if pkg.ForTest == "" {
+			byPath[pkg.ImportPath] = pkg
+		}
+	}
+	for _, pkg := range pkgs {
+		base := byPath[pkg.ImportPath]
+		if pkg.ForTest != pkg.ImportPath || base == nil {
+			continue
+		}
+		for _, f := range pkg.GoFiles {
+			if !contains(base.GoFiles, f) {
+				base.TestGoFiles = append(base.TestGoFiles, f)
+			}
+		}
+		for _, ip := range pkg.Imports {
+			if !contains(base.Imports, ip) {
+				base.TestImports = append(base.TestImports, ip)
+			}
+		}
+	}
+	return pkgs, nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, t := range ss {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,287 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package golang
+
+import (
+	"encoding/json"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// When fakeDriverEnv is set, the test binary acts as a GOPACKAGESDRIVER: it
+// replies with the JSON driverResponse in fakeDriverEnv after recording its
+// arguments and request to the file named by fakeRequestEnv.
+const (
+	fakeDriverEnv  = "KYTHE_TEST_FAKE_DRIVER_RESPONSE"
+	fakeRequestEnv = "KYTHE_TEST_FAKE_DRIVER_REQUEST"
+)
+
+// fakeDriverCall is the invocation recorded by the fake driver.
+type fakeDriverCall struct {
+	Args    []string
+	Request driverRequest
+}
+
+func TestMain(m *testing.M) {
+	if resp, ok := os.LookupEnv(fakeDriverEnv); ok {
+		os.Exit(runFakeDriver(resp))
+	}
+	os.Exit(m.Run())
+}
+
+func runFakeDriver(resp string) int {
+	call := fakeDriverCall{Args: os.Args[1:]}
+	if err := json.NewDecoder(os.Stdin).Decode(&call.Request); err != nil {
+		os.Stderr.WriteString(err.Error())
+		return 1
+	}
+	rec, err := json.Marshal(call)
+	if err != nil {
+		os.Stderr.WriteString(err.Error())
+		return 1
+	}
+	if err := ioutil.WriteFile(os.Getenv(fakeRequestEnv), rec, 0644); err != nil {
+		os.Stderr.WriteString(err.Error())
+		return 1
+	}
+	os.Stdout.WriteString(resp)
+	return 0
+}
+
+// fakeDriver returns the path of a GOPACKAGESDRIVER that replies with resp,
+// the environment with which to run it, and a function returning the call it
+// received.
+func fakeDriver(t *testing.T, resp *driverResponse) (string, []string, func() fakeDriverCall) {
+	t.Helper()
+	rec, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "driver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqFile := filepath.Join(dir, "request.json")
+	env := append(os.Environ(), fakeDriverEnv+"="+string(rec), fakeRequestEnv+"="+reqFile)
+	return os.Args[0], env, func() fakeDriverCall {
+		defer os.RemoveAll(dir)
+		var call fakeDriverCall
+		rec, err := ioutil.ReadFile(reqFile)
+		if err != nil {
+			t.Fatalf("Reading driver request: %v", err)
+		} else if err := json.Unmarshal(rec, &call); err != nil {
+			t.Fatalf("Decoding driver request: %v", err)
+		}
+		return call
+	}
+}
+
+func TestFindPackagesDriver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "path")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer setenv(t, "PATH", dir)()
+
+	tests := []struct {
+		env  []string
+		want string
+	}{
+		{nil, ""},
+		{[]string{"GOPACKAGESDRIVER=/bin/driver"}, "/bin/driver"},
+		{[]string{"GOPACKAGESDRIVER=/bin/first", "HOME=/", "GOPACKAGESDRIVER=/bin/last"}, "/bin/last"},
+		{[]string{"GOPACKAGESDRIVER=off"}, ""},
+		{[]string{"GOPACKAGESDRIVER=/bin/driver", "GOPACKAGESDRIVER=off"}, ""},
+	}
+	for _, test := range tests {
+		if got := findPackagesDriver(test.env); got != test.want {
+			t.Errorf("findPackagesDriver(%q): got %q; want %q", test.env, got, test.want)
+		}
+	}
+
+	// Without a setting, a gopackagesdriver binary in the PATH is not used.
+	driver := filepath.Join(dir, "gopackagesdriver")
+	if err := ioutil.WriteFile(driver, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if got := findPackagesDriver(nil); got != "" {
+		t.Errorf("findPackagesDriver(nil): got %q; want %q", got, "")
+	}
+}
+
+func TestListDriverPackages(t *testing.T) {
+	goroot := "/goroot"
+	driver, env, driverCall := fakeDriver(t, &driverResponse{
+		Roots: []string{"example.com/a", "example.com/a [example.com/a.test]"},
+		Packages: []*driverPackage{{
+			ID:         "example.com/a",
+			Name:       "a",
+			PkgPath:    "example.com/a",
+			GoFiles:    []string{"/src/a/a.go", "/src/a/cgo.go"},
+			OtherFiles: []string{"/src/a/a.c", "/src/a/a.h", "/src/a/a.cc", "/src/a/a.s", "/src/a/README"},
+			ExportFile: "/cache/a.x",
+			Imports:    map[string]string{"fmt": "fmt", "example.com/b": "example.com/b"},
+		}, {
+			ID:         "example.com/a [example.com/a.test]",
+			Name:       "a",
+			PkgPath:    "example.com/a",
+			GoFiles:    []string{"/src/a/a.go", "/src/a/cgo.go", "/src/a/a_test.go"},
+			ExportFile: "/cache/a_test.x",
+			Imports:    map[string]string{"fmt": "fmt", "example.com/b": "example.com/b", "testing": "testing"},
+		}, {
+			ID:         "example.com/b",
+			Name:       "b",
+			PkgPath:    "example.com/b",
+			GoFiles:    []string{"/src/b/b.go"},
+			ExportFile: "/cache/b.x",
+			Errors:     []struct{ Pos, Msg string }{{"/src/b/b.go:1:1", "bad"}, {"/src/b/b.go:2:1", "worse"}},
+		}, {
+			ID:         "fmt",
+			Name:       "fmt",
+			PkgPath:    "fmt",
+			GoFiles:    []string{filepath.Join(goroot, "src/fmt/print.go")},
+			ExportFile: "/cache/fmt.x",
+		}},
+	})
+
+	e := &Extractor{BuildContext: build.Context{GOROOT: goroot, BuildTags: []string{"x", "y"}}}
+	pkgs, err := e.listDriverPackages(driver, env, "example.com/a", "-flag")
+	if err != nil {
+		t.Fatalf("listDriverPackages error: %v", err)
+	}
+
+	call := driverCall()
+	if diff := cmp.Diff([]string{"example.com/a", "-flag"}, call.Args); diff != "" {
+		t.Errorf("Unexpected driver arguments: (- expected; + found)\n%s", diff)
+	}
+	if want := []string{"-tags=x y"}; !cmp.Equal(call.Request.BuildFlags, want) {
+		t.Errorf("Driver request BuildFlags: got %q; want %q", call.Request.BuildFlags, want)
+	}
+	if !call.Request.Tests {
+		t.Error("Driver request did not include tests")
+	}
+	if mode := call.Request.Mode; mode&driverNeedDeps == 0 || mode&driverNeedExportsFile == 0 {
+		t.Errorf("Driver request mode %#x is missing NeedDeps or NeedExportsFile", mode)
+	}
+	if !strings.Contains(strings.Join(call.Request.Env, "\n"), fakeRequestEnv+"=") {
+		t.Error("Driver request did not include the environment")
+	}
+
+	expected := []*jsonPackage{{
+		ImportPath:  "example.com/a",
+		Name:        "a",
+		Dir:         "/src/a",
+		Export:      "/cache/a.x",
+		GoFiles:     []string{"a.go", "cgo.go"},
+		CFiles:      []string{"a.c"},
+		HFiles:      []string{"a.h"},
+		CXXFiles:    []string{"a.cc"},
+		SFiles:      []string{"a.s"},
+		Imports:     []string{"example.com/b", "fmt"},
+		TestGoFiles: []string{"a_test.go"},
+		TestImports: []string{"testing"},
+	}, {
+		ImportPath: "example.com/a",
+		Name:       "a",
+		Dir:        "/src/a",
+		Export:     "/cache/a_test.x",
+		ForTest:    "example.com/a",
+		GoFiles:    []string{"a.go", "cgo.go", "a_test.go"},
+		Imports:    []string{"example.com/b", "fmt", "testing"},
+	}, {
+		ImportPath: "example.com/b",
+		Name:       "b",
+		Dir:        "/src/b",
+		Export:     "/cache/b.x",
+		DepOnly:    true,
+		GoFiles:    []string{"b.go"},
+		Error:      &jsonPackageError{Pos: "/src/b/b.go:1:1", Err: "bad"},
+	}, {
+		ImportPath: "fmt",
+		Name:       "fmt",
+		Dir:        filepath.Join(goroot, "src/fmt"),
+		Export:     "/cache/fmt.x",
+		Goroot:     true,
+		DepOnly:    true,
+		GoFiles:    []string{"print.go"},
+	}}
+	if diff := cmp.Diff(expected, pkgs); diff != "" {
+		t.Errorf("Unexpected packages: (- expected; + found)\n%s", diff)
+	}
+}
+
+func TestListDriverPackages_notHandled(t *testing.T) {
+	driver, env, driverCall := fakeDriver(t, &driverResponse{NotHandled: true})
+	var e Extractor
+	if pkgs, err := e.listDriverPackages(driver, env, "./..."); err != errNotHandled {
+		t.Errorf("listDriverPackages: got (%v, %v); want %v", pkgs, err, errNotHandled)
+	}
+	driverCall()
+}
+
+func TestListDriverPackages_error(t *testing.T) {
+	var e Extractor
+	if pkgs, err := e.listDriverPackages(filepath.Join(os.TempDir(), "no-such-driver"), nil, "./..."); err == nil {
+		t.Errorf("listDriverPackages with missing driver: got %v; want error", pkgs)
+	}
+}
+
+func TestLocate_packagesDriver(t *testing.T) {
+	rec, err := json.Marshal(&driverResponse{
+		Roots: []string{"example.com/a"},
+		Packages: []*driverPackage{{
+			ID:         "example.com/a",
+			Name:       "a",
+			PkgPath:    "example.com/a",
+			GoFiles:    []string{"/src/a/a.go"},
+			ExportFile: "/cache/a.x",
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "driver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer setenv(t, "GOPACKAGESDRIVER", os.Args[0])()
+	defer setenv(t, fakeDriverEnv, string(rec))()
+	defer setenv(t, fakeRequestEnv, filepath.Join(dir, "request.json"))()
+
+	var e Extractor
+	pkgs, err := e.Locate("example.com/a")
+	if err != nil {
+		t.Fatalf("Locate error: %v", err)
+	} else if len(pkgs) != 1 {
+		t.Fatalf("Locate returned %d packages; want 1", len(pkgs))
+	}
+	bp := pkgs[0].BuildPackage
+	if bp.ImportPath != "example.com/a" || bp.Dir != "/src/a" || bp.PkgObj != "/cache/a.x" || !cmp.Equal(bp.GoFiles, []string{"a.go"}) {
+		t.Errorf("Unexpected package from driver: %+v", bp)
+	}
+	if pkgs[0].Module != nil {
+		t.Errorf("Unexpected module from driver: %+v", pkgs[0].Module)
+	}
+}
@@ -101,26 +101,8 @@ type PackageVNameOptions struct {
 //		 Signature: "package",
 //   }
 func ForPackage(pkg *build.Package, opts *PackageVNameOptions) *spb.VName {
-	if !pkg.Goroot && opts != nil && opts.Rules != nil {
-		root := pkg.Root
-		if opts.RootDirectory != "" {
-			root = opts.RootDirectory
-		}
-
-		relpath, err := filepath.Rel(root, pkg.Dir)
-		if err != nil {
-			log.Fatalf("relativizing path %q against dir %q: %v", pkg.Dir, root, err)
-		}
-		if relpath == "." {
-			relpath = ""
-		}
-
-		v2, ok := opts.Rules.Apply(relpath)
-		if ok {
-			v2.Language = Language
-			v2.Signature = packageSig
-			return v2
-		}
+	if v, ok := applyRules(pkg, pkg.Root, opts); ok {
+		return v
 	}
 
 	ip := pkg.ImportPath
@@ -161,6 +143,86 @@ func ForPackage(pkg *build.Package, opts *PackageVNameOptions) *spb.VName {
 	return v
 }
 
+// A Module describes a Go module, as reported by "go list".
+type Module struct {
+	Path    string  // module path, e.g. "golang.org/x/tools"
+	Version string  // module version, e.g. "v0.1.0"; empty for the main module
+	Main    bool    // whether this is the main module
+	Dir     string  // directory holding the module's files, if any
+	Replace *Module // the module replacing this one, if any
+}
+
+// SourceDir returns the directory holding the files of m, accounting for its
+// replacement (if any).  It returns "" if the directory is unknown.
+func (m *Module) SourceDir() string {
+	if m.Replace != nil && m.Replace.Dir != "" {
+		return m.Replace.Dir
+	}
+	return m.Dir
+}
+
+// ForModulePackage returns a VName for a Go package provided by the module mod.
+//
+// As with ForPackage, a package VName has the fixed signature "package".  If
+// opts.DefaultCorpus is set, it is the package's corpus and the VName path is
+// the package's import path.  Otherwise, its corpus is the module path and its
+// VName path holds the import path relative to the module path, wherever the
+// module's files reside (e.g. in the module cache or in the directory of a
+// replacement).  This gives each module a stable corpus without requiring a
+// VCS lookup; CanonicalizePackageCorpus is ignored.
+//
+// If a set of vname rules is provided, they are applied first and used if
+// applicable.  The package directory is made relative to opts.RootDirectory, if
+// set, or else to the module's source directory before applying the rules.
+//
+// If mod == nil or pkg is part of the standard library, ForModulePackage is
+// equivalent to ForPackage.
+func ForModulePackage(pkg *build.Package, mod *Module, opts *PackageVNameOptions) *spb.VName {
+	if mod == nil || pkg.Goroot {
+		return ForPackage(pkg, opts)
+	}
+	if v, ok := applyRules(pkg, mod.SourceDir(), opts); ok {
+		return v
+	}
+	v := &spb.VName{Language: Language, Signature: packageSig}
+	if opts != nil && opts.DefaultCorpus != "" {
+		v.Corpus = opts.DefaultCorpus
+		v.Path = pkg.ImportPath
+	} else {
+		v.Corpus = mod.Path
+		v.Path = strings.TrimPrefix(strings.TrimPrefix(pkg.ImportPath, mod.Path), "/")
+	}
+	return v
+}
+
+// applyRules applies the vname rules of opts (if any) to the directory of pkg,
+// relative to opts.RootDirectory or else root.  It reports false if the rules
+// are not applicable.
+func applyRules(pkg *build.Package, root string, opts *PackageVNameOptions) (*spb.VName, bool) {
+	if pkg.Goroot || opts == nil || opts.Rules == nil {
+		return nil, false
+	}
+	if opts.RootDirectory != "" {
+		root = opts.RootDirectory
+	}
+
+	relpath, err := filepath.Rel(root, pkg.Dir)
+	if err != nil {
+		log.Fatalf("relativizing path %q against dir %q: %v", pkg.Dir, root, err)
+	}
+	if relpath == "." {
+		relpath = ""
+	}
+
+	v2, ok := opts.Rules.Apply(relpath)
+	if !ok {
+		return nil, false
+	}
+	v2.Language = Language
+	v2.Signature = packageSig
+	return v2, true
+}
+
 // ForBuiltin returns a VName for a Go built-in with the given signature.
 func ForBuiltin(signature string) *spb.VName {
 	return &spb.VName{
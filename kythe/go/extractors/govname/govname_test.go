@@ -97,6 +97,67 @@ func TestForPackage(t *testing.T) {
 	}
 }
 
+func TestForModulePackage(t *testing.T) {
+	const exampleRules = `[{
+		"pattern": "(.*)",
+		"vname": {
+			"corpus": "rule_corpus",
+			"path": "rule_path/@1@"
+		}}]`
+	tools := &Module{Path: "golang.org/x/tools", Version: "v0.1.0", Dir: "/mod/golang.org/x/tools@v0.1.0"}
+	replaced := &Module{Path: "example.com/dep", Version: "v1.2.3",
+		Replace: &Module{Path: "../dep", Dir: "/work/dep"}}
+
+	tests := []struct {
+		path      string // import path
+		dir       string // on-disk directory that contains this package
+		mod       *Module
+		isRoot    bool
+		rulesJSON string
+		corpus    string // DefaultCorpus
+		ticket    string
+	}{
+		{path: "bytes", isRoot: true, ticket: "kythe://golang.org?lang=go?path=bytes#package"},
+		{path: "bytes", isRoot: true, mod: tools, ticket: "kythe://golang.org?lang=go?path=bytes#package"},
+		{path: "golang.org/x/tools", dir: tools.Dir, mod: tools,
+			ticket: "kythe://golang.org/x/tools?lang=go#package"},
+		{path: "golang.org/x/tools/go/packages", dir: tools.Dir + "/go/packages", mod: tools,
+			ticket: "kythe://golang.org/x/tools?lang=go?path=go/packages#package"},
+		{path: "example.com/dep/sub", dir: "/work/dep/sub", mod: replaced,
+			ticket: "kythe://example.com/dep?lang=go?path=sub#package"},
+		{path: "example.com/dep/sub", dir: "/work/dep/sub", mod: replaced, rulesJSON: exampleRules,
+			ticket: "kythe://rule_corpus?lang=go?path=rule_path/sub#package"},
+		{path: "example.com/main/pkg", dir: "/work/main/pkg", mod: &Module{Path: "example.com/main", Main: true, Dir: "/work/main"},
+			ticket: "kythe://example.com/main?lang=go?path=pkg#package"},
+		{path: "golang.org/x/tools/go/packages", dir: tools.Dir + "/go/packages", mod: tools, corpus: "mycorpus",
+			ticket: "kythe://mycorpus?lang=go?path=golang.org/x/tools/go/packages#package"},
+		{path: "example.com/dep/sub", dir: "/work/dep/sub", mod: replaced, rulesJSON: exampleRules, corpus: "mycorpus",
+			ticket: "kythe://rule_corpus?lang=go?path=rule_path/sub#package"},
+		{path: "bytes", isRoot: true, mod: tools, corpus: "mycorpus", ticket: "kythe://golang.org?lang=go?path=bytes#package"},
+	}
+	for _, test := range tests {
+		var rules vnameutil.Rules
+		if test.rulesJSON != "" {
+			var err error
+			rules, err = vnameutil.ParseRules([]byte(test.rulesJSON))
+			if err != nil {
+				t.Errorf("parsing vname rules: %v", err)
+			}
+		}
+
+		pkg := &build.Package{
+			ImportPath: test.path,
+			Goroot:     test.isRoot,
+			Dir:        test.dir,
+		}
+		opts := &PackageVNameOptions{Rules: rules, DefaultCorpus: test.corpus}
+		got := kytheuri.ToString(ForModulePackage(pkg, test.mod, opts))
+		if got != test.ticket {
+			t.Errorf("ForModulePackage([%s], %+v, %+v): got %q, want %q", test.path, test.mod, opts, got, test.ticket)
+		}
+	}
+}
+
 func TestIsStandardLib(t *testing.T) {
 	tests := []*spb.VName{
 		{Corpus: "golang.org"},
@@ -15,13 +15,16 @@
  */
 
 // Program go_indexer implements a Kythe indexer for the Go language.  Input is
-// read from one or more .kzip paths.
+// read from one or more .kzip paths.  The compilation units of each .kzip are
+// indexed concurrently by an analysis driver and the entries of each unit are
+// written together, in the order in which the units are stored, regardless of
+// --parallelism.
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
@@ -29,12 +32,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"kythe.io/kythe/go/indexer"
-	"kythe.io/kythe/go/platform/delimited"
+	"kythe.io/kythe/go/platform/analysis"
+	"kythe.io/kythe/go/platform/analysis/driver"
 	"kythe.io/kythe/go/platform/kzip"
+	"kythe.io/kythe/go/storage/stream"
+	"kythe.io/kythe/go/util/datasize"
+	"kythe.io/kythe/go/util/dedup"
 	"kythe.io/kythe/go/util/metadata"
+	"kythe.io/kythe/go/util/riegeli"
 
 	protopb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	apb "kythe.io/kythe/proto/analysis_go_proto"
@@ -42,7 +52,9 @@ import (
 )
 
 var (
-	doJSON                         = flag.Bool("json", false, "Write output as JSON")
+	doJSON                         = flag.Bool("json", false, "Write output as JSON (equivalent to --write_format=json)")
+	writeFormat                    = stream.FormatFlag("write_format", "delimited", "Format of the output stream")
+	riegeliOptions                 = flag.String("riegeli_writer_options", "", "Riegeli writer options (with --write_format=riegeli)")
 	doLibNodes                     = flag.Bool("libnodes", false, "Emit nodes for standard library packages")
 	doCodeFacts                    = flag.Bool("code", false, "Emit code facts containing MarkedSource markup")
 	doAnchorScopes                 = flag.Bool("anchor_scopes", false, "Emit childof edges to an anchor's semantic scope")
@@ -52,9 +64,11 @@ var (
 	verbose                        = flag.Bool("verbose", false, "Emit verbose log information")
 	contOnErr                      = flag.Bool("continue", false, "Log errors encountered during analysis but do not exit unsuccessfully")
 	useCompilationCorpusAsDefault  = flag.Bool("use_compilation_corpus_as_default", false, "Nodes that otherwise wouldn't have a corpus (such as tapps) are given the corpus of the compilation unit being indexed.")
+	parallelism                    = flag.Int("parallelism", 1, "Maximum number of compilation units to read, index, and buffer output for concurrently; output order is unaffected")
+	dedupCacheSize                 = datasize.Flag("dedup_cache_size", "0", `If non-zero, drop duplicate output entries using a cache of known entry hashes of at most this size (e.g. "3GiB")`)
+	showProgress                   = flag.Bool("progress", false, "Log progress and statistics as compilation units are indexed")
 
-	writeEntry func(context.Context, *spb.Entry) error
-	docURL     *url.URL
+	docURL *url.URL
 )
 
 func init() {
@@ -65,8 +79,17 @@ Generate Kythe graph data for the compilations stored in .kzip format
 named by the path arguments. Output is written to stdout.
 
 By default, the output is a delimited stream of wire-format Kythe Entry
-protobuf messages. With the --json flag, output is instead a stream of
-undelimited JSON messages.
+protobuf messages. With --write_format, output is instead a stream of
+undelimited JSON messages (also selected by --json) or a Riegeli file.
+
+Compilation units are indexed in the order in which they are stored in each
+.kzip (that is, by unit digest), and the entries of each unit are written
+together in that order.
+
+With --parallelism, up to that many compilation units of each .kzip are read
+and indexed concurrently.  The output is the same as without --parallelism: a
+unit whose entries are waiting on a preceding unit pauses once its output
+buffer fills.
 
 Options:
 `, filepath.Base(os.Args[0]))
@@ -80,17 +103,28 @@ func main() {
 
 	if flag.NArg() == 0 {
 		log.Fatal("No input paths were specified to index")
+	} else if *parallelism < 1 {
+		log.Fatalf("Invalid --parallelism: %d", *parallelism)
 	}
 	if *doJSON {
-		enc := json.NewEncoder(os.Stdout)
-		writeEntry = func(_ context.Context, entry *spb.Entry) error {
-			return enc.Encode(entry)
-		}
-	} else {
-		rw := delimited.NewWriter(os.Stdout)
-		writeEntry = func(_ context.Context, entry *spb.Entry) error {
-			return rw.PutProto(entry)
+		*writeFormat = stream.JSONFormat
+	}
+	opts, err := riegeli.ParseOptions(*riegeliOptions)
+	if err != nil {
+		log.Fatalf("Invalid --riegeli_writer_options: %v", err)
+	}
+	out := bufio.NewWriter(os.Stdout)
+	wr, err := stream.NewFormatWriter(out, *writeFormat, opts)
+	if err != nil {
+		log.Fatalf("Invalid --write_format: %v", err)
+	}
+	w := &entryWriter{wr: wr}
+	if *dedupCacheSize > 0 {
+		d, err := dedup.New(int(*dedupCacheSize))
+		if err != nil {
+			log.Fatalf("Invalid --dedup_cache_size: %v", err)
 		}
+		w.dedup = d
 	}
 	if *docBase != "" {
 		u, err := url.Parse(*docBase)
@@ -101,18 +135,22 @@ func main() {
 	}
 
 	ctx := context.Background()
+	start := time.Now()
+	stats := new(indexStats)
 	for _, path := range flag.Args() {
-		if err := visitPath(ctx, path, func(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher) error {
-			err := indexGo(ctx, unit, f)
-			if err != nil && *contOnErr {
-				log.Printf("Continuing after error: %v", err)
-				return nil
-			}
-			return err
-		}); err != nil {
+		if err := indexPath(ctx, path, w, stats); err != nil {
 			log.Fatalf("Error indexing %q: %v", path, err)
 		}
 	}
+	if err := wr.Flush(); err != nil {
+		log.Fatalf("Error writing output: %v", err)
+	} else if err := out.Flush(); err != nil {
+		log.Fatalf("Error writing output: %v", err)
+	}
+	if *showProgress {
+		log.Printf("Indexed %d compilation units (%d failed) in %v; wrote %d entries (%d duplicates dropped)",
+			stats.units, stats.failed, time.Since(start).Round(time.Millisecond), w.entries, w.dedup.Duplicates())
+	}
 }
 
 // checkMetadata checks whether ri denotes a metadata file according to the
@@ -140,8 +178,9 @@ func checkMetadata(ri *apb.CompilationUnit_FileInput, f indexer.Fetcher) (*index
 	}, nil
 }
 
-// indexGo is a visitFunc that invokes the Kythe Go indexer on unit.
-func indexGo(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher) error {
+// indexGo invokes the Kythe Go indexer on unit, passing its entries to
+// writeEntry.
+func indexGo(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher, writeEntry func(context.Context, *spb.Entry) error) error {
 	pi, err := indexer.Resolve(unit, f, &indexer.ResolveOptions{
 		Info:       indexer.XRefTypeInfo(),
 		CheckRules: checkMetadata,
@@ -163,25 +202,150 @@ func indexGo(ctx context.Context, unit *apb.CompilationUnit, f indexer.Fetcher)
 	})
 }
 
-type visitFunc func(context.Context, *apb.CompilationUnit, indexer.Fetcher) error
-
-// visitPath invokes visit for each compilation denoted by path, which is
-// must be a .kzip file (with a single compilation).
-func visitPath(ctx context.Context, path string, visit visitFunc) error {
+// indexPath indexes each compilation stored in the .kzip file at path.
+func indexPath(ctx context.Context, path string, w *entryWriter, stats *indexStats) error {
 	f, err := os.Open(path)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
-	switch ext := filepath.Ext(path); ext {
-	case ".kzip":
-		return kzip.Scan(f, func(r *kzip.Reader, unit *kzip.Unit) error {
-			return visit(ctx, unit.Proto, kzipFetcher{r})
-		})
-
-	default:
+	if ext := filepath.Ext(path); ext != ".kzip" {
 		return fmt.Errorf("unknown file extension %q", ext)
 	}
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	rd, err := kzip.NewReader(f, stat.Size())
+	if err != nil {
+		return err
+	}
+
+	// Each unit is read only as the driver asks for it, so no more than one
+	// unit beyond those being indexed is held in memory at a time.
+	digests := rd.UnitDigests()
+	d := &driver.Driver{
+		Analyzer: &unitAnalyzer{fetcher: kzipFetcher{rd}},
+		Context:  &unitContext{stats: stats, total: len(digests)},
+		WriteOutput: func(_ context.Context, out *apb.AnalysisOutput) error {
+			return w.put(out.Value)
+		},
+		Concurrency:   *parallelism,
+		OrderedOutput: true,
+	}
+	return d.Run(ctx, &unitQueue{rd: rd, digests: digests})
+}
+
+// A unitQueue is a driver.Queue reading the compilation units of a .kzip file
+// in the order in which they are stored.
+type unitQueue struct {
+	rd      *kzip.Reader
+	digests []string
+}
+
+// Next implements the driver.Queue interface.
+func (q *unitQueue) Next(ctx context.Context, f driver.CompilationFunc) error {
+	if len(q.digests) == 0 {
+		return driver.ErrEndOfQueue
+	}
+	digest := q.digests[0]
+	q.digests = q.digests[1:]
+	unit, err := q.rd.Lookup(digest)
+	if err != nil {
+		return fmt.Errorf("reading compilation unit %s: %v", digest, err)
+	}
+	return f(ctx, driver.Compilation{Unit: unit.Proto, UnitDigest: digest})
+}
+
+// A unitAnalyzer is an analysis.CompilationAnalyzer that invokes the Kythe Go
+// indexer, emitting each entry encoded in the output format.
+type unitAnalyzer struct{ fetcher indexer.Fetcher }
+
+// Analyze implements the analysis.CompilationAnalyzer interface.
+func (a *unitAnalyzer) Analyze(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+	return indexGo(ctx, req.Compilation, a.fetcher, func(ctx context.Context, entry *spb.Entry) error {
+		rec, err := writeFormat.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		return out(ctx, &apb.AnalysisOutput{Value: rec})
+	})
+}
+
+// indexStats counts the compilation units indexed across all inputs.
+type indexStats struct {
+	mu            sync.Mutex
+	units, failed int
+}
+
+// A unitContext is a driver.Context that counts (and with --progress, logs)
+// the compilation units of a .kzip file as they are indexed.
+type unitContext struct {
+	stats *indexStats
+	total int
+
+	mu    sync.Mutex
+	start map[string]time.Time // :: unit digest → analysis start
+	done  int
+}
+
+// Setup implements part of the driver.Context interface.
+func (c *unitContext) Setup(_ context.Context, cu driver.Compilation) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.start == nil {
+		c.start = make(map[string]time.Time)
+	}
+	c.start[cu.UnitDigest] = time.Now()
+	return nil
+}
+
+// Teardown implements part of the driver.Context interface.
+func (c *unitContext) Teardown(_ context.Context, cu driver.Compilation) error {
+	c.mu.Lock()
+	elapsed := time.Since(c.start[cu.UnitDigest])
+	delete(c.start, cu.UnitDigest)
+	c.done++
+	done := c.done
+	c.mu.Unlock()
+
+	c.stats.mu.Lock()
+	c.stats.units++
+	c.stats.mu.Unlock()
+	if *showProgress {
+		log.Printf("Indexed %d/%d: %s in %v", done, c.total, cu.UnitDigest, elapsed.Round(time.Millisecond))
+	}
+	return nil
+}
+
+// AnalysisError implements part of the driver.Context interface.  With
+// --continue, the error is logged and indexing proceeds.
+func (c *unitContext) AnalysisError(_ context.Context, cu driver.Compilation, err error) error {
+	c.stats.mu.Lock()
+	c.stats.failed++
+	c.stats.mu.Unlock()
+	if !*contOnErr {
+		return err
+	}
+	log.Printf("Continuing after error in %s: %v", cu.UnitDigest, err)
+	return nil
+}
+
+// An entryWriter writes encoded entries to the output, dropping duplicates if
+// it has a Deduper.
+type entryWriter struct {
+	wr    *stream.Writer
+	dedup *dedup.Deduper
+
+	entries uint64
+}
+
+func (w *entryWriter) put(rec []byte) error {
+	if !w.dedup.IsUnique(rec) {
+		return nil
+	}
+	w.entries++
+	return w.wr.PutRecord(rec)
 }
 
 type kzipFetcher struct{ r *kzip.Reader }
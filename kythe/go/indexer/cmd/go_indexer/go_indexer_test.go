@@ -0,0 +1,117 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/platform/kzip"
+	"kythe.io/kythe/go/storage/stream"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// writeTestKzip writes a .kzip of n single-file Go compilations to a new
+// file in dir and returns its path.
+func writeTestKzip(t *testing.T, dir string, n int) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := kzip.NewWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewWriter error: %v", err)
+	}
+	for i := 0; i < n; i++ {
+		pkg := fmt.Sprintf("pkg%d", i)
+		path := pkg + "/file.go"
+		src := fmt.Sprintf("package %s\n\n// F%[2]d calls itself.\nfunc F%[2]d() { F%[2]d() }\n\nvar V = %[2]d\n", pkg, i)
+		digest, err := w.AddFile(strings.NewReader(src))
+		if err != nil {
+			t.Fatalf("AddFile error: %v", err)
+		}
+		if _, err := w.AddUnit(&apb.CompilationUnit{
+			VName: &spb.VName{Language: "go", Corpus: "test", Path: pkg, Signature: "package"},
+			RequiredInput: []*apb.CompilationUnit_FileInput{{
+				VName: &spb.VName{Corpus: "test", Path: path},
+				Info:  &apb.FileInfo{Path: path, Digest: digest},
+			}},
+			SourceFile: []string{path},
+		}, nil); err != nil {
+			t.Fatalf("AddUnit error: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	path := filepath.Join(dir, "units.kzip")
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// indexWithParallelism indexes the .kzip at path with the given --parallelism
+// and returns the output.
+func indexWithParallelism(t *testing.T, path string, n int) []byte {
+	t.Helper()
+	defer func(old int) { *parallelism = old }(*parallelism)
+	*parallelism = n
+
+	var buf bytes.Buffer
+	wr, err := stream.NewFormatWriter(&buf, *writeFormat, nil)
+	if err != nil {
+		t.Fatalf("NewFormatWriter error: %v", err)
+	}
+	stats := new(indexStats)
+	if err := indexPath(context.Background(), path, &entryWriter{wr: wr}, stats); err != nil {
+		t.Fatalf("indexPath (parallelism %d) error: %v", n, err)
+	} else if err := wr.Flush(); err != nil {
+		t.Fatalf("Flush error: %v", err)
+	}
+	if stats.units != numTestUnits || stats.failed != 0 {
+		t.Errorf("Parallelism %d: indexed %d units (%d failed); want %d", n, stats.units, stats.failed, numTestUnits)
+	}
+	return buf.Bytes()
+}
+
+const numTestUnits = 12
+
+func TestParallelismOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go_indexer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := writeTestKzip(t, dir, numTestUnits)
+
+	expected := indexWithParallelism(t, path, 1)
+	if len(expected) == 0 {
+		t.Fatal("No output with parallelism 1")
+	}
+	for _, n := range []int{2, 4, numTestUnits + 1} {
+		if found := indexWithParallelism(t, path, n); !bytes.Equal(found, expected) {
+			t.Errorf("Output with parallelism %d differs from parallelism 1 (%d vs. %d bytes)", n, len(found), len(expected))
+		}
+	}
+}
@@ -201,8 +201,10 @@ type emitter struct {
 // as part of their parent syntax.
 func (e *emitter) visitIdent(id *ast.Ident, stack stackFunc) {
 	obj := e.pi.Info.Uses[id]
-	if obj == nil {
-		// Defining identifiers are handled by their parent nodes.
+	if obj == nil || e.pi.Info.Defs[id] == obj {
+		// Defining identifiers are handled by their parent nodes.  This
+		// includes the type parameters of a method receiver, which the type
+		// checker records as both definitions and uses.
 		return
 	}
 
@@ -221,6 +223,7 @@ func (e *emitter) visitIdent(id *ast.Ident, stack stackFunc) {
 	if e.opts.emitAnchorScopes() {
 		e.writeEdge(ref, e.callContext(stack).vname, edges.ChildOf)
 	}
+	e.emitInstanceRef(id, ref, target)
 	if call, ok := isCall(id, obj, stack); ok {
 		callAnchor := e.writeRef(call, target, edges.RefCall)
 
@@ -251,6 +254,7 @@ func (e *emitter) visitFuncDecl(decl *ast.FuncDecl, stack stackFunc) {
 	info.vname = e.mustWriteBinding(decl.Name, nodes.Function, nil)
 	e.writeDef(decl, info.vname)
 	e.writeDoc(decl.Doc, info.vname)
+	e.emitFuncTypeParams(decl, info.vname)
 
 	// For concrete methods: Emit the receiver if named, and connect the method
 	// to its declaring type.
@@ -274,9 +278,10 @@ func (e *emitter) visitFuncDecl(decl *ast.FuncDecl, stack stackFunc) {
 
 // emitTApp emits a tapp node and returns its VName.  The new tapp is emitted
 // with given constructor and parameters.  The constructor's kind is also
-// emitted if this is the first time seeing it.
+// emitted if this is the first time seeing it, unless ctorKind == "" (e.g.,
+// for generic declarations, whose nodes are emitted elsewhere).
 func (e *emitter) emitTApp(ms *cpb.MarkedSource, ctorKind string, ctor *spb.VName, params ...*spb.VName) *spb.VName {
-	if e.pi.typeEmitted.Add(ctor.Signature) {
+	if ctorKind != "" && e.pi.typeEmitted.Add(ctor.Signature) {
 		e.writeFact(ctor, facts.NodeKind, ctorKind)
 		if ctorKind == nodes.TBuiltin {
 			e.emitBuiltinMarkedSource(ctor)
@@ -313,7 +318,7 @@ func (e *emitter) emitType(typ types.Type) *spb.VName {
 
 	switch typ := typ.(type) {
 	case *types.Named:
-		v = e.pi.ObjectVName(typ.Obj())
+		v = e.emitNamedType(typ)
 	case *types.Basic:
 		v = govname.BasicType(typ)
 		if e.pi.typeEmitted.Add(v.Signature) {
@@ -411,7 +416,10 @@ func (e *emitter) emitType(typ types.Type) *spb.VName {
 			}
 		}
 	default:
-		log.Printf("WARNING: unknown type %T: %+v", typ, typ)
+		var ok bool
+		if v, ok = e.emitGenericType(typ); !ok {
+			log.Printf("WARNING: unknown type %T: %+v", typ, typ)
+		}
 	}
 
 	e.pi.typeVName[typ] = v
@@ -487,6 +495,7 @@ func (e *emitter) visitTypeSpec(spec *ast.TypeSpec, stack stackFunc) {
 	target := e.mustWriteBinding(spec.Name, "", e.nameContext(stack))
 	e.writeDef(spec, target)
 	e.writeDoc(specComment(spec, stack), target)
+	e.emitTypeSpecParams(spec, target)
 
 	// Emit type-specific structure.
 	switch t := obj.Type().Underlying().(type) {
@@ -537,8 +546,14 @@ func (e *emitter) visitTypeSpec(spec *ast.TypeSpec, stack stackFunc) {
 			e.writeEdge(e.pi.ObjectVName(t.Method(i)), target, edges.ChildOf)
 		}
 		// Mark the interface as an extension of any embedded interfaces.
+		// Constraint interfaces may also embed non-interface types and unions,
+		// which are not extended.
 		for i, n := 0, t.NumEmbeddeds(); i < n; i++ {
-			if eobj := t.Embedded(i).Obj(); e.checkImplements(obj, eobj) {
+			named, ok := t.EmbeddedType(i).(*types.Named)
+			if !ok || !isInterface(named) {
+				continue
+			}
+			if eobj := named.Obj(); e.checkImplements(obj, eobj) {
 				e.writeEdge(target, e.pi.ObjectVName(eobj), edges.Extends)
 			}
 		}
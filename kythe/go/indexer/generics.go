@@ -0,0 +1,198 @@
+//go:build go1.22
+// +build go1.22
+
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+// This file holds the indexing of generic types and functions, which relies on
+// the type parameter support added to go/ast and go/types in Go 1.18 and on
+// the explicit alias types of Go 1.22.  See nogenerics.go for older Go
+// releases, which cannot parse generic code.
+
+import (
+	"go/ast"
+	"go/types"
+
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// withInstances adds a map for recording the instantiations of generic types
+// and functions to info, and returns info.
+func withInstances(info *types.Info) *types.Info {
+	info.Instances = make(map[*ast.Ident]types.Instance)
+	return info
+}
+
+// emitInstanceRef emits an implicit reference from the anchor ref to the
+// application of target to its type arguments, if id instantiates a generic
+// type or function.
+func (e *emitter) emitInstanceRef(id *ast.Ident, ref, target *spb.VName) {
+	if inst, ok := e.pi.Info.Instances[id]; ok && inst.TypeArgs.Len() != 0 {
+		e.writeEdge(ref, e.emitInstance(target, inst.TypeArgs), edges.RefImplicit)
+	}
+}
+
+// emitNamedType returns the VName of the named type typ.  An instantiated
+// generic type, e.g., List[int], is emitted as a tapp of its generic type.
+func (e *emitter) emitNamedType(typ *types.Named) *spb.VName {
+	if args := typ.TypeArgs(); args.Len() != 0 {
+		return e.emitInstance(e.pi.ObjectVName(typ.Origin().Obj()), args)
+	}
+	return e.pi.ObjectVName(typ.Obj())
+}
+
+// emitGenericType returns the VName of typ if it is a type parameter or an
+// alias, and reports whether it was.
+func (e *emitter) emitGenericType(typ types.Type) (*spb.VName, bool) {
+	switch typ := typ.(type) {
+	case *types.TypeParam:
+		return e.pi.ObjectVName(typ.Obj()), true
+	case *types.Alias:
+		return e.emitType(types.Unalias(typ)), true
+	}
+	return nil, false
+}
+
+// emitFuncTypeParams emits bindings for the type parameters of the function
+// declared by decl, including those redeclared by the receiver of a method of
+// a generic type, e.g., "func (l *List[T]) Len() int".
+func (e *emitter) emitFuncTypeParams(decl *ast.FuncDecl, owner *spb.VName) {
+	e.emitTypeParams(decl.Type.TypeParams, owner)
+	if decl.Recv != nil && len(decl.Recv.List) > 0 {
+		e.emitRecvTypeParams(decl.Recv.List[0].Type, owner)
+	}
+}
+
+// emitTypeSpecParams emits bindings for the type parameters of the type
+// declared by spec.
+func (e *emitter) emitTypeSpecParams(spec *ast.TypeSpec, owner *spb.VName) {
+	e.emitTypeParams(spec.TypeParams, owner)
+}
+
+// emitInstance emits a tapp node for the application of the generic type or
+// function denoted by generic to the given type arguments, and returns its
+// VName.
+func (e *emitter) emitInstance(generic *spb.VName, args *types.TypeList) *spb.VName {
+	params := make([]*spb.VName, args.Len())
+	for i := range params {
+		params[i] = e.emitType(args.At(i))
+	}
+	return e.emitTApp(genericTAppMS, "", generic, params...)
+}
+
+// emitTypeParams emits bindings for the type parameters declared by fields,
+// which belong to the generic type or function denoted by owner.  Each type
+// parameter is connected to its owner by a tparam edge and bounded by its
+// constraint.
+func (e *emitter) emitTypeParams(fields *ast.FieldList, owner *spb.VName) {
+	var idents []*ast.Ident
+	mapFields(fields, func(_ int, id *ast.Ident) { idents = append(idents, id) })
+	e.emitTypeParamIdents(idents, owner)
+}
+
+// emitRecvTypeParams emits bindings for the type parameters redeclared by the
+// receiver type expression of a method, e.g., "*List[K, V]".
+func (e *emitter) emitRecvTypeParams(recv ast.Expr, owner *spb.VName) {
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	switch t := recv.(type) {
+	case *ast.IndexExpr:
+		if id, ok := t.Index.(*ast.Ident); ok {
+			e.emitTypeParamIdents([]*ast.Ident{id}, owner)
+		}
+	case *ast.IndexListExpr:
+		var idents []*ast.Ident
+		for _, idx := range t.Indices {
+			if id, ok := idx.(*ast.Ident); ok {
+				idents = append(idents, id)
+			}
+		}
+		e.emitTypeParamIdents(idents, owner)
+	}
+}
+
+// emitTypeParamIdents emits bindings for the type parameters declared at
+// idents, in order, as parameters of owner.
+func (e *emitter) emitTypeParamIdents(idents []*ast.Ident, owner *spb.VName) {
+	for i, id := range idents {
+		obj, ok := e.pi.Info.Defs[id].(*types.TypeName)
+		if !ok {
+			continue // type error, or a blank receiver type parameter
+		}
+		tp, ok := obj.Type().(*types.TypeParam)
+		if !ok {
+			continue
+		}
+		target := e.pi.ObjectVName(obj)
+		e.writeFact(target, facts.NodeKind, nodes.TVar)
+		if id.Name != "_" {
+			e.writeRef(id, target, edges.DefinesBinding)
+		}
+		e.writeEdge(target, owner, edges.ChildOf)
+		e.writeEdge(owner, target, edges.TParamIndex(i))
+		if e.opts.emitMarkedSource() {
+			e.emitCode(target, e.pi.MarkedSource(obj))
+		}
+		if c := tp.Constraint(); c != nil {
+			e.writeEdge(target, e.emitType(c), edges.BoundedUpper)
+		}
+	}
+}
+
+// isTypeParam reports whether typ is a type parameter.
+func isTypeParam(typ types.Type) bool {
+	_, ok := typ.(*types.TypeParam)
+	return ok
+}
+
+// typeParamNames returns the type parameters declared by typ, which is a
+// generic named type or function signature.  The type parameters of a method
+// include those redeclared by its receiver.
+func typeParamNames(typ types.Type) []*types.TypeName {
+	var tps []*types.TypeParamList
+	switch t := typ.(type) {
+	case *types.Named:
+		tps = append(tps, t.TypeParams())
+	case *types.Signature:
+		tps = append(tps, t.TypeParams(), t.RecvTypeParams())
+	}
+	var names []*types.TypeName
+	for _, list := range tps {
+		for i := 0; i < list.Len(); i++ {
+			names = append(names, list.At(i).Obj())
+		}
+	}
+	return names
+}
+
+// origin returns the generic object from which obj was instantiated, or obj
+// itself if it is not a member of an instantiated type or function.
+func origin(obj types.Object) types.Object {
+	switch t := obj.(type) {
+	case *types.Func:
+		return t.Origin()
+	case *types.Var:
+		return t.Origin()
+	}
+	return obj
+}
@@ -0,0 +1,126 @@
+//go:build go1.22
+// +build go1.22
+
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func TestGenerics(t *testing.T) {
+	const input = `package gen
+
+type List[T any] struct{ elts []T }
+
+func (l *List[T]) Push(v T) { l.elts = append(l.elts, v) }
+
+func Map[T, U any](ts []T, f func(T) U) []U { return nil }
+
+func use() {
+	var l List[int]
+	l.Push(1)
+	_ = Map[int, string](nil, nil)
+}
+`
+	unit, digest := oneFileCompilation("gen.go", "gen", input)
+	pi, err := Resolve(unit, memFetcher{digest: input}, &ResolveOptions{Info: XRefTypeInfo()})
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if len(pi.Errors) != 0 {
+		t.Fatalf("Type errors: %v", pi.Errors)
+	}
+
+	// Record the target signatures of each edge kind from each source.
+	kinds := make(map[string]string) // :: signature → node kind
+	edges := make(map[string][]string)
+	if err := pi.Emit(context.Background(), func(_ context.Context, e *spb.Entry) error {
+		if isEdge(e) {
+			key := e.Source.Signature + " " + e.EdgeKind
+			edges[key] = append(edges[key], e.Target.Signature)
+		} else if e.FactName == "/kythe/node/kind" {
+			kinds[e.Source.Signature] = string(e.FactValue)
+		}
+		return nil
+	}, nil); err != nil {
+		t.Fatalf("Emit failed: %v", err)
+	}
+	hasEdge := func(src, kind, tgt string) bool {
+		for _, got := range edges[src+" "+kind] {
+			if got == tgt {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Type parameters are emitted as tvar nodes connected to their owners.
+	for _, test := range []struct {
+		owner, kind, tparam string
+	}{
+		{"type List", "/kythe/edge/tparam.0", "tparam List[T]"},
+		{"method List.Push", "/kythe/edge/tparam.0", "tparam List.Push[T]"},
+		{"func Map", "/kythe/edge/tparam.0", "tparam Map[T]"},
+		{"func Map", "/kythe/edge/tparam.1", "tparam Map[U]"},
+	} {
+		if !hasEdge(test.owner, test.kind, test.tparam) {
+			t.Errorf("Missing edge %q ―%s→ %q", test.owner, test.kind, test.tparam)
+		}
+		if got := kinds[test.tparam]; got != "tvar" {
+			t.Errorf("Kind of %q: got %q, want tvar", test.tparam, got)
+		}
+		if !hasEdge(test.tparam, "/kythe/edge/childof", test.owner) {
+			t.Errorf("Missing edge %q ―childof→ %q", test.tparam, test.owner)
+		}
+	}
+
+	// Instantiations refer to their generic declarations, and applications of
+	// generic types and functions to their type arguments are tapps.
+	var listInt string
+	for _, tgts := range edges {
+		for _, tgt := range tgts {
+			if kinds[tgt] == "tapp" && hasEdge(tgt, "/kythe/edge/param.0", "type List") &&
+				hasEdge(tgt, "/kythe/edge/param.1", "int#builtin") {
+				listInt = tgt
+			}
+		}
+	}
+	if listInt == "" {
+		t.Error("Missing tapp for List[int]")
+	}
+	var refs []string
+	for key, tgts := range edges {
+		if strings.HasSuffix(key, " /kythe/edge/ref") {
+			refs = append(refs, tgts...)
+		}
+	}
+	for _, want := range []string{"type List", "method List.Push", "func Map"} {
+		found := false
+		for _, ref := range refs {
+			found = found || ref == want
+		}
+		if !found {
+			t.Errorf("Missing reference to %q", want)
+		}
+	}
+}
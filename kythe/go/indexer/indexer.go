@@ -429,11 +429,15 @@ func (pi *PackageInfo) String() string {
 		pi.Name, pi.ImportPath, pi.Package, len(pi.Dependencies), len(pi.Files), len(pi.Errors))
 }
 
-// Signature returns a signature for obj, suitable for use in a vname.
+// Signature returns a signature for obj, suitable for use in a vname.  The
+// members of an instantiated generic type or function share the signature of
+// the corresponding generic declaration.
 func (pi *PackageInfo) Signature(obj types.Object) string {
 	if obj == nil {
 		return ""
-	} else if pi.owner == nil {
+	}
+	obj = origin(obj)
+	if pi.owner == nil {
 		pi.owner = make(map[types.Object]types.Object)
 		ownerByPos := make(map[token.Position]types.Object)
 		unownedByPos := make(map[token.Position][]types.Object)
@@ -459,6 +463,7 @@ func (pi *PackageInfo) ObjectVName(obj types.Object) *spb.VName {
 	if pkg, ok := obj.(*types.PkgName); ok {
 		return pi.PackageVName[pkg.Imported()]
 	}
+	obj = origin(obj)
 	sig := pi.Signature(obj)
 	pkg := obj.Pkg()
 	var vname *spb.VName
@@ -532,6 +537,7 @@ const (
 	tagLabel  = "label"
 	tagMethod = "method"
 	tagParam  = "param"
+	tagTParam = "tparam"
 	tagType   = "type"
 	tagVar    = "var"
 )
@@ -594,6 +600,13 @@ func (pi *PackageInfo) newSignature(obj types.Object) (tag, base string) {
 		if t.Pkg() == nil {
 			return isBuiltin + tagType, t.Name()
 		}
+		if isTypeParam(t.Type()) {
+			if owner, ok := pi.owner[t]; ok {
+				_, base := pi.newSignature(owner)
+				return tagTParam, base + "[" + t.Name() + "]"
+			}
+			topLevelTag = tagTParam
+		}
 
 	case *types.Label:
 		return tagLabel, fmt.Sprintf("[%p].%s", t, t.Name())
@@ -673,6 +686,11 @@ func (pi *PackageInfo) newSignature(obj types.Object) (tag, base string) {
 // names.  They should be rare in readable code.
 func (pi *PackageInfo) addOwners(pkg *types.Package, ownerByPos map[token.Position]types.Object, unownedByPos map[token.Position][]types.Object) {
 	scope := pkg.Scope()
+	addTypeParams := func(obj types.Object, typ types.Type) {
+		for _, tp := range typeParamNames(typ) {
+			pi.owner[tp] = obj
+		}
+	}
 	addFunc := func(obj *types.Func) {
 		// Inspect the receiver, parameters, and result values.
 		fsig := obj.Type().(*types.Signature)
@@ -689,6 +707,7 @@ func (pi *PackageInfo) addOwners(pkg *types.Package, ownerByPos map[token.Positi
 				pi.owner[res.At(i)] = obj
 			}
 		}
+		addTypeParams(obj, fsig)
 	}
 	addMethods := func(obj types.Object, n int, method func(i int) *types.Func) {
 		for i := 0; i < n; i++ {
@@ -742,6 +761,7 @@ func (pi *PackageInfo) addOwners(pkg *types.Package, ownerByPos map[token.Positi
 			if !ok {
 				continue
 			}
+			addTypeParams(obj, named)
 			switch t := named.Underlying().(type) {
 			case *types.Struct:
 				// Inspect the fields of a struct.
@@ -856,23 +876,23 @@ func matchesBuildTags(fpath string, data []byte, bc *build.Context) bool {
 // AllTypeInfo creates a new types.Info value with empty maps for each of the
 // fields that can be filled in by the type-checker.
 func AllTypeInfo() *types.Info {
-	return &types.Info{
+	return withInstances(&types.Info{
 		Types:      make(map[ast.Expr]types.TypeAndValue),
 		Defs:       make(map[*ast.Ident]types.Object),
 		Uses:       make(map[*ast.Ident]types.Object),
 		Implicits:  make(map[ast.Node]types.Object),
 		Selections: make(map[*ast.SelectorExpr]*types.Selection),
 		Scopes:     make(map[ast.Node]*types.Scope),
-	}
+	})
 }
 
 // XRefTypeInfo creates a new types.Info value with empty maps for each of the
 // fields needed for cross-reference indexing.
 func XRefTypeInfo() *types.Info {
-	return &types.Info{
+	return withInstances(&types.Info{
 		Types:     make(map[ast.Expr]types.TypeAndValue),
 		Defs:      make(map[*ast.Ident]types.Object),
 		Uses:      make(map[*ast.Ident]types.Object),
 		Implicits: make(map[ast.Node]types.Object),
-	}
+	})
 }
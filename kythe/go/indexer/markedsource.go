@@ -302,6 +302,19 @@ var (
 		PreText:  "(",
 		PostText: ")",
 	}
+	genericTAppMS = &cpb.MarkedSource{
+		Kind: cpb.MarkedSource_TYPE,
+		Child: []*cpb.MarkedSource{{
+			Kind:        cpb.MarkedSource_LOOKUP_BY_PARAM,
+			LookupIndex: 0,
+		}, {
+			Kind:          cpb.MarkedSource_PARAMETER_LOOKUP_BY_PARAM,
+			LookupIndex:   1,
+			PreText:       "[",
+			PostChildText: ", ",
+			PostText:      "]",
+		}},
+	}
 	variadicTAppMS = &cpb.MarkedSource{
 		Kind: cpb.MarkedSource_TYPE,
 		Child: []*cpb.MarkedSource{{
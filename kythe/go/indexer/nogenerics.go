@@ -0,0 +1,48 @@
+//go:build !go1.22
+// +build !go1.22
+
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package indexer
+
+// Go releases before 1.22 lack the go/types support needed to index generic
+// code (see generics.go), so generic types and functions are not indexed.
+
+import (
+	"go/ast"
+	"go/types"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func withInstances(info *types.Info) *types.Info { return info }
+
+func (e *emitter) emitInstanceRef(id *ast.Ident, ref, target *spb.VName) {}
+
+func (e *emitter) emitNamedType(typ *types.Named) *spb.VName { return e.pi.ObjectVName(typ.Obj()) }
+
+func (e *emitter) emitGenericType(typ types.Type) (*spb.VName, bool) { return nil, false }
+
+func (e *emitter) emitFuncTypeParams(decl *ast.FuncDecl, owner *spb.VName) {}
+
+func (e *emitter) emitTypeSpecParams(spec *ast.TypeSpec, owner *spb.VName) {}
+
+func isTypeParam(typ types.Type) bool { return false }
+
+func typeParamNames(typ types.Type) []*types.TypeName { return nil }
+
+func origin(obj types.Object) types.Object { return obj }
@@ -0,0 +1,70 @@
+// Package generics tests type parameters and instantiations.
+package generics
+
+//- @Number defines/binding Number
+//- Number.node/kind interface
+type Number interface {
+	~int | ~float64
+}
+
+//- @List defines/binding List
+//- List.node/kind record
+//- @T defines/binding ListT
+//- ListT.node/kind tvar
+//- ListT childof List
+//- List tparam.0 ListT
+//- @elts defines/binding Elts
+//- Elts childof List
+type List[T any] struct {
+	//- @T ref ListT
+	elts []T
+}
+
+//- @Push defines/binding Push
+//- Push childof List
+//- @List ref List
+//- @T defines/binding PushT
+//- PushT.node/kind tvar
+//- Push tparam.0 PushT
+//- @v defines/binding V
+//- V typed PushT
+func (l *List[T]) Push(v T) {
+	//- @elts ref Elts
+	l.elts = append(l.elts, v)
+}
+
+//- @Sum defines/binding Sum
+//- @K defines/binding SumK
+//- @N defines/binding SumN
+//- Sum tparam.0 SumK
+//- Sum tparam.1 SumN
+//- SumN bounded/upper Number
+//- @Number ref Number
+func Sum[K comparable, N Number](m map[K]N) N {
+	var s N
+	for _, v := range m {
+		s += v
+	}
+	return s
+}
+
+func use() {
+	//- @l defines/binding L
+	//- L typed ListInt
+	//- ListInt.node/kind tapp
+	//- ListInt param.0 List
+	//- ListInt param.1 IntBuiltin
+	//- IntBuiltin.node/kind tbuiltin
+	//- @List ref List
+	//- @List ref/implicit ListInt
+	var l List[int]
+
+	//- @Push ref Push
+	l.Push(1)
+
+	//- @Sum ref Sum
+	//- @Sum ref/implicit SumInst
+	//- SumInst.node/kind tapp
+	//- SumInst param.0 Sum
+	_ = Sum(map[string]int{"a": 1})
+}
@@ -29,9 +29,13 @@ import (
 	"time"
 
 	"kythe.io/kythe/go/languageserver"
+	"kythe.io/kythe/go/services/explore"
+	"kythe.io/kythe/go/services/graph"
 	"kythe.io/kythe/go/services/xrefs"
+	"kythe.io/kythe/go/serving/identifiers"
 
 	"github.com/sourcegraph/jsonrpc2"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -39,6 +43,7 @@ var (
 
 	serverAddr = flag.String("server", "localhost:8080",
 		"The address of the Kythe service to use (:8080 allows access from other machines)")
+	useGRPC = flag.Bool("grpc", false, "Whether the Kythe service at --server is a gRPC server rather than a JSON HTTP server")
 )
 
 func main() {
@@ -64,10 +69,25 @@ func main() {
 	}
 	conn.Close()
 
-	client := xrefs.WebClient("http://" + *serverAddr)
-	server := languageserver.NewServer(client, &languageserver.Options{
-		PageSize: *pageSize,
-	})
+	var server languageserver.Server
+	opts := &languageserver.Options{PageSize: *pageSize}
+	if *useGRPC {
+		cc, err := grpc.Dial(*serverAddr, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("Dialing Kythe gRPC service: %v", err)
+		}
+		defer cc.Close()
+		server = languageserver.NewServer(xrefs.GRPCClient(cc), opts)
+		server.Graph = graph.GRPCClient(cc)
+		server.Identifiers = identifiers.GRPCClient(cc)
+		server.Explore = explore.GRPCClient(cc)
+	} else {
+		addr := "http://" + *serverAddr
+		server = languageserver.NewServer(xrefs.WebClient(addr), opts)
+		server.Graph = graph.WebClient(addr)
+		server.Identifiers = identifiers.WebClient(addr)
+		server.Explore = explore.WebClient(addr)
+	}
 
 	<-jsonrpc2.NewConn(
 		context.Background(),
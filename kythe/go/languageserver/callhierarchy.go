@@ -0,0 +1,342 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languageserver
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"kythe.io/kythe/go/util/markedsource"
+	"kythe.io/kythe/go/util/schema/edges"
+
+	epb "kythe.io/kythe/proto/explore_go_proto"
+	xpb "kythe.io/kythe/proto/xref_go_proto"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+// The call hierarchy requests were added in version 3.16 of the protocol,
+// which the lsp package does not yet describe, so the types are defined here.
+
+// serverCapabilities extends lsp.ServerCapabilities with the capabilities the
+// lsp package does not describe.
+type serverCapabilities struct {
+	lsp.ServerCapabilities
+
+	CallHierarchyProvider bool `json:"callHierarchyProvider,omitempty"`
+}
+
+// initializeResult is the result of an initialize request as sent to the
+// client, advertising the call hierarchy alongside the capabilities returned
+// by Initialize.
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities,omitempty"`
+}
+
+func withCallHierarchy(res *lsp.InitializeResult) *initializeResult {
+	return &initializeResult{
+		Capabilities: serverCapabilities{
+			ServerCapabilities:    res.Capabilities,
+			CallHierarchyProvider: true,
+		},
+	}
+}
+
+// CallHierarchyItem represents a function in a call hierarchy.
+type CallHierarchyItem struct {
+	Name           string          `json:"name"`
+	Kind           lsp.SymbolKind  `json:"kind"`
+	Detail         string          `json:"detail,omitempty"`
+	URI            lsp.DocumentURI `json:"uri"`
+	Range          lsp.Range       `json:"range"`
+	SelectionRange lsp.Range       `json:"selectionRange"`
+
+	// Data is preserved between a prepare request and the subsequent incoming
+	// and outgoing calls requests; it holds the Kythe ticket of the function.
+	Data string `json:"data,omitempty"`
+}
+
+// CallHierarchyCallsParams are the parameters of the incoming and outgoing
+// calls requests.
+type CallHierarchyCallsParams struct {
+	Item CallHierarchyItem `json:"item"`
+}
+
+// CallHierarchyIncomingCall represents the calls to an item from a caller.
+type CallHierarchyIncomingCall struct {
+	From       CallHierarchyItem `json:"from"`
+	FromRanges []lsp.Range       `json:"fromRanges"` // call sites within From
+}
+
+// CallHierarchyOutgoingCall represents the calls from an item to a callee.
+type CallHierarchyOutgoingCall struct {
+	To         CallHierarchyItem `json:"to"`
+	FromRanges []lsp.Range       `json:"fromRanges"` // call sites within the caller
+}
+
+// TextDocumentPrepareCallHierarchy produces the call hierarchy item for the
+// semantic node at a given position, if there is one.
+//
+// NOTE: As per the lsp spec, the result is either an array or null.
+func (ls *Server) TextDocumentPrepareCallHierarchy(params lsp.TextDocumentPositionParams) ([]CallHierarchyItem, error) {
+	log.Printf("Preparing call hierarchy at %v", params)
+	local, err := ls.localFromURI(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, exists := ls.docs[local]
+	if !exists {
+		log.Printf("Call hierarchy requested from unknown file %q", local)
+		return nil, nil
+	}
+
+	ref := doc.xrefs(params.Position)
+	if ref == nil {
+		log.Printf("No ref found at %v", params.Position)
+		return nil, nil
+	}
+
+	kind, ok := symbolKind(ref.nodeKind, ref.subkind)
+	if !ok {
+		kind = lsp.SKFunction
+	}
+	item := CallHierarchyItem{
+		Name:           ref.name,
+		Kind:           kind,
+		URI:            params.TextDocument.URI,
+		Range:          *ref.newRange,
+		SelectionRange: *ref.newRange,
+		Data:           ref.ticket,
+	}
+
+	// Prefer the location of the function's definition, if it is known.
+	if l, ok := doc.defLocs[ref.def]; ok {
+		loc := ls.locationInNewSource(*l)
+		item.URI, item.Range, item.SelectionRange = loc.URI, loc.Range, loc.Range
+	}
+	return []CallHierarchyItem{item}, nil
+}
+
+// CallHierarchyIncomingCalls produces the callers of a call hierarchy item,
+// together with the sites of their calls to it.
+//
+// NOTE: As per the lsp spec, the result is either an array or null.
+func (ls *Server) CallHierarchyIncomingCalls(params CallHierarchyCallsParams) ([]CallHierarchyIncomingCall, error) {
+	ticket := params.Item.Data
+	if ticket == "" {
+		return nil, nil
+	}
+	local, err := ls.localFromURI(params.Item.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	xrefs, err := ls.XRefs.CrossReferences(context.TODO(), &xpb.CrossReferencesRequest{
+		Ticket:     []string{ticket},
+		CallerKind: xpb.CrossReferencesRequest_DIRECT_CALLERS,
+		PageSize:   int32(ls.opts.pageSize()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find callers of ticket %q: %v", ticket, err)
+	}
+
+	var calls []CallHierarchyIncomingCall
+	for _, caller := range xrefs.CrossReferences[ticket].GetCaller() {
+		loc := ls.anchorToLoc(local.Workspace, caller.Anchor)
+		if loc == nil {
+			continue
+		}
+		from := ls.locationInNewSource(*loc)
+		call := CallHierarchyIncomingCall{
+			From: CallHierarchyItem{
+				Name:           markedsource.RenderSimpleIdentifier(caller.MarkedSource),
+				Kind:           lsp.SKFunction,
+				URI:            from.URI,
+				Range:          from.Range,
+				SelectionRange: from.Range,
+				Data:           caller.Ticket,
+			},
+			FromRanges: []lsp.Range{},
+		}
+		for _, site := range caller.Site {
+			if l := ls.anchorToLoc(local.Workspace, site); l != nil {
+				call.FromRanges = append(call.FromRanges, ls.locationInNewSource(*l).Range)
+			}
+		}
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
+
+// CallHierarchyOutgoingCalls produces the callees of a call hierarchy item,
+// together with the sites of its calls to them.  The callees are found with
+// the explore service, if one is available; otherwise they are found from the
+// call anchors within the item's semantic scope in the file that defines it.
+//
+// NOTE: As per the lsp spec, the result is either an array or null.
+func (ls *Server) CallHierarchyOutgoingCalls(params CallHierarchyCallsParams) ([]CallHierarchyOutgoingCall, error) {
+	ticket := params.Item.Data
+	if ticket == "" {
+		return nil, nil
+	}
+	local, err := ls.localFromURI(params.Item.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		callees []string
+		sites   map[string][]lsp.Range // :: callee → call sites
+	)
+	if ls.Explore != nil {
+		// The explore tables are optional in the serving data; without them
+		// (or without an entry for ticket) fall back to the function's scope.
+		callees, sites, err = ls.exploreCallees(local, ticket)
+		if err != nil {
+			log.Printf("Error exploring callees of %q: %v", ticket, err)
+		}
+	}
+	if err != nil || len(callees) == 0 {
+		callees, sites, err = ls.scopeCallees(local, ticket)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find callees of ticket %q: %v", ticket, err)
+	} else if len(callees) == 0 {
+		return nil, nil
+	}
+
+	// Locate and name each of the callees.
+	xrefs, err := ls.XRefs.CrossReferences(context.TODO(), &xpb.CrossReferencesRequest{
+		Ticket:         callees,
+		DefinitionKind: xpb.CrossReferencesRequest_BINDING_DEFINITIONS,
+		PageSize:       int32(ls.opts.pageSize()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find definitions of callees: %v", err)
+	}
+	names := make(map[string]string)
+	if docs, err := ls.XRefs.Documentation(context.TODO(), &xpb.DocumentationRequest{
+		Ticket: callees,
+	}); err != nil {
+		log.Printf("Error fetching documentation for callees of %q: %v", ticket, err)
+	} else {
+		for _, doc := range docs.Document {
+			names[doc.Ticket] = markedsource.RenderSimpleIdentifier(doc.MarkedSource)
+		}
+	}
+
+	var calls []CallHierarchyOutgoingCall
+	for _, callee := range callees {
+		defs := xrefs.CrossReferences[callee].GetDefinition()
+		if len(defs) == 0 {
+			continue // we have nowhere to send the client
+		}
+		loc := ls.anchorToLoc(local.Workspace, defs[0].Anchor)
+		if loc == nil {
+			continue
+		}
+		to := ls.locationInNewSource(*loc)
+		calls = append(calls, CallHierarchyOutgoingCall{
+			To: CallHierarchyItem{
+				Name:           names[callee],
+				Kind:           lsp.SKFunction,
+				URI:            to.URI,
+				Range:          to.Range,
+				SelectionRange: to.Range,
+				Data:           callee,
+			},
+			FromRanges: append([]lsp.Range{}, sites[callee]...),
+		})
+	}
+	return calls, nil
+}
+
+// exploreCallees returns the callees of ticket according to the explore
+// service, and the sites at which ticket calls each of them.
+func (ls *Server) exploreCallees(local LocalFile, ticket string) ([]string, map[string][]lsp.Range, error) {
+	reply, err := ls.Explore.Callees(context.TODO(), &epb.CalleesRequest{
+		Tickets: []string{ticket},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	callees := reply.GetGraph().GetNodes()[ticket].GetSuccessors()
+	if len(callees) == 0 {
+		return nil, nil, nil
+	}
+
+	// The call sites are those of the callers of each callee within ticket.
+	xrefs, err := ls.XRefs.CrossReferences(context.TODO(), &xpb.CrossReferencesRequest{
+		Ticket:     callees,
+		CallerKind: xpb.CrossReferencesRequest_DIRECT_CALLERS,
+		PageSize:   int32(ls.opts.pageSize()),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sites := make(map[string][]lsp.Range)
+	for _, callee := range callees {
+		for _, caller := range xrefs.CrossReferences[callee].GetCaller() {
+			if caller.Ticket != ticket {
+				continue
+			}
+			for _, site := range caller.Site {
+				if l := ls.anchorToLoc(local.Workspace, site); l != nil {
+					sites[callee] = append(sites[callee], ls.locationInNewSource(*l).Range)
+				}
+			}
+		}
+	}
+	return callees, sites, nil
+}
+
+// scopeCallees returns the targets of the call anchors in the file of local
+// whose semantic scope is ticket, and the sites of those anchors.
+func (ls *Server) scopeCallees(local LocalFile, ticket string) ([]string, map[string][]lsp.Range, error) {
+	file, err := local.KytheURI()
+	if err != nil {
+		return nil, nil, err
+	}
+	dec, err := ls.XRefs.Decorations(context.TODO(), &xpb.DecorationsRequest{
+		Location:       &xpb.Location{Ticket: file.String()},
+		References:     true,
+		SemanticScopes: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var callees []string
+	sites := make(map[string][]lsp.Range)
+	for _, r := range dec.Reference {
+		if r.SemanticScope != ticket || !edges.IsVariant(r.Kind, edges.RefCall) {
+			continue
+		}
+		rng := spanToRange(r.Span)
+		if rng == nil {
+			continue
+		}
+		if _, ok := sites[r.TargetTicket]; !ok {
+			callees = append(callees, r.TargetTicket)
+		}
+		loc := ls.locationInNewSource(lsp.Location{URI: local.URI(), Range: *rng})
+		sites[r.TargetTicket] = append(sites[r.TargetTicket], loc.Range)
+	}
+	return callees, sites, nil
+}
@@ -0,0 +1,237 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package languageserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"kythe.io/kythe/go/services/explore"
+	"kythe.io/kythe/go/test/testutil"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	epb "kythe.io/kythe/proto/explore_go_proto"
+	xpb "kythe.io/kythe/proto/xref_go_proto"
+
+	"github.com/sourcegraph/go-langserver/pkg/lsp"
+)
+
+// Tickets and source text for call hierarchy tests.
+const (
+	callFile   = "kythe://corpus?path=call.go"
+	callF      = "kythe://corpus?lang=go?path=call.go#f"
+	callG      = "kythe://corpus?lang=go?path=call.go#g"
+	callSource = "package p\nfunc f() { g() }\nfunc g() {}\n"
+)
+
+// mockExplore implements the Callees method of explore.Service.
+type mockExplore struct {
+	explore.Service
+	callees map[string][]string // :: caller → callees
+	err     error
+}
+
+func (m mockExplore) Callees(_ context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	graph := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	for _, ticket := range req.Tickets {
+		graph.Nodes[ticket] = &epb.GraphNode{Successors: m.callees[ticket]}
+	}
+	return &epb.CalleesReply{Graph: graph}, nil
+}
+
+func newCallServer(t *testing.T) (*Server, lsp.DocumentURI) {
+	identifier := func(name string) *cpb.MarkedSource {
+		return &cpb.MarkedSource{Kind: cpb.MarkedSource_IDENTIFIER, PreText: name}
+	}
+	anchor := func(name string, span *cpb.Span) *xpb.Anchor {
+		return &xpb.Anchor{Ticket: callFile + "#" + name, Parent: callFile, Span: span}
+	}
+	c := MockClient{
+		decRsp: []mockDec{{
+			ticket: callFile,
+			resp: xpb.DecorationsReply{
+				SourceText: []byte(callSource),
+				Reference: []*xpb.DecorationsReply_Reference{
+					{TargetTicket: callF, Kind: "/kythe/edge/defines/binding", Span: symSpan(2, 5, 6, 10)},
+					{TargetTicket: callG, Kind: "/kythe/edge/ref/call", Span: symSpan(2, 11, 14, 10), SemanticScope: callF},
+					{TargetTicket: callG, Kind: "/kythe/edge/defines/binding", Span: symSpan(3, 5, 6, 27)},
+				},
+				Nodes: map[string]*cpb.NodeInfo{
+					callF: symNode("function", ""),
+					callG: symNode("function", ""),
+				},
+			}}},
+		refRsp: []mockRef{{
+			ticket: callG,
+			resp: xpb.CrossReferencesReply{
+				CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+					callG: {
+						Ticket:     callG,
+						Definition: []*xpb.CrossReferencesReply_RelatedAnchor{{Anchor: anchor("gdef", symSpan(3, 5, 6, 27))}},
+						Caller: []*xpb.CrossReferencesReply_RelatedAnchor{{
+							Ticket:       callF,
+							Anchor:       anchor("fdef", symSpan(2, 5, 6, 10)),
+							MarkedSource: identifier("f"),
+							Site:         []*xpb.Anchor{anchor("call", symSpan(2, 11, 14, 10))},
+						}},
+					}}}}},
+		docRsp: []mockDoc{{
+			ticket: callG,
+			resp: xpb.DocumentationReply{
+				Document: []*xpb.DocumentationReply_Document{{Ticket: callG, MarkedSource: identifier("g")}},
+			}}},
+	}
+
+	srv := NewServer(c, &Options{
+		NewWorkspace: func(_ lsp.DocumentURI) (Workspace, error) {
+			return NewSettingsWorkspace(Settings{
+				Root: "/root/dir/",
+				Mappings: []MappingConfig{{
+					Local: ":path*",
+					VName: VNameConfig{
+						Path:   ":path*",
+						Corpus: "corpus",
+					}},
+				},
+			})
+		},
+	})
+
+	u := lsp.DocumentURI("file:///root/dir/call.go")
+	if err := srv.TextDocumentDidOpen(lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{URI: u, Text: callSource},
+	}); err != nil {
+		t.Fatalf("Unexpected error opening document (%s): %v", u, err)
+	}
+	return &srv, u
+}
+
+func TestPrepareCallHierarchy(t *testing.T) {
+	srv, u := newCallServer(t)
+	items, err := srv.TextDocumentPrepareCallHierarchy(lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: u},
+		Position:     lsp.Position{Line: 2, Character: 5},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error preparing call hierarchy: %v", err)
+	}
+
+	expected := []CallHierarchyItem{{
+		Name:           "g",
+		Kind:           lsp.SKFunction,
+		URI:            u,
+		Range:          symRange(2, 5, 6),
+		SelectionRange: symRange(2, 5, 6),
+		Data:           callG,
+	}}
+	if err := testutil.DeepEqual(expected, items); err != nil {
+		t.Errorf("Incorrect call hierarchy items: %v", err)
+	}
+}
+
+func TestCallHierarchyIncomingCalls(t *testing.T) {
+	srv, u := newCallServer(t)
+	calls, err := srv.CallHierarchyIncomingCalls(CallHierarchyCallsParams{
+		Item: CallHierarchyItem{URI: u, Data: callG},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error finding incoming calls: %v", err)
+	}
+
+	expected := []CallHierarchyIncomingCall{{
+		From: CallHierarchyItem{
+			Name:           "f",
+			Kind:           lsp.SKFunction,
+			URI:            u,
+			Range:          symRange(1, 5, 6),
+			SelectionRange: symRange(1, 5, 6),
+			Data:           callF,
+		},
+		FromRanges: []lsp.Range{symRange(1, 11, 14)},
+	}}
+	if err := testutil.DeepEqual(expected, calls); err != nil {
+		t.Errorf("Incorrect incoming calls: %v", err)
+	}
+}
+
+func TestCallHierarchyOutgoingCalls(t *testing.T) {
+	tests := []struct {
+		name    string
+		explore explore.Service
+	}{
+		{"scopes", nil},
+		{"explore", mockExplore{callees: map[string][]string{callF: {callG}}}},
+		{"explore_missing", mockExplore{}},
+		{"explore_error", mockExplore{err: errors.New("no explore tables")}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv, u := newCallServer(t)
+			srv.Explore = test.explore
+			calls, err := srv.CallHierarchyOutgoingCalls(CallHierarchyCallsParams{
+				Item: CallHierarchyItem{URI: u, Data: callF},
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error finding outgoing calls: %v", err)
+			}
+
+			expected := []CallHierarchyOutgoingCall{{
+				To: CallHierarchyItem{
+					Name:           "g",
+					Kind:           lsp.SKFunction,
+					URI:            u,
+					Range:          symRange(2, 5, 6),
+					SelectionRange: symRange(2, 5, 6),
+					Data:           callG,
+				},
+				FromRanges: []lsp.Range{symRange(1, 11, 14)},
+			}}
+			if err := testutil.DeepEqual(expected, calls); err != nil {
+				t.Errorf("Incorrect outgoing calls: %v", err)
+			}
+		})
+	}
+}
+
+func TestWithCallHierarchy(t *testing.T) {
+	srv := NewServer(nil, nil)
+	res, err := srv.Initialize(lsp.InitializeParams{})
+	if err != nil {
+		t.Fatalf("Initialize error: %v", err)
+	}
+	rec, err := json.Marshal(withCallHierarchy(res))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var caps struct {
+		Capabilities map[string]interface{} `json:"capabilities"`
+	}
+	if err := json.Unmarshal(rec, &caps); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []string{"callHierarchyProvider", "referencesProvider", "hoverProvider"} {
+		if caps.Capabilities[c] != true {
+			t.Errorf("Missing capability %q in %s", c, rec)
+		}
+	}
+}
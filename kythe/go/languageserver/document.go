@@ -258,6 +258,10 @@ diffLoop:
 type RefResolution struct {
 	ticket   string
 	def      string     // the target definition anchor ticket
+	kind     string     // the anchor's edge kind, e.g. "/kythe/edge/ref"
+	name     string     // the text spanned by the anchor in the indexed source
+	nodeKind string     // the target's node kind, if known
+	subkind  string     // the target's node subkind, if known
 	markup   string     // a rendering of marked source
 	comment  string     // if available, a comment
 	lang     string     // a language label
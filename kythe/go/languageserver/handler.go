@@ -42,7 +42,10 @@ func ServerHandler(ls *Server) jsonrpc2.Handler {
 				if err := json.Unmarshal(*req.Params, &p); err != nil {
 					return nil, err
 				}
-				ret, err = ls.Initialize(p)
+				var res *lsp.InitializeResult
+				if res, err = ls.Initialize(p); err == nil {
+					ret = withCallHierarchy(res)
+				}
 			case "textDocument/didOpen":
 				var p lsp.DidOpenTextDocumentParams
 				if err := json.Unmarshal(*req.Params, &p); err != nil {
@@ -73,6 +76,48 @@ func ServerHandler(ls *Server) jsonrpc2.Handler {
 					return nil, err
 				}
 				err = ls.TextDocumentDidClose(p)
+			case "textDocument/documentSymbol":
+				var p lsp.DocumentSymbolParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.TextDocumentDocumentSymbol(p)
+			case "workspace/symbol":
+				var p lsp.WorkspaceSymbolParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.WorkspaceSymbol(p)
+			case "textDocument/implementation":
+				var p lsp.TextDocumentPositionParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.TextDocumentImplementation(p)
+			case "textDocument/typeDefinition":
+				var p lsp.TextDocumentPositionParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.TextDocumentTypeDefinition(p)
+			case "textDocument/prepareCallHierarchy":
+				var p lsp.TextDocumentPositionParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.TextDocumentPrepareCallHierarchy(p)
+			case "callHierarchy/incomingCalls":
+				var p CallHierarchyCallsParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.CallHierarchyIncomingCalls(p)
+			case "callHierarchy/outgoingCalls":
+				var p CallHierarchyCallsParams
+				if err := json.Unmarshal(*req.Params, &p); err != nil {
+					return nil, err
+				}
+				ret, err = ls.CallHierarchyOutgoingCalls(p)
 			case "textDocument/hover":
 				var p lsp.TextDocumentPositionParams
 				if err := json.Unmarshal(*req.Params, &p); err != nil {
@@ -19,6 +19,13 @@
 // This server implements the following capabilities:
 // 		textDocumentSync (full)
 //		referenceProvider
+//		definitionProvider
+//		hoverProvider
+//		documentSymbolProvider
+//		workspaceSymbolProvider (requires an identifiers service)
+//		implementationProvider (requires a graph service)
+//		typeDefinitionProvider (requires a graph service)
+//		callHierarchyProvider
 package languageserver // import "kythe.io/kythe/go/languageserver"
 
 import (
@@ -28,11 +35,19 @@ import (
 	"log"
 	"strings"
 
+	"kythe.io/kythe/go/services/explore"
+	"kythe.io/kythe/go/services/graph"
 	"kythe.io/kythe/go/services/xrefs"
+	"kythe.io/kythe/go/serving/identifiers"
 	"kythe.io/kythe/go/util/kytheuri"
 	"kythe.io/kythe/go/util/markedsource"
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
+	gpb "kythe.io/kythe/proto/graph_go_proto"
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
 	xpb "kythe.io/kythe/proto/xref_go_proto"
 
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
@@ -44,6 +59,17 @@ type Server struct {
 	docs       map[LocalFile]*document
 	XRefs      xrefs.Service
 	opts       *Options
+
+	// Graph, if set, is used to follow the edges of the graph for document
+	// symbol containers, implementations, and type definitions.
+	Graph graph.Service
+
+	// Identifiers, if set, is used to find workspace symbols.
+	Identifiers identifiers.Service
+
+	// Explore, if set, is used to find the callees of functions in the call
+	// hierarchy.
+	Explore explore.Service
 }
 
 // Options control optional behaviours of the language server implementation.
@@ -94,9 +120,13 @@ func (ls *Server) Initialize(params lsp.InitializeParams) (*lsp.InitializeResult
 				Kind:    &fullSync,
 				Options: nil,
 			},
-			ReferencesProvider: true,
-			HoverProvider:      true,
-			DefinitionProvider: true,
+			ReferencesProvider:      true,
+			HoverProvider:           true,
+			DefinitionProvider:      true,
+			DocumentSymbolProvider:  true,
+			WorkspaceSymbolProvider: ls.Identifiers != nil,
+			ImplementationProvider:  ls.Graph != nil,
+			TypeDefinitionProvider:  ls.Graph != nil,
 		},
 	}, nil
 }
@@ -127,6 +157,7 @@ func (ls *Server) TextDocumentDidOpen(params lsp.DidOpenTextDocumentParams) erro
 		References:        true,
 		TargetDefinitions: true,
 		SourceText:        true,
+		Filter:            []string{facts.NodeKind, facts.Subkind},
 	})
 
 	if err != nil {
@@ -146,9 +177,14 @@ func (ls *Server) TextDocumentDidOpen(params lsp.DidOpenTextDocumentParams) erro
 			continue
 		}
 
+		info := dec.Nodes[r.TargetTicket]
 		refs = append(refs, &RefResolution{
 			ticket:   r.TargetTicket,
 			def:      r.TargetDefinition,
+			kind:     r.Kind,
+			name:     spanText(dec.SourceText, r.Span),
+			nodeKind: string(info.GetFacts()[facts.NodeKind]),
+			subkind:  string(info.GetFacts()[facts.Subkind]),
 			oldRange: *rng,
 		})
 	}
@@ -398,6 +434,341 @@ func (ls *Server) TextDocumentHover(params lsp.TextDocumentPositionParams) (lsp.
 	}, nil
 }
 
+// TextDocumentDocumentSymbol produces the symbols defined in a document, i.e.,
+// the targets of its binding anchors.  If a graph service is available, the
+// container of each symbol is named from its childof edges; symbols that are
+// children of functions (such as parameters and locals) are omitted.
+//
+// NOTE: As per the lsp spec, document symbols must return an error or a valid
+// array.  Therefore, if no error is returned, a non-nil slice is returned.
+func (ls *Server) TextDocumentDocumentSymbol(params lsp.DocumentSymbolParams) ([]lsp.SymbolInformation, error) {
+	local, err := ls.localFromURI(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we don't have decorations we can't find the symbols
+	doc, exists := ls.docs[local]
+	if !exists {
+		log.Printf("Symbols requested from unknown file %q", local)
+		return []lsp.SymbolInformation{}, nil
+	}
+
+	if doc.staleRefs {
+		doc.generateNewRefs()
+	}
+	var (
+		bindings []*RefResolution
+		tickets  []string
+		byTicket = make(map[string]*RefResolution)
+	)
+	for _, r := range doc.refs {
+		if r.kind != edges.DefinesBinding || r.newRange == nil || r.name == "" {
+			continue
+		}
+		if _, ok := byTicket[r.ticket]; !ok {
+			byTicket[r.ticket] = r
+			tickets = append(tickets, r.ticket)
+		}
+		bindings = append(bindings, r)
+	}
+
+	// Find the parents of each symbol, if possible.
+	parents := make(map[string]string)
+	parentKinds := make(map[string]string)
+	if ls.Graph != nil && len(tickets) != 0 {
+		reply, err := graph.AllEdges(context.TODO(), ls.Graph, &gpb.EdgesRequest{
+			Ticket: tickets,
+			Kind:   []string{edges.ChildOf},
+			Filter: []string{facts.NodeKind},
+		})
+		if err != nil {
+			log.Printf("Error fetching parents of symbols in %q: %v", local, err)
+		} else {
+			for ticket, es := range reply.EdgeSets {
+				for _, e := range es.Groups[edges.ChildOf].GetEdge() {
+					parents[ticket] = e.TargetTicket
+					break
+				}
+			}
+			for ticket, info := range reply.Nodes {
+				parentKinds[ticket] = string(info.GetFacts()[facts.NodeKind])
+			}
+		}
+	}
+
+	syms := []lsp.SymbolInformation{}
+	for _, r := range bindings {
+		kind, ok := symbolKind(r.nodeKind, r.subkind)
+		if !ok {
+			continue
+		}
+		var container string
+		if p, ok := parents[r.ticket]; ok {
+			if parentKinds[p] == nodes.Function {
+				continue // a parameter or local of a function
+			}
+			if pr, ok := byTicket[p]; ok {
+				container = pr.name
+				if kind == lsp.SKFunction {
+					kind = lsp.SKMethod
+				}
+			}
+		}
+		syms = append(syms, lsp.SymbolInformation{
+			Name:          r.name,
+			Kind:          kind,
+			Location:      lsp.Location{URI: params.TextDocument.URI, Range: *r.newRange},
+			ContainerName: container,
+		})
+	}
+	return syms, nil
+}
+
+// WorkspaceSymbol finds the symbols whose qualified name matches the query
+// using the identifiers service, and produces the locations of their binding
+// definitions within the known workspaces.
+//
+// NOTE: As per the lsp spec, workspace symbols must return an error or a valid
+// array.  Therefore, if no error is returned, a non-nil slice is returned.
+func (ls *Server) WorkspaceSymbol(params lsp.WorkspaceSymbolParams) ([]lsp.SymbolInformation, error) {
+	log.Printf("Searching for workspace symbols matching %q", params.Query)
+	if ls.Identifiers == nil || params.Query == "" {
+		return []lsp.SymbolInformation{}, nil
+	}
+
+	// Queries are usually partially typed names, so names starting with the
+	// query are listed first, followed by those most similar to it.
+	var tickets []string
+	matches := make(map[string]*ipb.FindReply_Match)
+	for _, kind := range []ipb.FindRequest_MatchKind{ipb.FindRequest_PREFIX, ipb.FindRequest_FUZZY} {
+		if params.Limit > 0 && len(tickets) >= params.Limit {
+			break
+		}
+		found, err := ls.Identifiers.Find(context.TODO(), &ipb.FindRequest{
+			Identifier:      params.Query,
+			MatchKind:       kind,
+			CaseInsensitive: true,
+			PageSize:        int32(ls.opts.pageSize()),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find identifier %q: %v", params.Query, err)
+		}
+		for _, m := range found.GetMatches() {
+			if _, ok := matches[m.Ticket]; !ok {
+				matches[m.Ticket] = m
+				tickets = append(tickets, m.Ticket)
+			}
+		}
+	}
+	if len(tickets) == 0 {
+		return []lsp.SymbolInformation{}, nil
+	}
+
+	xrefs, err := ls.XRefs.CrossReferences(context.TODO(), &xpb.CrossReferencesRequest{
+		Ticket:         tickets,
+		DefinitionKind: xpb.CrossReferencesRequest_BINDING_DEFINITIONS,
+		PageSize:       int32(ls.opts.pageSize()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find definitions for %q: %v", params.Query, err)
+	}
+
+	syms := []lsp.SymbolInformation{}
+	for _, ticket := range tickets {
+		m := matches[ticket]
+		kind, ok := symbolKind(m.NodeKind, m.NodeSubkind)
+		if !ok {
+			continue
+		}
+		container := strings.TrimSuffix(strings.TrimSuffix(m.QualifiedName, m.BaseName), ".")
+		for _, def := range xrefs.CrossReferences[ticket].GetDefinition() {
+			loc := ls.anchorToKnownLoc(def.Anchor)
+			if loc == nil {
+				continue
+			}
+			syms = append(syms, lsp.SymbolInformation{
+				Name:          m.BaseName,
+				Kind:          kind,
+				Location:      ls.locationInNewSource(*loc),
+				ContainerName: container,
+			})
+			if params.Limit > 0 && len(syms) >= params.Limit {
+				return syms, nil
+			}
+		}
+	}
+	return syms, nil
+}
+
+// TextDocumentImplementation uses a position in code to produce the locations
+// of the definitions of the nodes that implement the semantic node at that
+// position, i.e., those that satisfy, extend, or override it.
+//
+// NOTE: As per the lsp spec, implementation must return an error or a valid
+// array.  Therefore, if no error is returned, a non-nil slice is returned.
+func (ls *Server) TextDocumentImplementation(params lsp.TextDocumentPositionParams) ([]lsp.Location, error) {
+	log.Printf("Searching for implementations at %v", params)
+	return ls.relatedDefinitions(params, func(ticket string) ([]string, error) {
+		return ls.edgeTargets(ticket, edges.Mirror(edges.Satisfies), edges.Mirror(edges.Extends), edges.Mirror(edges.Overrides))
+	})
+}
+
+// TextDocumentTypeDefinition uses a position in code to produce the locations
+// of the definitions of the type of the semantic node at that position.  For
+// type applications, the definition of the type constructor is used.
+//
+// NOTE: As per the lsp spec, type definition must return an error or a valid
+// array.  Therefore, if no error is returned, a non-nil slice is returned.
+func (ls *Server) TextDocumentTypeDefinition(params lsp.TextDocumentPositionParams) ([]lsp.Location, error) {
+	log.Printf("Searching for type definition at %v", params)
+	return ls.relatedDefinitions(params, func(ticket string) ([]string, error) {
+		reply, err := graph.AllEdges(context.TODO(), ls.Graph, &gpb.EdgesRequest{
+			Ticket: []string{ticket},
+			Kind:   []string{edges.Typed},
+			Filter: []string{facts.NodeKind},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var types []string
+		for _, e := range reply.EdgeSets[ticket].GetGroups()[edges.Typed].GetEdge() {
+			if string(reply.Nodes[e.TargetTicket].GetFacts()[facts.NodeKind]) != nodes.TApp {
+				types = append(types, e.TargetTicket)
+				continue
+			}
+			// Use the type constructor of a type application.
+			ctors, err := ls.edgeTargets(e.TargetTicket, edges.ParamIndex(0))
+			if err != nil {
+				return nil, err
+			}
+			types = append(types, ctors...)
+		}
+		return types, nil
+	})
+}
+
+// relatedDefinitions finds the semantic node at the given position, and
+// produces the locations of the binding definitions of the nodes that related
+// reports for it.  It requires a graph service.
+func (ls *Server) relatedDefinitions(params lsp.TextDocumentPositionParams, related func(ticket string) ([]string, error)) ([]lsp.Location, error) {
+	if ls.Graph == nil {
+		return []lsp.Location{}, nil
+	}
+	local, err := ls.localFromURI(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, exists := ls.docs[local]
+	if !exists {
+		log.Printf("Related definitions requested from unknown file %q", local)
+		return []lsp.Location{}, nil
+	}
+
+	ref := doc.xrefs(params.Position)
+	if ref == nil {
+		log.Printf("No ref found at %v", params.Position)
+		return []lsp.Location{}, nil
+	}
+
+	tickets, err := related(ref.ticket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find related nodes for ticket %q: %v", ref.ticket, err)
+	} else if len(tickets) == 0 {
+		return []lsp.Location{}, nil
+	}
+
+	xrefs, err := ls.XRefs.CrossReferences(context.TODO(), &xpb.CrossReferencesRequest{
+		Ticket:         tickets,
+		DefinitionKind: xpb.CrossReferencesRequest_BINDING_DEFINITIONS,
+		PageSize:       int32(ls.opts.pageSize()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find definitions for %v: %v", tickets, err)
+	}
+
+	locs := []lsp.Location{}
+	for _, ticket := range tickets {
+		if set := xrefs.CrossReferences[ticket]; set != nil {
+			locs = append(locs, ls.refLocs(local.Workspace, set)...)
+		}
+	}
+	return locs, nil
+}
+
+// edgeTargets returns the targets of the edges of the given kinds from ticket.
+func (ls *Server) edgeTargets(ticket string, kinds ...string) ([]string, error) {
+	reply, err := graph.AllEdges(context.TODO(), ls.Graph, &gpb.EdgesRequest{
+		Ticket: []string{ticket},
+		Kind:   kinds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var targets []string
+	for _, kind := range kinds {
+		for _, e := range reply.EdgeSets[ticket].GetGroups()[kind].GetEdge() {
+			targets = append(targets, e.TargetTicket)
+		}
+	}
+	return targets, nil
+}
+
+// symbolKind returns the LSP symbol kind corresponding to a Kythe node kind
+// and subkind, and reports whether the node should be considered a symbol.
+func symbolKind(kind, subkind string) (lsp.SymbolKind, bool) {
+	switch kind {
+	case nodes.Function:
+		return lsp.SKFunction, true
+	case nodes.Record:
+		switch subkind {
+		case nodes.Struct:
+			return lsp.SKStruct, true
+		case nodes.Enum, nodes.EnumClass:
+			return lsp.SKEnum, true
+		}
+		return lsp.SKClass, true
+	case nodes.Interface:
+		return lsp.SKInterface, true
+	case nodes.Variable:
+		if subkind == nodes.Field {
+			return lsp.SKField, true
+		}
+		return lsp.SKVariable, true
+	case nodes.Constant:
+		return lsp.SKConstant, true
+	case nodes.Package:
+		return lsp.SKPackage, true
+	case nodes.TVar:
+		return lsp.SKTypeParameter, true
+	case nodes.TAlias:
+		return lsp.SKClass, true
+	}
+	return 0, false
+}
+
+// spanText returns the text of src within s, or "" if s is not a valid span
+// of src.
+func spanText(src []byte, s *cpb.Span) string {
+	start, end := s.GetStart().GetByteOffset(), s.GetEnd().GetByteOffset()
+	if start < 0 || end <= start || int(end) > len(src) {
+		return ""
+	}
+	return string(src[start:end])
+}
+
+// anchorToKnownLoc returns the location of a in the first known workspace
+// that contains it, or nil if there is none.
+func (ls *Server) anchorToKnownLoc(a *xpb.Anchor) *lsp.Location {
+	for _, w := range ls.workspaces {
+		if loc := ls.anchorToLoc(w, a); loc != nil {
+			return loc
+		}
+	}
+	return nil
+}
+
 func (ls *Server) localFromURI(u lsp.DocumentURI) (LocalFile, error) {
 	for _, w := range ls.workspaces {
 		local, err := w.LocalFromURI(u)
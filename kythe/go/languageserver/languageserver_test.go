@@ -19,12 +19,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"kythe.io/kythe/go/test/testutil"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
 	gpb "kythe.io/kythe/proto/graph_go_proto"
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
 	xpb "kythe.io/kythe/proto/xref_go_proto"
 
 	"github.com/sourcegraph/go-langserver/pkg/lsp"
@@ -42,10 +44,15 @@ type mockDoc struct {
 	ticket string
 	resp   xpb.DocumentationReply
 }
+type mockEdges struct {
+	ticket string
+	resp   *gpb.EdgesReply
+}
 type MockClient struct {
-	decRsp []mockDec
-	refRsp []mockRef
-	docRsp []mockDoc
+	decRsp  []mockDec
+	refRsp  []mockRef
+	docRsp  []mockDoc
+	edgeRsp []mockEdges
 }
 
 func (c MockClient) Decorations(_ context.Context, d *xpb.DecorationsRequest) (*xpb.DecorationsReply, error) {
@@ -73,7 +80,12 @@ func (c MockClient) Documentation(_ context.Context, x *xpb.DocumentationRequest
 	return nil, fmt.Errorf("no CrossReferences Found")
 }
 func (c MockClient) Edges(_ context.Context, x *gpb.EdgesRequest) (*gpb.EdgesReply, error) {
-	return nil, fmt.Errorf("not Implemented")
+	for _, r := range c.edgeRsp {
+		if r.ticket == x.Ticket[0] {
+			return r.resp, nil
+		}
+	}
+	return &gpb.EdgesReply{}, nil
 }
 func (c MockClient) Nodes(_ context.Context, x *gpb.NodesRequest) (*gpb.NodesReply, error) {
 	return nil, fmt.Errorf("not Implemented")
@@ -294,3 +306,266 @@ func TestReferences(t *testing.T) {
 		t.Errorf("Hover results:\ngot  %+v\nwant %+v", hovExpected, hover)
 	}
 }
+
+// mockIdentifiers matches its identifiers case-insensitively, treating FUZZY
+// queries as subsequences of the qualified name, and records the kinds of
+// matches requested.
+type mockIdentifiers struct {
+	matches []*ipb.FindReply_Match
+	kinds   []ipb.FindRequest_MatchKind
+}
+
+func (m *mockIdentifiers) Find(_ context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
+	m.kinds = append(m.kinds, req.MatchKind)
+	ident := strings.ToLower(req.Identifier)
+	var reply ipb.FindReply
+	for _, match := range m.matches {
+		base, qname := strings.ToLower(match.BaseName), strings.ToLower(match.QualifiedName)
+		var ok bool
+		switch req.MatchKind {
+		case ipb.FindRequest_EXACT:
+			ok = qname == ident
+		case ipb.FindRequest_PREFIX:
+			ok = strings.HasPrefix(base, ident) || strings.HasPrefix(qname, ident)
+		case ipb.FindRequest_FUZZY:
+			ok = isSubsequence(ident, qname)
+		}
+		if ok {
+			reply.Matches = append(reply.Matches, match)
+		}
+	}
+	return &reply, nil
+}
+
+// isSubsequence reports whether the runes of sub appear in order in s.
+func isSubsequence(sub, s string) bool {
+	for _, r := range sub {
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
+}
+
+// Tickets and source text for symbol tests.
+const (
+	symFile = "kythe://corpus?path=sym.go"
+	symI    = "kythe://corpus?lang=go?path=sym.go#I"
+	symM    = "kythe://corpus?lang=go?path=sym.go#M"
+	symT    = "kythe://corpus?lang=go?path=sym.go#T"
+	symF    = "kythe://corpus?lang=go?path=sym.go#f"
+	symV    = "kythe://corpus?lang=go?path=sym.go#v"
+	symPkg  = "kythe://corpus?lang=go?path=sym.go#package"
+
+	symSource = "package p\ntype I interface{ M() }\ntype T struct{ f int }\nvar v T\n"
+)
+
+// symSpan returns a single-line span on the given 1-based line.
+func symSpan(line, start, end, lineOffset int32) *cpb.Span {
+	return &cpb.Span{
+		Start: &cpb.Point{LineNumber: line, ColumnOffset: start, ByteOffset: lineOffset + start},
+		End:   &cpb.Point{LineNumber: line, ColumnOffset: end, ByteOffset: lineOffset + end},
+	}
+}
+
+func symNode(kind, subkind string) *cpb.NodeInfo {
+	info := &cpb.NodeInfo{Facts: map[string][]byte{"/kythe/node/kind": []byte(kind)}}
+	if subkind != "" {
+		info.Facts["/kythe/subkind"] = []byte(subkind)
+	}
+	return info
+}
+
+func childOf(parent string) *gpb.EdgeSet {
+	return &gpb.EdgeSet{Groups: map[string]*gpb.EdgeSet_Group{
+		"/kythe/edge/childof": {Edge: []*gpb.EdgeSet_Group_Edge{{TargetTicket: parent}}},
+	}}
+}
+
+func newSymbolServer(t *testing.T) (*Server, lsp.DocumentURI) {
+	binding := func(ticket string, span *cpb.Span) *xpb.DecorationsReply_Reference {
+		return &xpb.DecorationsReply_Reference{TargetTicket: ticket, Kind: "/kythe/edge/defines/binding", Span: span}
+	}
+	tDef := &xpb.Anchor{Ticket: symFile + "#tdef", Parent: symFile, Span: symSpan(3, 5, 6, 34)}
+	c := MockClient{
+		decRsp: []mockDec{{
+			ticket: symFile,
+			resp: xpb.DecorationsReply{
+				SourceText: []byte(symSource),
+				Reference: []*xpb.DecorationsReply_Reference{
+					binding(symI, symSpan(2, 5, 6, 10)),
+					binding(symM, symSpan(2, 18, 19, 10)),
+					binding(symT, symSpan(3, 5, 6, 34)),
+					binding(symF, symSpan(3, 15, 16, 34)),
+					binding(symV, symSpan(4, 4, 5, 57)),
+					{TargetTicket: symT, Kind: "/kythe/edge/ref", Span: symSpan(4, 6, 7, 57)},
+				},
+				Nodes: map[string]*cpb.NodeInfo{
+					symI: symNode("interface", ""),
+					symM: symNode("function", ""),
+					symT: symNode("record", "struct"),
+					symF: symNode("variable", "field"),
+					symV: symNode("variable", ""),
+				},
+			}}},
+		refRsp: []mockRef{{
+			ticket: symT,
+			resp: xpb.CrossReferencesReply{
+				CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+					symT: {
+						Ticket:     symT,
+						Definition: []*xpb.CrossReferencesReply_RelatedAnchor{{Anchor: tDef}},
+					}}}}},
+		edgeRsp: []mockEdges{{
+			ticket: symI,
+			resp: &gpb.EdgesReply{
+				EdgeSets: map[string]*gpb.EdgeSet{
+					symI: {Groups: map[string]*gpb.EdgeSet_Group{
+						"%/kythe/edge/satisfies": {Edge: []*gpb.EdgeSet_Group_Edge{{TargetTicket: symT}}},
+						"/kythe/edge/childof":    {Edge: []*gpb.EdgeSet_Group_Edge{{TargetTicket: symPkg}}},
+					}},
+					symM: childOf(symI),
+					symT: childOf(symPkg),
+					symF: childOf(symT),
+					symV: childOf(symPkg),
+				},
+				Nodes: map[string]*cpb.NodeInfo{
+					symI:   symNode("interface", ""),
+					symT:   symNode("record", "struct"),
+					symPkg: symNode("package", ""),
+				},
+			}}, {
+			ticket: symV,
+			resp: &gpb.EdgesReply{
+				EdgeSets: map[string]*gpb.EdgeSet{
+					symV: {Groups: map[string]*gpb.EdgeSet_Group{
+						"/kythe/edge/typed": {Edge: []*gpb.EdgeSet_Group_Edge{{TargetTicket: symT}}},
+					}},
+				},
+				Nodes: map[string]*cpb.NodeInfo{symT: symNode("record", "struct")},
+			}}},
+	}
+
+	srv := NewServer(c, &Options{
+		NewWorkspace: func(_ lsp.DocumentURI) (Workspace, error) {
+			return NewSettingsWorkspace(Settings{
+				Root: "/root/dir/",
+				Mappings: []MappingConfig{{
+					Local: ":path*",
+					VName: VNameConfig{
+						Path:   ":path*",
+						Corpus: "corpus",
+					}},
+				},
+			})
+		},
+	})
+	srv.Graph = c
+	srv.Identifiers = &mockIdentifiers{matches: []*ipb.FindReply_Match{
+		{Ticket: symT, NodeKind: "record", NodeSubkind: "struct", BaseName: "T", QualifiedName: "p.T"},
+	}}
+
+	u := lsp.DocumentURI("file:///root/dir/sym.go")
+	if err := srv.TextDocumentDidOpen(lsp.DidOpenTextDocumentParams{
+		TextDocument: lsp.TextDocumentItem{URI: u, Text: symSource},
+	}); err != nil {
+		t.Fatalf("Unexpected error opening document (%s): %v", u, err)
+	}
+	return &srv, u
+}
+
+func symRange(line, start, end int) lsp.Range {
+	return lsp.Range{
+		Start: lsp.Position{Line: line, Character: start},
+		End:   lsp.Position{Line: line, Character: end},
+	}
+}
+
+func TestDocumentSymbol(t *testing.T) {
+	srv, u := newSymbolServer(t)
+	syms, err := srv.TextDocumentDocumentSymbol(lsp.DocumentSymbolParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: u},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error finding document symbols: %v", err)
+	}
+
+	expected := []lsp.SymbolInformation{
+		{Name: "I", Kind: lsp.SKInterface, Location: lsp.Location{URI: u, Range: symRange(1, 5, 6)}},
+		{Name: "M", Kind: lsp.SKMethod, Location: lsp.Location{URI: u, Range: symRange(1, 18, 19)}, ContainerName: "I"},
+		{Name: "T", Kind: lsp.SKStruct, Location: lsp.Location{URI: u, Range: symRange(2, 5, 6)}},
+		{Name: "f", Kind: lsp.SKField, Location: lsp.Location{URI: u, Range: symRange(2, 15, 16)}, ContainerName: "T"},
+		{Name: "v", Kind: lsp.SKVariable, Location: lsp.Location{URI: u, Range: symRange(3, 4, 5)}},
+	}
+	if err := testutil.DeepEqual(expected, syms); err != nil {
+		t.Errorf("Incorrect document symbols: %v", err)
+	}
+}
+
+func TestWorkspaceSymbol(t *testing.T) {
+	srv, u := newSymbolServer(t)
+	expected := []lsp.SymbolInformation{{
+		Name:          "T",
+		Kind:          lsp.SKStruct,
+		Location:      lsp.Location{URI: u, Range: symRange(2, 5, 6)},
+		ContainerName: "p",
+	}}
+
+	for _, query := range []string{
+		"p.T", // qualified name prefix
+		"t",   // base name prefix, in any case
+		"pt",  // fuzzy match
+	} {
+		syms, err := srv.WorkspaceSymbol(lsp.WorkspaceSymbolParams{Query: query})
+		if err != nil {
+			t.Fatalf("Unexpected error finding workspace symbols for %q: %v", query, err)
+		}
+		if err := testutil.DeepEqual(expected, syms); err != nil {
+			t.Errorf("Incorrect workspace symbols for %q: %v", query, err)
+		}
+	}
+	kinds := srv.Identifiers.(*mockIdentifiers).kinds
+	if want := ipb.FindRequest_PREFIX; len(kinds) == 0 || kinds[0] != want {
+		t.Errorf("First identifier match kind: got %v; want %v", kinds, want)
+	}
+	for _, kind := range kinds {
+		if kind != ipb.FindRequest_PREFIX && kind != ipb.FindRequest_FUZZY {
+			t.Errorf("Unexpected identifier match kind: %v", kind)
+		}
+	}
+
+	if syms, err := srv.WorkspaceSymbol(lsp.WorkspaceSymbolParams{Query: "p.Missing"}); err != nil {
+		t.Errorf("Unexpected error finding workspace symbols: %v", err)
+	} else if len(syms) != 0 {
+		t.Errorf("Unexpected workspace symbols: %v", syms)
+	}
+}
+
+func TestImplementationAndTypeDefinition(t *testing.T) {
+	srv, u := newSymbolServer(t)
+	expected := []lsp.Location{{URI: u, Range: symRange(2, 5, 6)}}
+
+	impls, err := srv.TextDocumentImplementation(lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: u},
+		Position:     lsp.Position{Line: 1, Character: 5},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error finding implementations: %v", err)
+	}
+	if err := testutil.DeepEqual(expected, impls); err != nil {
+		t.Errorf("Incorrect implementations: %v", err)
+	}
+
+	types, err := srv.TextDocumentTypeDefinition(lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: u},
+		Position:     lsp.Position{Line: 3, Character: 4},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error finding type definitions: %v", err)
+	}
+	if err := testutil.DeepEqual(expected, types); err != nil {
+		t.Errorf("Incorrect type definitions: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+)
+
+func (d *Driver) outputBufferSize() int {
+	if d.OutputBufferSize <= 0 {
+		return DefaultOutputBufferSize
+	}
+	return d.OutputBufferSize
+}
+
+// runConcurrent implements Run for d.Concurrency > 1.  A single goroutine
+// consumes the queue, starting an analysis for each compilation once fewer
+// than d.Concurrency analyses are in flight.  Another goroutine passes the
+// outputs of those analyses to d.WriteOutput.
+func (d *Driver) runConcurrent(ctx context.Context, queue Queue) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	// Each channel sent on pending carries outputs to be written.  With
+	// OrderedOutput, each analysis has its own channel and pending preserves the
+	// queue order; otherwise, all analyses share a single channel.
+	pending := make(chan chan *apb.AnalysisOutput, d.Concurrency)
+	var shared chan *apb.AnalysisOutput
+	if !d.OrderedOutput {
+		shared = make(chan *apb.AnalysisOutput, d.outputBufferSize())
+		pending <- shared
+	}
+	g.Go(func() error { return d.deliverOutputs(ctx, pending) })
+
+	g.Go(func() error {
+		defer close(pending)
+
+		var inFlight sync.WaitGroup
+		if shared != nil {
+			defer close(shared)
+		}
+		defer inFlight.Wait()
+
+		sem := make(chan struct{}, d.Concurrency)
+		for {
+			err := queue.Next(ctx, func(_ context.Context, cu Compilation) error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+
+				outputs := shared
+				if outputs == nil {
+					outputs = make(chan *apb.AnalysisOutput, d.outputBufferSize())
+					select {
+					case pending <- outputs:
+					case <-ctx.Done():
+						<-sem
+						return ctx.Err()
+					}
+				}
+
+				inFlight.Add(1)
+				g.Go(func() error {
+					defer func() { <-sem }()
+					defer inFlight.Done()
+					if outputs != shared {
+						defer close(outputs)
+					}
+					return d.analyze(ctx, cu, func(ctx context.Context, out *apb.AnalysisOutput) error {
+						select {
+						case outputs <- out:
+							return nil
+						case <-ctx.Done():
+							return ctx.Err()
+						}
+					})
+				})
+				return nil
+			})
+			if err == ErrEndOfQueue {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+
+	return g.Wait()
+}
+
+// deliverOutputs passes each output received from the channels sent on pending
+// to d.WriteOutput, draining each channel in turn until pending is closed.
+func (d *Driver) deliverOutputs(ctx context.Context, pending <-chan chan *apb.AnalysisOutput) error {
+	for outputs := range pending {
+		for out := range outputs {
+			if err := ctx.Err(); err != nil {
+				return err
+			} else if err := d.writeOutput(ctx, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
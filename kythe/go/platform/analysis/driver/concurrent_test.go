@@ -0,0 +1,276 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"kythe.io/kythe/go/platform/analysis"
+
+	"github.com/google/go-cmp/cmp"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+)
+
+// concurrentMock is a Queue and CompilationAnalyzer safe for use with a
+// concurrent Driver.  Each compilation produces numOutputs outputs named after
+// its signature.  Earlier compilations take longer to analyze so that
+// analyses complete out of order.
+type concurrentMock struct {
+	compilations []Compilation
+	idx          int
+
+	numOutputs int
+	failOn     string // signature of a compilation whose analysis fails
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	setups      int
+	teardowns   int
+}
+
+var errConcurrentAnalysis = errors.New("concurrent analysis error")
+
+func (m *concurrentMock) Next(ctx context.Context, f CompilationFunc) error {
+	if m.idx >= len(m.compilations) {
+		return ErrEndOfQueue
+	}
+	err := f(ctx, m.compilations[m.idx])
+	m.idx++
+	return err
+}
+
+func (m *concurrentMock) Analyze(ctx context.Context, req *apb.AnalysisRequest, out analysis.OutputFunc) error {
+	m.mu.Lock()
+	m.inFlight++
+	if m.inFlight > m.maxInFlight {
+		m.maxInFlight = m.inFlight
+	}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.inFlight--
+		m.mu.Unlock()
+	}()
+
+	sig := req.Compilation.VName.Signature
+	if sig == m.failOn {
+		return errConcurrentAnalysis
+	}
+	delay := time.Duration(len(m.compilations)-m.index(sig)) * 5 * time.Millisecond
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	for i := 0; i < m.numOutputs; i++ {
+		if err := out(ctx, &apb.AnalysisOutput{Value: []byte(fmt.Sprintf("%s:%d", sig, i))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *concurrentMock) index(sig string) int {
+	for i, c := range m.compilations {
+		if c.Unit.VName.Signature == sig {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *concurrentMock) context() Context {
+	return testContext{
+		setup: func(context.Context, Compilation) error {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			m.setups++
+			return nil
+		},
+		teardown: func(context.Context, Compilation) error {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			m.teardowns++
+			return nil
+		},
+	}
+}
+
+// expectedOutputs returns the outputs of m's compilations in queue order.
+func (m *concurrentMock) expectedOutputs() []string {
+	var outs []string
+	for _, c := range m.compilations {
+		for i := 0; i < m.numOutputs; i++ {
+			outs = append(outs, fmt.Sprintf("%s:%d", c.Unit.VName.Signature, i))
+		}
+	}
+	return outs
+}
+
+func newConcurrentMock(numOutputs int, sigs ...string) *concurrentMock {
+	return &concurrentMock{compilations: comps(sigs...), numOutputs: numOutputs}
+}
+
+// collect returns an OutputFunc that appends each output's value to *outs.
+// Since the Driver only writes outputs from a single goroutine, it does not
+// need to synchronize.
+func collect(outs *[]string) analysis.OutputFunc {
+	return func(_ context.Context, out *apb.AnalysisOutput) error {
+		*outs = append(*outs, string(out.Value))
+		return nil
+	}
+}
+
+func TestDriverConcurrentOrdered(t *testing.T) {
+	m := newConcurrentMock(3, "a", "b", "c", "d", "e", "f")
+	var found []string
+	d := &Driver{
+		Analyzer:         m,
+		Context:          m.context(),
+		WriteOutput:      collect(&found),
+		Concurrency:      3,
+		OutputBufferSize: 1,
+		OrderedOutput:    true,
+	}
+	if err := d.Run(context.Background(), m); err != nil {
+		t.Fatalf("Driver error: %v", err)
+	}
+	if diff := cmp.Diff(m.expectedOutputs(), found); diff != "" {
+		t.Errorf("Unexpected outputs: (- expected; + found)\n%s", diff)
+	}
+	if m.maxInFlight > d.Concurrency {
+		t.Errorf("Found %d concurrent analyses; expected at most %d", m.maxInFlight, d.Concurrency)
+	}
+	if m.setups != len(m.compilations) || m.teardowns != len(m.compilations) {
+		t.Errorf("Found %d setups and %d teardowns; expected %d of each", m.setups, m.teardowns, len(m.compilations))
+	}
+}
+
+func TestDriverConcurrentUnordered(t *testing.T) {
+	m := newConcurrentMock(4, "a", "b", "c", "d", "e")
+	var found []string
+	d := &Driver{
+		Analyzer:    m,
+		WriteOutput: collect(&found),
+		Concurrency: 2,
+	}
+	if err := d.Run(context.Background(), m); err != nil {
+		t.Fatalf("Driver error: %v", err)
+	}
+	expected := m.expectedOutputs()
+	sort.Strings(expected)
+	sort.Strings(found)
+	if diff := cmp.Diff(expected, found); diff != "" {
+		t.Errorf("Unexpected outputs: (- expected; + found)\n%s", diff)
+	}
+	if m.maxInFlight > d.Concurrency {
+		t.Errorf("Found %d concurrent analyses; expected at most %d", m.maxInFlight, d.Concurrency)
+	}
+}
+
+func TestDriverConcurrentAnalyzeError(t *testing.T) {
+	m := newConcurrentMock(2, "a", "b", "c", "d", "e", "f", "g", "h")
+	m.failOn = "b"
+	d := &Driver{
+		Analyzer:    m,
+		Context:     m.context(),
+		Concurrency: 2,
+	}
+	if err := d.Run(context.Background(), m); err != errConcurrentAnalysis {
+		t.Errorf("Expected error %v; found %v", errConcurrentAnalysis, err)
+	}
+	if m.idx == len(m.compilations) {
+		t.Errorf("Expected the queue to be abandoned after the analysis error")
+	}
+	if m.setups != m.teardowns {
+		t.Errorf("Found %d setups but %d teardowns", m.setups, m.teardowns)
+	}
+}
+
+func TestDriverConcurrentOutputError(t *testing.T) {
+	m := newConcurrentMock(3, "a", "b", "c", "d")
+	errOutput := errors.New("output error")
+	d := &Driver{
+		Analyzer: m,
+		WriteOutput: func(context.Context, *apb.AnalysisOutput) error {
+			return errOutput
+		},
+		Concurrency:   2,
+		OrderedOutput: true,
+	}
+	if err := d.Run(context.Background(), m); err != errOutput {
+		t.Errorf("Expected error %v; found %v", errOutput, err)
+	}
+}
+
+func TestDriverConcurrentRetry(t *testing.T) {
+	m := newConcurrentMock(1, "a", "b", "c")
+	m.failOn = "b"
+	var (
+		mu      sync.Mutex
+		retries int
+	)
+	d := &Driver{
+		Analyzer: m,
+		Context: testContext{
+			analysisError: func(_ context.Context, cu Compilation, err error) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if retries++; retries < 3 {
+					return ErrRetry
+				}
+				return nil // give up on the compilation, without failing
+			},
+		},
+		Concurrency: 2,
+	}
+	if err := d.Run(context.Background(), m); err != nil {
+		t.Fatalf("Driver error: %v", err)
+	}
+	if retries != 3 {
+		t.Errorf("Expected 3 calls to AnalysisError; found %d", retries)
+	}
+}
+
+func TestDriverConcurrentCancel(t *testing.T) {
+	m := newConcurrentMock(1, "a", "b", "c", "d", "e", "f")
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Driver{
+		Analyzer: m,
+		Context: testContext{
+			setup: func(context.Context, Compilation) error {
+				cancel()
+				return nil
+			},
+		},
+		Concurrency: 3,
+	}
+	if err := d.Run(ctx, m); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected error %v; found %v", context.Canceled, err)
+	}
+	if m.idx == len(m.compilations) {
+		t.Errorf("Expected the queue to be abandoned after cancellation")
+	}
+}
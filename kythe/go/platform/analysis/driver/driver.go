@@ -87,7 +87,8 @@ type AnalysisOptions struct {
 	Timeout time.Duration
 }
 
-// Driver sends compilations sequentially from a queue to an analyzer.
+// Driver sends compilations from a queue to an analyzer.  By default,
+// compilations are analyzed sequentially; see Concurrency.
 type Driver struct {
 	Analyzer        analysis.CompilationAnalyzer
 	AnalysisOptions AnalysisOptions
@@ -95,8 +96,34 @@ type Driver struct {
 	FileDataService string
 	Context         Context             // if nil, callbacks are no-ops
 	WriteOutput     analysis.OutputFunc // if nil, output is discarded
+
+	// Concurrency is the maximum number of compilations analyzed at once.  If
+	// Concurrency <= 1, each compilation is analyzed in turn.
+	//
+	// Otherwise, the Queue is still consumed by a single goroutine, but the
+	// CompilationFunc passed to it returns once the compilation's analysis has
+	// been started, so the Queue must keep any state needed by the analysis
+	// valid until Run returns.  The Context callbacks may be invoked
+	// concurrently for different compilations, while WriteOutput is only ever
+	// invoked by a single goroutine.
+	Concurrency int
+
+	// OutputBufferSize bounds the number of outputs buffered for delivery to
+	// WriteOutput when Concurrency > 1: per compilation if OrderedOutput is set
+	// or overall otherwise.  Analyses block while their buffer is full.  If
+	// OutputBufferSize <= 0, DefaultOutputBufferSize is used.
+	OutputBufferSize int
+
+	// OrderedOutput, if set, causes the outputs of each compilation to be
+	// delivered contiguously and in the order in which compilations were
+	// received from the Queue when Concurrency > 1.  Otherwise, outputs are
+	// delivered as they are produced and may interleave across compilations.
+	OrderedOutput bool
 }
 
+// DefaultOutputBufferSize is the default value of Driver.OutputBufferSize.
+const DefaultOutputBufferSize = 1024
+
 func (d *Driver) writeOutput(ctx context.Context, out *apb.AnalysisOutput) error {
 	if write := d.WriteOutput; write != nil {
 		return write(ctx, out)
@@ -128,27 +155,20 @@ func (d *Driver) analysisError(ctx context.Context, unit Compilation, err error)
 // Run sends each compilation received from the driver's Queue to the driver's
 // Analyzer.  All outputs are passed to Output in turn.  An error is immediately
 // returned if the Analyzer, Output, or Compilations fields are unset.
+//
+// If d.Concurrency > 1, up to that many compilations are analyzed at once.  The
+// first error reported by any analysis (or by WriteOutput) cancels the
+// remaining analyses and is returned once they have completed.
 func (d *Driver) Run(ctx context.Context, queue Queue) error {
 	if d.Analyzer == nil {
 		return errors.New("no analyzer has been specified")
+	} else if d.Concurrency > 1 {
+		return d.runConcurrent(ctx, queue)
 	}
 
 	for {
 		if err := queue.Next(ctx, func(ctx context.Context, cu Compilation) error {
-			if err := d.setup(ctx, cu); err != nil {
-				return errors.WithMessage(err, "driver: analysis setup")
-			}
-			err := ErrRetry
-			for err == ErrRetry {
-				err = d.analysisError(ctx, cu, d.runAnalysis(ctx, cu))
-			}
-			if terr := d.teardown(ctx, cu); terr != nil {
-				if err == nil {
-					return errors.WithMessage(terr, "driver: analysis teardown")
-				}
-				log.Printf("WARNING: analysis teardown failed: %v (analysis error: %v)", terr, err)
-			}
-			return err
+			return d.analyze(ctx, cu, d.writeOutput)
 		}); err == ErrEndOfQueue {
 			return nil
 		} else if err != nil {
@@ -157,7 +177,26 @@ func (d *Driver) Run(ctx context.Context, queue Queue) error {
 	}
 }
 
-func (d *Driver) runAnalysis(ctx context.Context, cu Compilation) error {
+// analyze runs the analysis of cu, sending its outputs to out, along with the
+// Setup, AnalysisError, and Teardown callbacks for the compilation.
+func (d *Driver) analyze(ctx context.Context, cu Compilation, out analysis.OutputFunc) error {
+	if err := d.setup(ctx, cu); err != nil {
+		return errors.WithMessage(err, "driver: analysis setup")
+	}
+	err := ErrRetry
+	for err == ErrRetry {
+		err = d.analysisError(ctx, cu, d.runAnalysis(ctx, cu, out))
+	}
+	if terr := d.teardown(ctx, cu); terr != nil {
+		if err == nil {
+			return errors.WithMessage(terr, "driver: analysis teardown")
+		}
+		log.Printf("WARNING: analysis teardown failed: %v (analysis error: %v)", terr, err)
+	}
+	return err
+}
+
+func (d *Driver) runAnalysis(ctx context.Context, cu Compilation, out analysis.OutputFunc) error {
 	if d.AnalysisOptions.Timeout != 0 {
 		var cancel func()
 		ctx, cancel = context.WithTimeout(ctx, d.AnalysisOptions.Timeout)
@@ -168,5 +207,5 @@ func (d *Driver) runAnalysis(ctx context.Context, cu Compilation) error {
 		FileDataService: d.FileDataService,
 		Revision:        cu.Revision,
 		BuildId:         cu.BuildID,
-	}, d.writeOutput)
+	}, out)
 }
@@ -0,0 +1,544 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package kvdb implements kcd.ReadWriteDeleter using a keyvalue.DB, such as a
+// LevelDB database, as its backing store.  It is suitable for a long-lived
+// compilation database shared by many extraction runs:
+//
+//	kv, err := leveldb.Open(path, nil)
+//	...
+//	db := kvdb.New(kv)
+//	defer db.Close(ctx)
+//
+// The database is organized into the following key spaces:
+//
+//	rev\x00<corpus>\x00<revision>\x00<timestamp>    revision markers
+//	unit\x00<digest>                                compilation records
+//	file\x00<digest>                                file contents
+//	index\x00<term>\x00<value>\x00<digest>          index postings
+//	terms\x00<digest>\x00<term>\x00<value>          index terms per unit
+//
+// Timestamps are encoded as big-endian nanoseconds since the Unix epoch.  The
+// index postings allow Find to read only the units matching each exact-match
+// filter term (revisions, corpora, and languages) and only the values of each
+// regular expression term (targets, sources, and outputs).  Likewise, a
+// corpus filter limits Revisions to that corpus's markers.
+package kvdb // import "kythe.io/kythe/go/platform/kcd/kvdb"
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"kythe.io/kythe/go/platform/kcd"
+	"kythe.io/kythe/go/storage/keyvalue"
+
+	"bitbucket.org/creachadair/stringset"
+)
+
+// DB implements kcd.ReadWriteDeleter using a keyvalue.DB.  Its methods are
+// safe for concurrent use by multiple goroutines.
+type DB struct {
+	db keyvalue.DB
+
+	// mu serializes the methods that modify the database, since several of
+	// them must read existing keys before deciding what to write.
+	mu sync.Mutex
+}
+
+// New returns a compilation database stored in db.
+func New(db keyvalue.DB) *DB { return &DB{db: db} }
+
+// Close closes the underlying keyvalue.DB.
+func (db *DB) Close(ctx context.Context) error { return db.db.Close(ctx) }
+
+// Index terms matching the fields of a kcd.FindFilter.
+const (
+	revisionTerm = "revision"
+	corpusTerm   = "corpus"
+	languageTerm = "language"
+	outputTerm   = "output"
+	sourceTerm   = "source"
+	targetTerm   = "target"
+)
+
+// Key space prefixes.
+const (
+	revisionPrefix = "rev\x00"
+	unitPrefix     = "unit\x00"
+	filePrefix     = "file\x00"
+	indexPrefix    = "index\x00"
+	termsPrefix    = "terms\x00"
+)
+
+func revisionKey(corpus, revision string) []byte {
+	return []byte(revisionPrefix + corpus + "\x00" + revision + "\x00")
+}
+
+func unitKey(digest string) []byte { return []byte(unitPrefix + digest) }
+func fileKey(digest string) []byte { return []byte(filePrefix + digest) }
+
+func postingPrefix(term, value string) []byte {
+	return []byte(indexPrefix + term + "\x00" + value + "\x00")
+}
+
+func termKey(digest, term, value string) []byte {
+	return []byte(termsPrefix + digest + "\x00" + term + "\x00" + value)
+}
+
+// decodeRevision parses a revision marker key.
+func decodeRevision(key []byte) (kcd.Revision, error) {
+	rest := key[len(revisionPrefix):]
+	if len(rest) < 8 {
+		return kcd.Revision{}, fmt.Errorf("invalid revision key %q", key)
+	}
+	nanos := int64(binary.BigEndian.Uint64(rest[len(rest)-8:]))
+	parts := strings.SplitN(string(rest[:len(rest)-8]), "\x00", 3)
+	if len(parts) != 3 || parts[2] != "" {
+		return kcd.Revision{}, fmt.Errorf("invalid revision key %q", key)
+	}
+	return kcd.Revision{
+		Corpus:    parts[0],
+		Revision:  parts[1],
+		Timestamp: time.Unix(0, nanos).In(time.UTC),
+	}, nil
+}
+
+// scanKeys calls f with each key in db having the given prefix.  The
+// iterator is closed before scanKeys returns, so f must not write to db.
+func (db *DB) scanKeys(ctx context.Context, prefix []byte, f func(key []byte) error) error {
+	it, err := db.db.ScanPrefix(ctx, prefix, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for {
+		key, _, err := it.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := f(key); err != nil {
+			return err
+		}
+	}
+}
+
+// postings adds to digests the unit digests posted for the given term whose
+// values have the given prefix and satisfy match.
+func (db *DB) postings(ctx context.Context, term, valuePrefix string, match func(...string) bool, digests stringset.Set) error {
+	prefix := []byte(indexPrefix + term + "\x00")
+	return db.scanKeys(ctx, append(prefix, valuePrefix...), func(key []byte) error {
+		rest := string(key[len(prefix):])
+		i := strings.LastIndexByte(rest, 0)
+		if i < 0 {
+			return fmt.Errorf("invalid index key %q", key)
+		}
+		if match == nil || match(rest[:i]) {
+			digests.Add(rest[i+1:])
+		}
+		return nil
+	})
+}
+
+// Revisions implements a method of kcd.Reader.
+func (db *DB) Revisions(ctx context.Context, want *kcd.RevisionsFilter, f func(kcd.Revision) error) error {
+	revisionMatches, err := want.Compile()
+	if err != nil {
+		return err
+	}
+	prefix := []byte(revisionPrefix)
+	if want != nil && want.Corpus != "" {
+		prefix = append(prefix, want.Corpus+"\x00"...)
+	}
+	var revs []kcd.Revision
+	if err := db.scanKeys(ctx, prefix, func(key []byte) error {
+		rev, err := decodeRevision(key)
+		if err != nil {
+			return err
+		}
+		if revisionMatches(rev) {
+			revs = append(revs, rev)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, rev := range revs {
+		if err := f(rev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find implements a method of kcd.Reader.  Results are reported in order of
+// digest.
+func (db *DB) Find(ctx context.Context, filter *kcd.FindFilter, f func(string) error) error {
+	cf, err := filter.Compile()
+	if err != nil {
+		return err
+	} else if cf == nil {
+		return nil
+	}
+
+	// Each non-empty filter field selects the union of the postings of its
+	// values; the result is the intersection of these sets.
+	var found stringset.Set
+	restrict := func(digests stringset.Set) {
+		if found == nil {
+			found = digests
+		} else {
+			found = found.Intersect(digests)
+		}
+	}
+	exact := []struct {
+		term   string
+		values []string
+	}{
+		{revisionTerm, filter.Revisions},
+		{corpusTerm, filter.Corpus},
+		{languageTerm, filter.Languages},
+	}
+	for _, e := range exact {
+		if len(e.values) == 0 {
+			continue
+		}
+		digests := stringset.New()
+		for _, value := range stringset.New(e.values...).Elements() {
+			if err := db.postings(ctx, e.term, value+"\x00", nil, digests); err != nil {
+				return err
+			}
+		}
+		restrict(digests)
+		if found.Empty() {
+			return nil
+		}
+	}
+	patterns := []struct {
+		term  string
+		given bool
+		match func(...string) bool
+	}{
+		{targetTerm, len(filter.Targets) != 0, cf.TargetMatches},
+		{outputTerm, len(filter.Outputs) != 0, cf.OutputMatches},
+		{sourceTerm, len(filter.Sources) != 0, cf.SourcesMatch},
+	}
+	for _, p := range patterns {
+		if !p.given {
+			continue
+		}
+		digests := stringset.New()
+		if err := db.postings(ctx, p.term, "", p.match, digests); err != nil {
+			return err
+		}
+		restrict(digests)
+		if found.Empty() {
+			return nil
+		}
+	}
+
+	for _, digest := range found.Elements() {
+		if err := f(digest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Units implements a method of kcd.Reader.
+func (db *DB) Units(ctx context.Context, unitDigests []string, f func(digest, key string, data []byte) error) error {
+	for _, ud := range unitDigests {
+		val, err := db.db.Get(ctx, unitKey(ud), nil)
+		if err == io.EOF {
+			continue
+		} else if err != nil {
+			return err
+		}
+		formatKey, data, err := decodeUnit(val)
+		if err != nil {
+			return fmt.Errorf("unit %q: %v", ud, err)
+		}
+		if err := f(ud, formatKey, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeUnit encodes a stored compilation record as the length-prefixed
+// format key followed by the unit's wire-format data.
+func encodeUnit(formatKey string, data []byte) []byte {
+	buf := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(formatKey)+len(data))
+	buf = buf[:binary.PutUvarint(buf, uint64(len(formatKey)))]
+	buf = append(buf, formatKey...)
+	return append(buf, data...)
+}
+
+func decodeUnit(val []byte) (formatKey string, data []byte, err error) {
+	n, size := binary.Uvarint(val)
+	if size <= 0 || uint64(len(val)-size) < n {
+		return "", nil, errors.New("invalid stored unit")
+	}
+	return string(val[size : size+int(n)]), val[size+int(n):], nil
+}
+
+// Files implements a method of kcd.Reader.
+func (db *DB) Files(ctx context.Context, fileDigests []string, f func(string, []byte) error) error {
+	for _, fd := range fileDigests {
+		data, err := db.db.Get(ctx, fileKey(fd), nil)
+		if err == io.EOF {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := f(fd, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FilesExist implements a method of kcd.Reader.
+func (db *DB) FilesExist(ctx context.Context, fileDigests []string, f func(string) error) error {
+	return db.Files(ctx, fileDigests, func(fd string, _ []byte) error { return f(fd) })
+}
+
+// checkKeyPart reports an error if s cannot be embedded in a key.
+func checkKeyPart(what, s string) error {
+	if strings.IndexByte(s, 0) >= 0 {
+		return fmt.Errorf("invalid %s %q: contains NUL", what, s)
+	}
+	return nil
+}
+
+// write applies the given deletions and then writes to the database in a
+// single batch.
+func (db *DB) write(ctx context.Context, deletes [][]byte, writes map[string][]byte) (err error) {
+	wr, err := db.db.Writer(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := wr.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if len(deletes) > 0 {
+		d, ok := wr.(keyvalue.Deleter)
+		if !ok {
+			return keyvalue.ErrDeleteUnsupported
+		}
+		for _, key := range deletes {
+			if err := d.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	keys := make([]string, 0, len(writes))
+	for key := range writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := wr.Write([]byte(key), writes[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteRevision implements a method of kcd.Writer.
+func (db *DB) WriteRevision(ctx context.Context, rev kcd.Revision, replace bool) error {
+	if rev.Revision == "" {
+		return errors.New("missing revision marker")
+	} else if rev.Corpus == "" {
+		return errors.New("missing corpus label")
+	} else if err := checkKeyPart("revision", rev.Revision); err != nil {
+		return err
+	} else if err := checkKeyPart("corpus", rev.Corpus); err != nil {
+		return err
+	}
+	if rev.Timestamp.IsZero() {
+		rev.Timestamp = time.Now()
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	prefix := revisionKey(rev.Corpus, rev.Revision)
+	var deletes [][]byte
+	if replace {
+		if err := db.scanKeys(ctx, prefix, func(key []byte) error {
+			deletes = append(deletes, append([]byte(nil), key...))
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(rev.Timestamp.UnixNano()))
+	return db.write(ctx, deletes, map[string][]byte{
+		string(prefix) + string(ts[:]): nil,
+	})
+}
+
+// WriteUnit implements a method of kcd.Writer.  On success, the returned
+// digest is the kcd.HexDigest of whatever unit.MarshalBinary returned.
+func (db *DB) WriteUnit(ctx context.Context, revision, corpus, formatKey string, unit kcd.Unit) (string, error) {
+	if revision == "" {
+		return "", errors.New("empty revision marker")
+	}
+	unit.Canonicalize()
+	bits, err := unit.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	digest := unit.Digest()
+
+	writes := map[string][]byte{string(unitKey(digest)): encodeUnit(formatKey, bits)}
+	addTerm := func(term, value string) error {
+		if value == "" {
+			return nil
+		} else if err := checkKeyPart(term, value); err != nil {
+			return err
+		}
+		writes[string(postingPrefix(term, value))+digest] = nil
+		writes[string(termKey(digest, term, value))] = nil
+		return nil
+	}
+	idx := unit.Index()
+	terms := []struct{ term, value string }{
+		{revisionTerm, revision},
+		{corpusTerm, corpus},
+		{languageTerm, idx.Language},
+		{outputTerm, idx.Output},
+		{targetTerm, idx.Target},
+	}
+	for _, src := range idx.Sources {
+		terms = append(terms, struct{ term, value string }{sourceTerm, src})
+	}
+	for _, t := range terms {
+		if err := addTerm(t.term, t.value); err != nil {
+			return "", err
+		}
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.write(ctx, nil, writes); err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+// WriteFile implements a method of kcd.Writer.
+func (db *DB) WriteFile(ctx context.Context, r io.Reader) (string, error) {
+	bits, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	digest := kcd.HexDigest(bits)
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if err := db.write(ctx, nil, map[string][]byte{string(fileKey(digest)): bits}); err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+// exists reports whether key is present in the database.
+func (db *DB) exists(ctx context.Context, key []byte) (bool, error) {
+	if _, err := db.db.Get(ctx, key, nil); err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// DeleteUnit implements a method of kcd.Deleter.  The unit's index terms are
+// removed along with it.
+func (db *DB) DeleteUnit(ctx context.Context, unitDigest string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	key := unitKey(unitDigest)
+	if ok, err := db.exists(ctx, key); err != nil {
+		return err
+	} else if !ok {
+		return os.ErrNotExist
+	}
+
+	deletes := [][]byte{key}
+	prefix := []byte(termsPrefix + unitDigest + "\x00")
+	if err := db.scanKeys(ctx, prefix, func(key []byte) error {
+		parts := bytes.SplitN(key[len(prefix):], []byte{0}, 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid index terms key %q", key)
+		}
+		deletes = append(deletes,
+			append([]byte(nil), key...),
+			append(postingPrefix(string(parts[0]), string(parts[1])), unitDigest...))
+		return nil
+	}); err != nil {
+		return err
+	}
+	return db.write(ctx, deletes, nil)
+}
+
+// DeleteFile implements a method of kcd.Deleter.
+func (db *DB) DeleteFile(ctx context.Context, fileDigest string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	key := fileKey(fileDigest)
+	if ok, err := db.exists(ctx, key); err != nil {
+		return err
+	} else if !ok {
+		return os.ErrNotExist
+	}
+	return db.write(ctx, [][]byte{key}, nil)
+}
+
+// DeleteRevision implements a method of kcd.Deleter.
+func (db *DB) DeleteRevision(ctx context.Context, revision, corpus string) error {
+	rev := kcd.Revision{Revision: revision, Corpus: corpus}
+	if err := rev.IsValid(); err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var deletes [][]byte
+	if err := db.scanKeys(ctx, revisionKey(corpus, revision), func(key []byte) error {
+		deletes = append(deletes, append([]byte(nil), key...))
+		return nil
+	}); err != nil {
+		return err
+	} else if len(deletes) == 0 {
+		return os.ErrNotExist
+	}
+	return db.write(ctx, deletes, nil)
+}
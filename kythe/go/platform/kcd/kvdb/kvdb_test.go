@@ -0,0 +1,171 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package kvdb
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"kythe.io/kythe/go/platform/kcd"
+	"kythe.io/kythe/go/platform/kcd/kythe"
+	"kythe.io/kythe/go/platform/kcd/testutil"
+	"kythe.io/kythe/go/storage/inmemory"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+var _ kcd.ReadWriteDeleter = (*DB)(nil)
+
+func TestKVDB(t *testing.T) {
+	db := New(inmemory.NewKeyValueDB())
+	for _, err := range testutil.Run(context.Background(), db) {
+		t.Error(err)
+	}
+}
+
+func find(t *testing.T, db *DB, filter *kcd.FindFilter) string {
+	t.Helper()
+	var digests []string
+	if err := db.Find(context.Background(), filter, func(digest string) error {
+		digests = append(digests, digest)
+		return nil
+	}); err != nil {
+		t.Fatalf("Find(%+v): %v", filter, err)
+	}
+	return strings.Join(digests, ",")
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	kv := inmemory.NewKeyValueDB()
+	db := New(kv)
+
+	write := func(revision, corpus, target, lang string, sources ...string) string {
+		digest, err := db.WriteUnit(ctx, revision, corpus, kythe.Format, kythe.Unit{Proto: &apb.CompilationUnit{
+			VName:      &spb.VName{Signature: target, Language: lang},
+			SourceFile: sources,
+			OutputKey:  target + ".o",
+		}})
+		if err != nil {
+			t.Fatalf("WriteUnit: %v", err)
+		}
+		return digest
+	}
+	a := write("r1", "c1", "//a:a", "go", "a.go")
+	b := write("r1", "c2", "//b:b", "c++", "b.cc", "b.h")
+	c := write("r2", "c1", "//c:c", "go", "c.go")
+	if got := write("r2", "c1", "//a:a", "go", "a.go"); got != a {
+		t.Fatalf("Rewriting unit: got digest %q, want %q", got, a)
+	}
+	sorted := func(ds ...string) string {
+		sort.Strings(ds)
+		return strings.Join(ds, ",")
+	}
+
+	tests := []struct {
+		filter *kcd.FindFilter
+		want   string
+	}{
+		{&kcd.FindFilter{Revisions: []string{"r1"}}, sorted(a, b)},
+		{&kcd.FindFilter{Revisions: []string{"r2"}}, sorted(a, c)},
+		{&kcd.FindFilter{Revisions: []string{"r1", "r2"}}, sorted(a, b, c)},
+		{&kcd.FindFilter{Revisions: []string{"r"}}, ""},
+		{&kcd.FindFilter{Corpus: []string{"c1"}}, sorted(a, c)},
+		{&kcd.FindFilter{Languages: []string{"c++"}}, b},
+		{&kcd.FindFilter{Revisions: []string{"r1"}, Corpus: []string{"c1"}}, a},
+		{&kcd.FindFilter{Targets: []*regexp.Regexp{regexp.MustCompile(`//[ab]:.*`)}}, sorted(a, b)},
+		{&kcd.FindFilter{Sources: []*regexp.Regexp{regexp.MustCompile(`.*\.h`)}}, b},
+		{&kcd.FindFilter{Outputs: []*regexp.Regexp{regexp.MustCompile(`//c:c\.o`)}}, c},
+		{&kcd.FindFilter{Corpus: []string{"c1"}, Sources: []*regexp.Regexp{regexp.MustCompile(`a\..*`)}}, a},
+		{&kcd.FindFilter{Corpus: []string{"c2"}, Languages: []string{"go"}}, ""},
+	}
+	for _, test := range tests {
+		if got := find(t, db, test.filter); got != test.want {
+			t.Errorf("Find(%+v): got %q, want %q", test.filter, got, test.want)
+		}
+	}
+
+	// Deleting a unit removes it from the index.
+	if err := db.DeleteUnit(ctx, a); err != nil {
+		t.Fatalf("DeleteUnit: %v", err)
+	}
+	if got, want := find(t, db, &kcd.FindFilter{Corpus: []string{"c1"}}), c; got != want {
+		t.Errorf("Find after DeleteUnit: got %q, want %q", got, want)
+	}
+
+	// The data persists in the underlying store.
+	if got, want := find(t, New(kv), &kcd.FindFilter{Revisions: []string{"r1"}}), b; got != want {
+		t.Errorf("Find after reopening: got %q, want %q", got, want)
+	}
+}
+
+func TestRevisions(t *testing.T) {
+	ctx := context.Background()
+	db := New(inmemory.NewKeyValueDB())
+	revs := []kcd.Revision{
+		{Revision: "r1", Corpus: "c", Timestamp: time.Unix(10, 0)},
+		{Revision: "r1", Corpus: "c", Timestamp: time.Unix(20, 0)},
+		{Revision: "r2", Corpus: "c", Timestamp: time.Unix(30, 0)},
+		{Revision: "r1", Corpus: "cc", Timestamp: time.Unix(40, 0)},
+	}
+	for _, rev := range revs {
+		if err := db.WriteRevision(ctx, rev, false); err != nil {
+			t.Fatalf("WriteRevision(%v): %v", rev, err)
+		}
+	}
+	if err := db.WriteRevision(ctx, kcd.Revision{Revision: "bad\x00", Corpus: "c"}, false); err == nil {
+		t.Error("WriteRevision: no error for revision containing NUL")
+	}
+
+	list := func(filter *kcd.RevisionsFilter) []string {
+		var got []string
+		if err := db.Revisions(ctx, filter, func(rev kcd.Revision) error {
+			got = append(got, rev.Corpus+"/"+rev.Revision+"@"+rev.Timestamp.Format("05"))
+			return nil
+		}); err != nil {
+			t.Fatalf("Revisions(%+v): %v", filter, err)
+		}
+		return got
+	}
+	tests := []struct {
+		filter *kcd.RevisionsFilter
+		want   string
+	}{
+		{nil, "c/r1@10 c/r1@20 c/r2@30 cc/r1@40"},
+		{&kcd.RevisionsFilter{Corpus: "c"}, "c/r1@10 c/r1@20 c/r2@30"},
+		{&kcd.RevisionsFilter{Corpus: "cc"}, "cc/r1@40"},
+		{&kcd.RevisionsFilter{Revision: "r1", Since: time.Unix(15, 0), Until: time.Unix(40, 0)}, "c/r1@20 cc/r1@40"},
+	}
+	for _, test := range tests {
+		if got := strings.Join(list(test.filter), " "); got != test.want {
+			t.Errorf("Revisions(%+v): got %q, want %q", test.filter, got, test.want)
+		}
+	}
+
+	// Replacing a revision discards its other timestamps.
+	if err := db.WriteRevision(ctx, kcd.Revision{Revision: "r1", Corpus: "c", Timestamp: time.Unix(50, 0)}, true); err != nil {
+		t.Fatalf("WriteRevision: %v", err)
+	}
+	if got, want := strings.Join(list(&kcd.RevisionsFilter{Corpus: "c"}), " "), "c/r1@50 c/r2@30"; got != want {
+		t.Errorf("Revisions after replace: got %q, want %q", got, want)
+	}
+}
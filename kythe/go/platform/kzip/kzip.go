@@ -265,6 +265,10 @@ var ErrDigestNotFound = errors.New("digest not found")
 var ErrUnitExists = errors.New("unit already exists")
 
 func (r *Reader) readUnit(digest string, f *zip.File) (*Unit, error) {
+	return readUnitEncoding(r.unitsPrefix, digest, f)
+}
+
+func readUnitEncoding(unitsPrefix, digest string, f *zip.File) (*Unit, error) {
 	rc, err := f.Open()
 	if err != nil {
 		return nil, err
@@ -276,7 +280,7 @@ func (r *Reader) readUnit(digest string, f *zip.File) (*Unit, error) {
 		return nil, err
 	}
 	var msg apb.IndexedCompilation
-	if r.unitsPrefix == prefixProto {
+	if unitsPrefix == prefixProto {
 		if err := proto.Unmarshal(rec, &msg); err != nil {
 			return nil, fmt.Errorf("error unmarshaling for %s: %s", digest, err)
 		}
@@ -319,6 +323,87 @@ func (r *Reader) Lookup(unitDigest string) (*Unit, error) {
 	return nil, ErrDigestNotFound
 }
 
+// LookupEncoding returns the specified compilation as stored in the given
+// encoding, which must be either EncodingJSON or EncodingProto.  If the
+// requested digest is not stored in that encoding, ErrDigestNotFound is
+// returned.  Lookup is equivalent to LookupEncoding with the reader's
+// Encoding.
+func (r *Reader) LookupEncoding(unitDigest string, enc Encoding) (*Unit, error) {
+	prefix, err := encodingPrefix(enc)
+	if err != nil {
+		return nil, err
+	}
+	needle := path.Join(r.root, prefix, unitDigest)
+	if pos := r.firstIndex(needle); pos >= 0 {
+		if f := r.zip.File[pos]; f.Name == needle {
+			return readUnitEncoding(prefix, unitDigest, f)
+		}
+	}
+	return nil, ErrDigestNotFound
+}
+
+// UnitDigests returns the digests of all the compilations stored in the
+// archive, in lexicographic order.  Unlike Scan, it does not read the
+// compilations themselves.
+func (r *Reader) UnitDigests() []string {
+	prefix, fileUnits := r.canonicalUnits()
+	digests := make([]string, len(fileUnits))
+	for i, file := range fileUnits {
+		digests[i] = strings.TrimPrefix(file.Name, prefix)
+	}
+	return digests
+}
+
+// UnitDigestsEncoding returns the digests of the compilations stored in the
+// given encoding, which must be either EncodingJSON or EncodingProto, in
+// lexicographic order.  UnitDigests is equivalent to UnitDigestsEncoding with
+// the reader's Encoding.
+func (r *Reader) UnitDigestsEncoding(enc Encoding) ([]string, error) {
+	unitsPrefix, err := encodingPrefix(enc)
+	if err != nil {
+		return nil, err
+	}
+	prefix, fileUnits := r.unitFiles(unitsPrefix)
+	digests := make([]string, len(fileUnits))
+	for i, file := range fileUnits {
+		digests[i] = strings.TrimPrefix(file.Name, prefix)
+	}
+	return digests, nil
+}
+
+// encodingPrefix returns the compilation unit directory of the given
+// encoding, which must be either EncodingJSON or EncodingProto.
+func encodingPrefix(enc Encoding) (string, error) {
+	switch enc {
+	case EncodingJSON:
+		return prefixJSON, nil
+	case EncodingProto:
+		return prefixProto, nil
+	}
+	return "", fmt.Errorf("invalid unit encoding: %v", enc)
+}
+
+// FileDigests returns the digests of all the files stored in the archive, in
+// lexicographic order.
+func (r *Reader) FileDigests() []string {
+	prefix := r.filePath("") + "/"
+	pos := r.firstIndex(prefix)
+	if pos < 0 {
+		return nil
+	}
+	var digests []string
+	for _, file := range r.zip.File[pos:] {
+		if !strings.HasPrefix(file.Name, prefix) {
+			break
+		}
+		if file.Name == prefix {
+			continue // tolerate an empty files directory entry
+		}
+		digests = append(digests, strings.TrimPrefix(file.Name, prefix))
+	}
+	return digests
+}
+
 // A ScanOption configures the behavior of scanning a kzip file.
 type ScanOption interface{ isScanOption() }
 
@@ -332,8 +417,12 @@ func ReadConcurrency(n int) ScanOption {
 	return readConcurrency(n)
 }
 
-func (r *Reader) canonicalUnits() (string, []*zip.File) {
-	prefix := r.unitPath("") + "/"
+func (r *Reader) canonicalUnits() (string, []*zip.File) { return r.unitFiles(r.unitsPrefix) }
+
+// unitFiles returns the path prefix and the files of the compilations stored
+// in the given unit directory.
+func (r *Reader) unitFiles(unitsPrefix string) (string, []*zip.File) {
+	prefix := path.Join(r.root, unitsPrefix) + "/"
 	pos := r.firstIndex(prefix)
 	if pos < 0 {
 		return "", nil
@@ -439,6 +528,19 @@ func (r *Reader) Open(fileDigest string) (io.ReadCloser, error) {
 	return nil, ErrDigestNotFound
 }
 
+// FileSize returns the uncompressed size in bytes of the file with the
+// specified digest.  If the requested digest is not in the archive,
+// ErrDigestNotFound is returned.
+func (r *Reader) FileSize(fileDigest string) (int64, error) {
+	needle := r.filePath(fileDigest)
+	if pos := r.firstIndex(needle); pos >= 0 {
+		if f := r.zip.File[pos]; f.Name == needle {
+			return int64(f.UncompressedSize64), nil
+		}
+	}
+	return 0, ErrDigestNotFound
+}
+
 // ReadAll returns the complete contents of the file with the specified digest.
 // It is a convenience wrapper for Open followed by ioutil.ReadAll.
 func (r *Reader) ReadAll(fileDigest string) ([]byte, error) {
@@ -129,6 +129,16 @@ func testRoundTrip(encoding kzip.Encoding, t *testing.T) {
 		t.Errorf("ReadAll %q: got %q, want %q", fdigest, got, fileIn)
 	}
 
+	// Verify that the file size is reported.
+	if size, err := r.FileSize(fdigest); err != nil {
+		t.Errorf("FileSize %q: unexpected error: %v", fdigest, err)
+	} else if size != int64(len(fileIn)) {
+		t.Errorf("FileSize %q: got %d, want %d", fdigest, size, len(fileIn))
+	}
+	if size, err := r.FileSize("does not exist"); err != kzip.ErrDigestNotFound {
+		t.Errorf("FileSize (non-existing file): got %d and error %v, want %v", size, err, kzip.ErrDigestNotFound)
+	}
+
 	// Verify that a non-existing file digest reports ErrDigestNotFound.
 	if f, err := r.Open("does not exist"); err != kzip.ErrDigestNotFound {
 		t.Errorf("Open (non-existing file): got error %v, want %v", err, kzip.ErrDigestNotFound)
@@ -137,6 +147,44 @@ func testRoundTrip(encoding kzip.Encoding, t *testing.T) {
 		}
 	}
 
+	// Verify that the stored digests are listed.
+	if got := r.UnitDigests(); len(got) != 1 || got[0] != udigest {
+		t.Errorf("UnitDigests: got %q, want [%q]", got, udigest)
+	}
+	if got := r.FileDigests(); len(got) != 1 || got[0] != fdigest {
+		t.Errorf("FileDigests: got %q, want [%q]", got, fdigest)
+	}
+
+	// Verify that units can be read in each encoding they were written in.
+	for _, enc := range []kzip.Encoding{kzip.EncodingJSON, kzip.EncodingProto} {
+		u, err := r.LookupEncoding(udigest, enc)
+		if encoding&enc == 0 {
+			if err != kzip.ErrDigestNotFound {
+				t.Errorf("LookupEncoding %q (%v): got %+v and error %v, want %v", udigest, enc, u, err, kzip.ErrDigestNotFound)
+			}
+		} else if err != nil {
+			t.Errorf("LookupEncoding %q (%v): unexpected error: %v", udigest, enc, err)
+		} else if !proto.Equal(u.Proto, unitIn) || !proto.Equal(u.Index, indexIn) {
+			t.Errorf("LookupEncoding %q (%v): got %+v, want %+v", udigest, enc, u.Proto, unitIn)
+		}
+
+		want := []string{udigest}
+		if encoding&enc == 0 {
+			want = nil
+		}
+		if got, err := r.UnitDigestsEncoding(enc); err != nil {
+			t.Errorf("UnitDigestsEncoding (%v): unexpected error: %v", enc, err)
+		} else if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("UnitDigestsEncoding (%v): got %q, want %q", enc, got, want)
+		}
+	}
+	if u, err := r.LookupEncoding(udigest, kzip.EncodingAll); err == nil {
+		t.Errorf("LookupEncoding %q (%v): got %+v, want error", udigest, kzip.EncodingAll, u)
+	}
+	if got, err := r.UnitDigestsEncoding(kzip.EncodingAll); err == nil {
+		t.Errorf("UnitDigestsEncoding (%v): got %q, want error", kzip.EncodingAll, got)
+	}
+
 	// Verify that scanning works.
 	ok := false
 	if err := r.Scan(func(u *kzip.Unit) error {
@@ -14,8 +14,10 @@
  * limitations under the License.
  */
 
-// Binary entrystream provides tools to manipulate a stream of delimited Entry
-// messages. By default, entrystream does nothing to the entry stream.
+// Binary entrystream provides tools to manipulate a stream of Entry messages.
+// By default, entrystream does nothing to the entry stream except convert it
+// to a delimited stream; the format of the input stream (delimited, JSON, or
+// Riegeli) is detected unless given by --read_format.
 //
 // Examples:
 //   $ ... | entrystream                      # Passes through proto entry stream unchanged
@@ -34,10 +36,8 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
-	"strings"
 
 	"kythe.io/kythe/go/platform/delimited"
 	"kythe.io/kythe/go/storage/entryset"
@@ -60,19 +60,12 @@ type entrySet struct { // TODO(schroederc): rename to avoid confusion with Entry
 	Properties map[string]json.RawMessage `json:"properties"`
 }
 
-// Accepted --{read,write}_format values
-const (
-	delimitedFormat = "delimited"
-	jsonFormat      = "json"
-	riegeliFormat   = "riegeli"
-)
-
 var (
 	readJSON  = flag.Bool("read_json", false, "Assume stdin is a stream of JSON entries instead of protobufs (deprecated: use --read_format)")
 	writeJSON = flag.Bool("write_json", false, "Print JSON stream as output (deprecated: use --write_format)")
 
-	readFormat  = flag.String("read_format", delimitedFormat, "Format of the input stream (accepted formats: {delimited,json,riegeli})")
-	writeFormat = flag.String("write_format", delimitedFormat, "Format of the output stream (accepted formats: {delimited,json,riegeli})")
+	readFormat  = stream.FormatFlag("read_format", "auto", "Format of the input stream")
+	writeFormat = stream.FormatFlag("write_format", "delimited", "Format of the output stream")
 
 	riegeliOptions = flag.String("riegeli_writer_options", "", "Riegeli writer options")
 
@@ -97,52 +90,29 @@ func main() {
 		flagutil.UsageErrorf("unknown arguments: %v", flag.Args())
 	}
 
-	// Normalize --{read,write}_format values
-	*readFormat = strings.ToLower(*readFormat)
-	*writeFormat = strings.ToLower(*writeFormat)
-
 	if *readJSON {
 		log.Printf("WARNING: --read_json is deprecated; use --read_format=json")
-		*readFormat = jsonFormat
+		*readFormat = stream.JSONFormat
 	}
 	if *writeJSON {
 		log.Printf("WARNING: --write_json is deprecated; use --write_format=json")
-		*writeFormat = jsonFormat
+		*writeFormat = stream.JSONFormat
 	}
 
 	in := bufio.NewReaderSize(os.Stdin, 2*4096)
 	out := bufio.NewWriter(os.Stdout)
 
+	if *readFormat == stream.AutoFormat {
+		var err error
+		*readFormat, err = stream.DetectFormat(in)
+		failOnErr(err)
+	}
+
 	var rd stream.EntryReader
-	switch *readFormat {
-	case jsonFormat:
-		if *structuredFacts {
-			rd = stream.NewStructuredJSONReader(in)
-		} else {
-			rd = stream.NewJSONReader(in)
-		}
-	case riegeliFormat:
-		rd = func(emit func(*spb.Entry) error) error {
-			r := riegeli.NewReader(in)
-			for {
-				rec, err := r.Next()
-				if err == io.EOF {
-					return nil
-				} else if err != nil {
-					return err
-				}
-				var e spb.Entry
-				if err := proto.Unmarshal(rec, &e); err != nil {
-					return err
-				} else if err := emit(&e); err != nil {
-					return err
-				}
-			}
-		}
-	case delimitedFormat:
-		rd = stream.NewReader(in)
-	default:
-		log.Fatalf("Unsupported --read_format=%s", *readFormat)
+	if *readFormat == stream.JSONFormat && *structuredFacts {
+		rd = stream.NewStructuredJSONReader(in)
+	} else {
+		rd = stream.NewFormatReader(in, *readFormat)
 	}
 
 	if *sortStream || *entrySets || *uniqEntries {
@@ -168,16 +138,16 @@ func main() {
 		failOnErr(rd(es.Add))
 		pb := es.Encode()
 		switch *writeFormat {
-		case jsonFormat:
+		case stream.JSONFormat:
 			encoder := json.NewEncoder(out)
 			failOnErr(encoder.Encode(pb))
-		case riegeliFormat:
+		case stream.RiegeliFormat:
 			opts, err := riegeli.ParseOptions(*riegeliOptions)
 			failOnErr(err)
 			wr := riegeli.NewWriter(out, opts)
 			failOnErr(wr.PutProto(pb))
 			failOnErr(wr.Flush())
-		case delimitedFormat:
+		case stream.DelimitedFormat:
 			wr := delimited.NewWriter(out)
 			failOnErr(wr.PutProto(pb))
 		default:
@@ -209,32 +179,18 @@ func main() {
 		if len(set.Properties) != 0 {
 			failOnErr(encoder.Encode(set))
 		}
+	case *writeFormat == stream.JSONFormat && *structuredFacts:
+		encoder := json.NewEncoder(out)
+		failOnErr(rd(func(entry *spb.Entry) error {
+			return encoder.Encode(stream.Structured(entry))
+		}))
 	default:
-		switch *writeFormat {
-		case jsonFormat:
-			encoder := json.NewEncoder(out)
-			failOnErr(rd(func(entry *spb.Entry) error {
-				if *structuredFacts {
-					return encoder.Encode(stream.Structured(entry))
-				}
-				return encoder.Encode(entry)
-			}))
-		case riegeliFormat:
-			opts, err := riegeli.ParseOptions(*riegeliOptions)
-			failOnErr(err)
-			wr := riegeli.NewWriter(out, opts)
-			failOnErr(rd(func(entry *spb.Entry) error {
-				return wr.PutProto(entry)
-			}))
-			failOnErr(wr.Flush())
-		case delimitedFormat:
-			wr := delimited.NewWriter(out)
-			failOnErr(rd(func(entry *spb.Entry) error {
-				return wr.PutProto(entry)
-			}))
-		default:
-			log.Fatalf("Unsupported --write_format=%s", *writeFormat)
-		}
+		opts, err := riegeli.ParseOptions(*riegeliOptions)
+		failOnErr(err)
+		wr, err := stream.NewFormatWriter(out, *writeFormat, opts)
+		failOnErr(err)
+		failOnErr(rd(wr.Put))
+		failOnErr(wr.Flush())
 	}
 	failOnErr(out.Flush())
 }
@@ -0,0 +1,457 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package diffcmd provides the kzip command for comparing two kzip archives.
+package diffcmd // import "kythe.io/kythe/go/platform/tools/kzip/diffcmd"
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	"kythe.io/kythe/go/platform/kzip"
+	"kythe.io/kythe/go/platform/vfs"
+	"kythe.io/kythe/go/util/cmdutil"
+	"kythe.io/kythe/go/util/kytheuri"
+
+	"github.com/google/subcommands"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+)
+
+type diffCommand struct {
+	cmdutil.Info
+
+	writeJSON       bool
+	readConcurrency int
+}
+
+// New creates a new subcommand for comparing kzip files.
+func New() subcommands.Command {
+	const usage = `Usage: diff [options] <old.kzip> <new.kzip>
+
+Compare the compilation units of two .kzip files.  Units are matched by VName
+and reported as added, removed, or changed.  For each changed unit, the
+differences in its arguments, required inputs (by path and digest), details
+(by type URL), environment variables, and any other fields are reported.`
+
+	return &diffCommand{
+		Info: cmdutil.NewInfo("diff", "compare the compilation units of two kzip archives", usage),
+	}
+}
+
+// SetFlags implements the subcommands interface and provides command-specific flags
+// for the diff command.
+func (c *diffCommand) SetFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&c.writeJSON, "json", false, "Write the differences as a JSON object")
+	fs.IntVar(&c.readConcurrency, "read_concurrency", runtime.NumCPU(), "Max concurrency of reading compilation units from each kzip. Defaults to the number of cpu cores.")
+}
+
+// Execute implements the subcommands interface and compares the given files.
+func (c *diffCommand) Execute(ctx context.Context, fs *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	if fs.NArg() != 2 {
+		return c.Fail("Expected exactly 2 .kzip paths; found %d", fs.NArg())
+	}
+	oldUnits, err := c.readUnits(ctx, fs.Arg(0))
+	if err != nil {
+		return c.Fail("Reading %q: %v", fs.Arg(0), err)
+	}
+	newUnits, err := c.readUnits(ctx, fs.Arg(1))
+	if err != nil {
+		return c.Fail("Reading %q: %v", fs.Arg(1), err)
+	}
+
+	d := Compare(oldUnits, newUnits)
+	out := bufio.NewWriter(os.Stdout)
+	if c.writeJSON {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(d)
+	} else {
+		err = d.Write(out)
+	}
+	if err != nil {
+		return c.Fail("Writing diff: %v", err)
+	} else if err := out.Flush(); err != nil {
+		return c.Fail("Writing diff: %v", err)
+	}
+	return subcommands.ExitSuccess
+}
+
+func (c *diffCommand) readUnits(ctx context.Context, path string) ([]*kzip.Unit, error) {
+	f, err := vfs.Open(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var units []*kzip.Unit
+	if err := kzip.Scan(f, func(_ *kzip.Reader, u *kzip.Unit) error {
+		units = append(units, u)
+		return nil
+	}, kzip.ReadConcurrency(c.readConcurrency)); err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+// Diff is the set of differences between the compilation units of two kzips.
+type Diff struct {
+	Added   []string    `json:"added,omitempty"`
+	Removed []string    `json:"removed,omitempty"`
+	Changed []*UnitDiff `json:"changed,omitempty"`
+}
+
+// UnitDiff is the set of differences between two compilation units with the
+// same VName.
+type UnitDiff struct {
+	Unit      string `json:"unit"`
+	OldDigest string `json:"old_digest"`
+	NewDigest string `json:"new_digest"`
+
+	Arguments      []*Change `json:"arguments,omitempty"`
+	RequiredInputs []*Change `json:"required_inputs,omitempty"`
+	Details        []*Change `json:"details,omitempty"`
+	Environment    []*Change `json:"environment,omitempty"`
+
+	// Fields are the names of any other CompilationUnit fields that differ.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Change statuses.
+const (
+	Added   = "added"
+	Removed = "removed"
+	Changed = "changed"
+)
+
+// A Change is a single added, removed, or changed element of a compilation
+// unit.  Arguments are keyed by their index in the old (removed) or new
+// (added) unit, required inputs by path, details by type URL, and environment
+// variables by name.
+type Change struct {
+	Status string `json:"status"`
+	Key    string `json:"key"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// Compare returns the differences between the old and new sets of compilation
+// units.  Units sharing a VName within a set are matched in order of digest.
+func Compare(oldUnits, newUnits []*kzip.Unit) *Diff {
+	oldKeys, oldByKey := keyUnits(oldUnits)
+	newKeys, newByKey := keyUnits(newUnits)
+
+	d := new(Diff)
+	for _, key := range oldKeys {
+		if _, ok := newByKey[key]; !ok {
+			d.Removed = append(d.Removed, key)
+		}
+	}
+	for _, key := range newKeys {
+		o, ok := oldByKey[key]
+		if !ok {
+			d.Added = append(d.Added, key)
+		} else if ud := compareUnits(key, o, newByKey[key]); ud != nil {
+			d.Changed = append(d.Changed, ud)
+		}
+	}
+	return d
+}
+
+// keyUnits returns the sorted keys of the given units along with a mapping
+// from each key to its unit.  A unit's key is its VName ticket; units sharing
+// a VName are ordered by digest and all but the first are suffixed by their
+// position.
+func keyUnits(units []*kzip.Unit) ([]string, map[string]*kzip.Unit) {
+	byTicket := make(map[string][]*kzip.Unit)
+	for _, u := range units {
+		ticket := kytheuri.FromVName(u.Proto.GetVName()).String()
+		byTicket[ticket] = append(byTicket[ticket], u)
+	}
+	var keys []string
+	byKey := make(map[string]*kzip.Unit)
+	for ticket, us := range byTicket {
+		sort.Slice(us, func(i, j int) bool { return us[i].Digest < us[j].Digest })
+		for i, u := range us {
+			key := ticket
+			if i > 0 {
+				key = fmt.Sprintf("%s (%d)", ticket, i+1)
+			}
+			keys = append(keys, key)
+			byKey[key] = u
+		}
+	}
+	sort.Strings(keys)
+	return keys, byKey
+}
+
+// compareUnits returns the differences between two units, or nil if they are
+// equivalent.
+func compareUnits(key string, o, n *kzip.Unit) *UnitDiff {
+	oldCU, newCU := o.Proto, n.Proto
+	ud := &UnitDiff{
+		Unit:      key,
+		OldDigest: o.Digest,
+		NewDigest: n.Digest,
+
+		Arguments:      diffArguments(oldCU.GetArgument(), newCU.GetArgument()),
+		RequiredInputs: diffMaps(requiredInputs(oldCU), requiredInputs(newCU)),
+		Details:        diffDetails(oldCU.GetDetails(), newCU.GetDetails()),
+		Environment:    diffMaps(environment(oldCU), environment(newCU)),
+		Fields:         diffFields(oldCU, newCU),
+	}
+	if len(ud.Arguments) == 0 && len(ud.RequiredInputs) == 0 && len(ud.Details) == 0 &&
+		len(ud.Environment) == 0 && len(ud.Fields) == 0 {
+		return nil
+	}
+	return ud
+}
+
+// diffArguments returns the arguments removed from a and added in b, based
+// on their longest common subsequence.
+func diffArguments(a, b []string) []*Change {
+	// Trim the common prefix and suffix, which usually leaves little to align.
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	ma, mb := a[pre:len(a)-suf], b[pre:len(b)-suf]
+
+	// lcs[i][j] is the length of the longest common subsequence of ma[i:] and
+	// mb[j:].
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var changes []*Change
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			i++
+			j++
+		case j == len(mb) || (i < len(ma) && lcs[i+1][j] >= lcs[i][j+1]):
+			changes = append(changes, &Change{Status: Removed, Key: fmt.Sprint(pre + i), Old: ma[i]})
+			i++
+		default:
+			changes = append(changes, &Change{Status: Added, Key: fmt.Sprint(pre + j), New: mb[j]})
+			j++
+		}
+	}
+	return changes
+}
+
+// requiredInputs returns the digest of each required input of cu by path.
+func requiredInputs(cu *apb.CompilationUnit) map[string]string {
+	m := make(map[string]string)
+	for _, ri := range cu.GetRequiredInput() {
+		m[ri.GetInfo().GetPath()] = ri.GetInfo().GetDigest()
+	}
+	return m
+}
+
+// environment returns the value of each environment variable of cu by name.
+func environment(cu *apb.CompilationUnit) map[string]string {
+	m := make(map[string]string)
+	for _, env := range cu.GetEnvironment() {
+		m[env.GetName()] = env.GetValue()
+	}
+	return m
+}
+
+// diffMaps returns the keys added, removed, or with changed values between a
+// and b, sorted by key.
+func diffMaps(a, b map[string]string) []*Change {
+	var changes []*Change
+	for k, old := range a {
+		if n, ok := b[k]; !ok {
+			changes = append(changes, &Change{Status: Removed, Key: k, Old: old})
+		} else if n != old {
+			changes = append(changes, &Change{Status: Changed, Key: k, Old: old, New: n})
+		}
+	}
+	for k, n := range b {
+		if _, ok := a[k]; !ok {
+			changes = append(changes, &Change{Status: Added, Key: k, New: n})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// diffDetails returns the type URLs of the details added, removed, or changed
+// between a and b.
+func diffDetails(a, b []*anypb.Any) []*Change {
+	group := func(ds []*anypb.Any) map[string][]*anypb.Any {
+		m := make(map[string][]*anypb.Any)
+		for _, d := range ds {
+			m[d.GetTypeUrl()] = append(m[d.GetTypeUrl()], d)
+		}
+		return m
+	}
+	oldDetails, newDetails := group(a), group(b)
+
+	var changes []*Change
+	for url, old := range oldDetails {
+		if n, ok := newDetails[url]; !ok {
+			changes = append(changes, &Change{Status: Removed, Key: url})
+		} else if !detailsEqual(old, n) {
+			changes = append(changes, &Change{Status: Changed, Key: url})
+		}
+	}
+	for url := range newDetails {
+		if _, ok := oldDetails[url]; !ok {
+			changes = append(changes, &Change{Status: Added, Key: url})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+func detailsEqual(a, b []*anypb.Any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// diffFields returns the names of the fields of a and b, other than those
+// compared individually, that differ.
+func diffFields(a, b *apb.CompilationUnit) []string {
+	var fields []string
+	ar, br := a.ProtoReflect(), b.ProtoReflect()
+	fds := ar.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		switch fd.Name() {
+		case "v_name", "argument", "required_input", "details", "environment":
+			continue
+		}
+		if !proto.Equal(onlyField(ar, fd), onlyField(br, fd)) {
+			fields = append(fields, string(fd.Name()))
+		}
+	}
+	return fields
+}
+
+// onlyField returns a copy of m containing only the given field.
+func onlyField(m protoreflect.Message, fd protoreflect.FieldDescriptor) proto.Message {
+	c := m.New()
+	if m.Has(fd) {
+		c.Set(fd, m.Get(fd))
+	}
+	return c.Interface()
+}
+
+// Write writes a human-readable description of d to w.
+func (d *Diff) Write(w io.Writer) error {
+	p := &printer{w: w}
+	if len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0 {
+		p.printf("No differences\n")
+		return p.err
+	}
+	if len(d.Removed) > 0 {
+		p.printf("Removed units (%d):\n", len(d.Removed))
+		for _, key := range d.Removed {
+			p.printf("  - %s\n", key)
+		}
+	}
+	if len(d.Added) > 0 {
+		p.printf("Added units (%d):\n", len(d.Added))
+		for _, key := range d.Added {
+			p.printf("  + %s\n", key)
+		}
+	}
+	for _, ud := range d.Changed {
+		p.printf("Changed unit %s (%s -> %s):\n", ud.Unit, ud.OldDigest, ud.NewDigest)
+		p.printChanges("arguments", ud.Arguments, func(c *Change) string {
+			return fmt.Sprintf("[%s] %s", c.Key, c.Old+c.New)
+		})
+		p.printChanges("required inputs", ud.RequiredInputs, func(c *Change) string {
+			if c.Status == Changed {
+				return fmt.Sprintf("%s: %s -> %s", c.Key, c.Old, c.New)
+			}
+			return fmt.Sprintf("%s (%s)", c.Key, c.Old+c.New)
+		})
+		p.printChanges("details", ud.Details, func(c *Change) string { return c.Key })
+		p.printChanges("environment", ud.Environment, func(c *Change) string {
+			if c.Status == Changed {
+				return fmt.Sprintf("%s: %q -> %q", c.Key, c.Old, c.New)
+			}
+			return fmt.Sprintf("%s=%q", c.Key, c.Old+c.New)
+		})
+		if len(ud.Fields) > 0 {
+			p.printf("  fields: %s\n", strings.Join(ud.Fields, ", "))
+		}
+	}
+	return p.err
+}
+
+// printer writes formatted text, retaining the first error encountered.
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+var statusMarks = map[string]string{Added: "+", Removed: "-", Changed: "~"}
+
+func (p *printer) printChanges(name string, changes []*Change, describe func(*Change) string) {
+	if len(changes) == 0 {
+		return
+	}
+	p.printf("  %s:\n", name)
+	for _, c := range changes {
+		p.printf("    %s %s\n", statusMarks[c.Status], describe(c))
+	}
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diffcmd
+
+import (
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/platform/kzip"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func unit(digest, signature string, cu *apb.CompilationUnit) *kzip.Unit {
+	cu.VName = &spb.VName{Corpus: "corpus", Language: "go", Signature: signature}
+	return &kzip.Unit{Digest: digest, Proto: cu}
+}
+
+func input(path, digest string) *apb.CompilationUnit_FileInput {
+	return &apb.CompilationUnit_FileInput{Info: &apb.FileInfo{Path: path, Digest: digest}}
+}
+
+func env(name, value string) *apb.CompilationUnit_Env {
+	return &apb.CompilationUnit_Env{Name: name, Value: value}
+}
+
+func TestCompare(t *testing.T) {
+	oldUnits := []*kzip.Unit{
+		unit("d1", "same", &apb.CompilationUnit{Argument: []string{"-x"}}),
+		unit("d2", "gone", &apb.CompilationUnit{}),
+		unit("d3", "changed", &apb.CompilationUnit{
+			Argument:      []string{"cc", "-O2", "-c", "a.c"},
+			RequiredInput: []*apb.CompilationUnit_FileInput{input("a.c", "1"), input("a.h", "2"), input("b.h", "3")},
+			Details:       []*anypb.Any{{TypeUrl: "kythe.io/proto/x", Value: []byte("x")}, {TypeUrl: "kythe.io/proto/y"}},
+			Environment:   []*apb.CompilationUnit_Env{env("HOME", "/old"), env("TMP", "/tmp")},
+			OutputKey:     "a.o",
+			SourceFile:    []string{"a.c"},
+		}),
+	}
+	newUnits := []*kzip.Unit{
+		unit("d1", "same", &apb.CompilationUnit{Argument: []string{"-x"}}),
+		unit("d4", "new", &apb.CompilationUnit{}),
+		unit("d5", "changed", &apb.CompilationUnit{
+			Argument:      []string{"cc", "-g", "-c", "a.c", "-Wall"},
+			RequiredInput: []*apb.CompilationUnit_FileInput{input("a.h", "2"), input("a.c", "4"), input("c.h", "5")},
+			Details:       []*anypb.Any{{TypeUrl: "kythe.io/proto/x", Value: []byte("z")}, {TypeUrl: "kythe.io/proto/z"}},
+			Environment:   []*apb.CompilationUnit_Env{env("HOME", "/new"), env("PWD", "/src")},
+			OutputKey:     "b.o",
+			SourceFile:    []string{"a.c"},
+		}),
+	}
+
+	expected := &Diff{
+		Added:   []string{"kythe://corpus?lang=go#new"},
+		Removed: []string{"kythe://corpus?lang=go#gone"},
+		Changed: []*UnitDiff{{
+			Unit:      "kythe://corpus?lang=go#changed",
+			OldDigest: "d3",
+			NewDigest: "d5",
+			Arguments: []*Change{
+				{Status: Removed, Key: "1", Old: "-O2"},
+				{Status: Added, Key: "1", New: "-g"},
+				{Status: Added, Key: "4", New: "-Wall"},
+			},
+			RequiredInputs: []*Change{
+				{Status: Changed, Key: "a.c", Old: "1", New: "4"},
+				{Status: Removed, Key: "b.h", Old: "3"},
+				{Status: Added, Key: "c.h", New: "5"},
+			},
+			Details: []*Change{
+				{Status: Changed, Key: "kythe.io/proto/x"},
+				{Status: Removed, Key: "kythe.io/proto/y"},
+				{Status: Added, Key: "kythe.io/proto/z"},
+			},
+			Environment: []*Change{
+				{Status: Changed, Key: "HOME", Old: "/old", New: "/new"},
+				{Status: Added, Key: "PWD", New: "/src"},
+				{Status: Removed, Key: "TMP", Old: "/tmp"},
+			},
+			Fields: []string{"output_key"},
+		}},
+	}
+	if diff := cmp.Diff(expected, Compare(oldUnits, newUnits)); diff != "" {
+		t.Errorf("Compare: (-expected; +found):\n%s", diff)
+	}
+}
+
+func TestCompareDuplicates(t *testing.T) {
+	units := []*kzip.Unit{
+		unit("d1", "dup", &apb.CompilationUnit{OutputKey: "1"}),
+		unit("d2", "dup", &apb.CompilationUnit{OutputKey: "2"}),
+	}
+	expected := &Diff{Removed: []string{"kythe://corpus?lang=go#dup (2)"}}
+	if diff := cmp.Diff(expected, Compare(units, units[:1])); diff != "" {
+		t.Errorf("Compare: (-expected; +found):\n%s", diff)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf strings.Builder
+	if err := new(Diff).Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	} else if found := buf.String(); found != "No differences\n" {
+		t.Errorf("Write: found %q", found)
+	}
+
+	buf.Reset()
+	d := &Diff{
+		Added: []string{"kythe:#a"},
+		Changed: []*UnitDiff{{
+			Unit:           "kythe:#c",
+			OldDigest:      "d1",
+			NewDigest:      "d2",
+			Arguments:      []*Change{{Status: Removed, Key: "0", Old: "-O2"}},
+			RequiredInputs: []*Change{{Status: Changed, Key: "a.c", Old: "1", New: "2"}},
+			Fields:         []string{"output_key"},
+		}},
+	}
+	if err := d.Write(&buf); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	const expected = `Added units (1):
+  + kythe:#a
+Changed unit kythe:#c (d1 -> d2):
+  arguments:
+    - [0] -O2
+  required inputs:
+    ~ a.c: 1 -> 2
+  fields: output_key
+`
+	if found := buf.String(); found != expected {
+		t.Errorf("Write: found:\n%s\nexpected:\n%s", found, expected)
+	}
+}
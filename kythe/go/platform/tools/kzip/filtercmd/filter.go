@@ -0,0 +1,164 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filtercmd
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"kythe.io/kythe/go/platform/kzip"
+
+	"bitbucket.org/creachadair/stringset"
+)
+
+// unitFilter is a conjunction of predicates over compilation units.  The zero
+// value matches every unit.
+type unitFilter struct {
+	digests          stringset.Set
+	languages        stringset.Set
+	corpora          stringset.Set
+	path             *regexp.Regexp
+	sourceGlobs      []string
+	argument         *regexp.Regexp
+	hasCompileErrors *bool
+}
+
+// unitFilter returns the filter described by the command's flags and the given
+// unit digests.  At least one predicate must be given.
+func (c *filterCommand) unitFilter(digests []string) (*unitFilter, error) {
+	f := &unitFilter{
+		digests:     stringset.New(digests...),
+		languages:   stringset.Set(c.languages),
+		corpora:     stringset.Set(c.corpora),
+		sourceGlobs: c.sourceGlobs,
+	}
+	if c.pathRegex != "" {
+		re, err := regexp.Compile(c.pathRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --path_regex: %v", err)
+		}
+		f.path = re
+	}
+	if c.argumentRegex != "" {
+		re, err := regexp.Compile(c.argumentRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --argument_regex: %v", err)
+		}
+		f.argument = re
+	}
+	for _, glob := range f.sourceGlobs {
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("invalid --source_glob %q: %v", glob, err)
+		}
+	}
+	if c.hasCompileErrors.set {
+		f.hasCompileErrors = &c.hasCompileErrors.value
+	}
+
+	if f.digests.Empty() && f.languages.Empty() && f.corpora.Empty() && f.path == nil &&
+		len(f.sourceGlobs) == 0 && f.argument == nil && f.hasCompileErrors == nil {
+		return nil, errors.New("no unit hashes or predicates given")
+	}
+	return f, nil
+}
+
+// matches reports whether u satisfies every predicate of f.
+func (f *unitFilter) matches(u *kzip.Unit) bool {
+	cu := u.Proto
+	switch {
+	case !f.digests.Empty() && !f.digests.Contains(u.Digest):
+		return false
+	case !f.languages.Empty() && !f.languages.Contains(cu.GetVName().GetLanguage()):
+		return false
+	case !f.corpora.Empty() && !f.corpora.Contains(cu.GetVName().GetCorpus()):
+		return false
+	case f.path != nil && !f.path.MatchString(cu.GetVName().GetPath()):
+		return false
+	case f.hasCompileErrors != nil && *f.hasCompileErrors != cu.GetHasCompileErrors():
+		return false
+	case len(f.sourceGlobs) > 0 && !anySourceMatches(f.sourceGlobs, cu.GetSourceFile()):
+		return false
+	case f.argument != nil && !anyArgumentMatches(f.argument, cu.GetArgument()):
+		return false
+	}
+	return true
+}
+
+// anySourceMatches reports whether any of the given source files matches any
+// of the globs.  A glob without a slash is matched against base names.
+func anySourceMatches(globs, sources []string) bool {
+	for _, src := range sources {
+		for _, glob := range globs {
+			name := src
+			if !strings.Contains(glob, "/") {
+				name = path.Base(src)
+			}
+			if ok, _ := path.Match(glob, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func anyArgumentMatches(re *regexp.Regexp, args []string) bool {
+	for _, arg := range args {
+		if re.MatchString(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// optionalBool is a boolean flag that records whether it was set.
+type optionalBool struct {
+	set, value bool
+}
+
+// IsBoolFlag implements part of the flag package's boolFlag interface, so the
+// flag may be given without a value.
+func (b *optionalBool) IsBoolFlag() bool { return true }
+
+// String implements part of the flag.Value interface.
+func (b *optionalBool) String() string {
+	if b == nil || !b.set {
+		return ""
+	}
+	return strconv.FormatBool(b.value)
+}
+
+// Get implements part of the flag.Getter interface.
+func (b *optionalBool) Get() interface{} {
+	if !b.set {
+		return nil
+	}
+	return b.value
+}
+
+// Set implements part of the flag.Value interface.
+func (b *optionalBool) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	b.set, b.value = true, v
+	return nil
+}
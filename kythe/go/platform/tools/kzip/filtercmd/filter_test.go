@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filtercmd
+
+import (
+	"flag"
+	"fmt"
+	"testing"
+
+	"kythe.io/kythe/go/platform/kzip"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func TestUnitFilter(t *testing.T) {
+	units := []*kzip.Unit{{
+		Digest: "d1",
+		Proto: &apb.CompilationUnit{
+			VName:      &spb.VName{Corpus: "c1", Language: "go", Path: "src/foo"},
+			SourceFile: []string{"src/foo/foo.go", "src/foo/bar.go"},
+			Argument:   []string{"-tags=linux"},
+		},
+	}, {
+		Digest: "d2",
+		Proto: &apb.CompilationUnit{
+			VName:            &spb.VName{Corpus: "c2", Language: "c++", Path: "lib/bar"},
+			SourceFile:       []string{"lib/bar/bar.cc"},
+			Argument:         []string{"clang++", "-DNDEBUG", "-c", "lib/bar/bar.cc"},
+			HasCompileErrors: true,
+		},
+	}}
+
+	tests := []struct {
+		args     []string
+		expected []string
+	}{
+		{[]string{"d2"}, []string{"d2"}},
+		{[]string{"--languages=go,java"}, []string{"d1"}},
+		{[]string{"--corpora=c1", "--corpora=c2"}, []string{"d1", "d2"}},
+		{[]string{"--path_regex=^lib/"}, []string{"d2"}},
+		{[]string{"--source_glob=src/*/bar.go"}, []string{"d1"}},
+		{[]string{"--source_glob=*.cc"}, []string{"d2"}},
+		{[]string{"--source_glob=src/*.go,lib/*/*.cc"}, []string{"d2"}},
+		{[]string{"--argument_regex=^-D"}, []string{"d2"}},
+		{[]string{"--has_compile_errors"}, []string{"d2"}},
+		{[]string{"--has_compile_errors=false"}, []string{"d1"}},
+		{[]string{"--languages=go", "--has_compile_errors"}, nil},
+		{[]string{"--corpora=c2", "d1", "d2"}, []string{"d2"}},
+	}
+	for _, test := range tests {
+		c := New().(*filterCommand)
+		fs := flag.NewFlagSet("filter", flag.ContinueOnError)
+		c.SetFlags(fs)
+		if err := fs.Parse(test.args); err != nil {
+			t.Fatalf("Parse(%v): %v", test.args, err)
+		}
+		f, err := c.unitFilter(fs.Args())
+		if err != nil {
+			t.Errorf("unitFilter(%v): %v", test.args, err)
+			continue
+		}
+		var found []string
+		for _, u := range units {
+			if f.matches(u) {
+				found = append(found, u.Digest)
+			}
+		}
+		if fmt.Sprint(found) != fmt.Sprint(test.expected) {
+			t.Errorf("unitFilter(%v): found %v; expected %v", test.args, found, test.expected)
+		}
+	}
+}
+
+func TestUnitFilterErrors(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"--path_regex=("},
+		{"--argument_regex=["},
+		{"--source_glob=["},
+	} {
+		c := New().(*filterCommand)
+		fs := flag.NewFlagSet("filter", flag.ContinueOnError)
+		c.SetFlags(fs)
+		if err := fs.Parse(args); err != nil {
+			t.Fatalf("Parse(%v): %v", args, err)
+		}
+		if f, err := c.unitFilter(fs.Args()); err == nil {
+			t.Errorf("unitFilter(%v): found %+v; expected error", args, f)
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"kythe.io/kythe/go/platform/tools/kzip/flags"
 	"kythe.io/kythe/go/platform/vfs"
 	"kythe.io/kythe/go/util/cmdutil"
+	"kythe.io/kythe/go/util/flagutil"
 
 	"bitbucket.org/creachadair/stringset"
 	"github.com/google/subcommands"
@@ -40,12 +41,25 @@ type filterCommand struct {
 	input    string
 	output   string
 	encoding flags.EncodingFlag
+
+	languages        flagutil.StringSet
+	corpora          flagutil.StringSet
+	pathRegex        string
+	sourceGlobs      flagutil.StringList
+	argumentRegex    string
+	hasCompileErrors optionalBool
 }
 
-// New creates a new subcommand for merging kzip files.
+// New creates a new subcommand for filtering kzip files.
 func New() subcommands.Command {
+	const usage = `--input path --output path [predicates] unit-hash*
+
+Copy the compilation units of the input kzip matching all of the given
+predicates, along with their required inputs, to the output kzip.  If any unit
+hashes are given, only units with those digests are copied.`
+
 	return &filterCommand{
-		Info:     cmdutil.NewInfo("filter", "filter units from kzip file", "--input path --output path unit-hash*"),
+		Info:     cmdutil.NewInfo("filter", "filter units from kzip file", usage),
 		encoding: flags.EncodingFlag{Encoding: kzip.EncodingJSON},
 	}
 }
@@ -56,6 +70,12 @@ func (c *filterCommand) SetFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.output, "output", "", "Path to output kzip file")
 	fs.StringVar(&c.input, "input", "", "Path to input kzip file")
 	fs.Var(&c.encoding, "encoding", "Encoding to use on output, one of JSON, PROTO, or ALL")
+	fs.Var(&c.languages, "languages", "Comma-separated languages of the units to keep (optional)")
+	fs.Var(&c.corpora, "corpora", "Comma-separated corpora of the units to keep (optional)")
+	fs.StringVar(&c.pathRegex, "path_regex", "", "Regular expression matching the VName paths of the units to keep (optional)")
+	fs.Var(&c.sourceGlobs, "source_glob", "Comma-separated glob patterns; keep units with a source file matching any of them.  Patterns without a slash match base names (optional)")
+	fs.StringVar(&c.argumentRegex, "argument_regex", "", "Regular expression; keep units with an argument matching it (optional)")
+	fs.Var(&c.hasCompileErrors, "has_compile_errors", "If set, keep only units whose has_compile_errors field has the given value (optional)")
 }
 
 // Execute implements the subcommands interface and filters the input file.
@@ -66,6 +86,10 @@ func (c *filterCommand) Execute(ctx context.Context, fs *flag.FlagSet, _ ...inte
 	if c.input == "" {
 		return c.Fail("Required --input path missing")
 	}
+	filter, err := c.unitFilter(fs.Args())
+	if err != nil {
+		return c.Fail("Invalid filter: %v", err)
+	}
 	opt := kzip.WithEncoding(c.encoding.Encoding)
 	dir, file := filepath.Split(c.output)
 	if dir == "" {
@@ -82,8 +106,7 @@ func (c *filterCommand) Execute(ctx context.Context, fs *flag.FlagSet, _ ...inte
 	if err != nil {
 		return c.Fail("Error creating temp output: %v", err)
 	}
-	units := stringset.New(fs.Args()...)
-	if err := filterArchive(ctx, tmpOut, c.input, units, opt); err != nil {
+	if err := filterArchive(ctx, tmpOut, c.input, filter, opt); err != nil {
 		return c.Fail("Error filtering archives: %v", err)
 	}
 	if err := vfs.Rename(ctx, tmpName, c.output); err != nil {
@@ -92,7 +115,7 @@ func (c *filterCommand) Execute(ctx context.Context, fs *flag.FlagSet, _ ...inte
 	return subcommands.ExitSuccess
 }
 
-func filterArchive(ctx context.Context, out io.WriteCloser, input string, digests stringset.Set, opts ...kzip.WriterOption) error {
+func filterArchive(ctx context.Context, out io.WriteCloser, input string, filter *unitFilter, opts ...kzip.WriterOption) error {
 	filesAdded := stringset.New()
 
 	f, err := vfs.Open(ctx, input)
@@ -123,7 +146,7 @@ func filterArchive(ctx context.Context, out io.WriteCloser, input string, digest
 
 	// scan the input, and for matching units, copy into output
 	err = rd.Scan(func(u *kzip.Unit) error {
-		if !digests.Contains(u.Digest) {
+		if !filter.matches(u) {
 			// non-matching unit, do not copy
 			return nil
 		}
@@ -27,10 +27,13 @@ import (
 	"os"
 
 	"kythe.io/kythe/go/platform/tools/kzip/createcmd"
+	"kythe.io/kythe/go/platform/tools/kzip/diffcmd"
 	"kythe.io/kythe/go/platform/tools/kzip/filtercmd"
 	"kythe.io/kythe/go/platform/tools/kzip/infocmd"
 	"kythe.io/kythe/go/platform/tools/kzip/mergecmd"
 	"kythe.io/kythe/go/platform/tools/kzip/metadatacmd"
+	"kythe.io/kythe/go/platform/tools/kzip/splitcmd"
+	"kythe.io/kythe/go/platform/tools/kzip/verifycmd"
 	"kythe.io/kythe/go/platform/tools/kzip/viewcmd"
 
 	"github.com/google/subcommands"
@@ -38,10 +41,13 @@ import (
 
 func init() {
 	subcommands.Register(createcmd.New(), "")
+	subcommands.Register(diffcmd.New(), "")
 	subcommands.Register(filtercmd.New(), "")
 	subcommands.Register(infocmd.New(), "")
 	subcommands.Register(mergecmd.New(), "")
 	subcommands.Register(metadatacmd.New(), "")
+	subcommands.Register(splitcmd.New(), "")
+	subcommands.Register(verifycmd.New(), "")
 	subcommands.Register(viewcmd.New(), "")
 }
 
@@ -0,0 +1,254 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package splitcmd provides the kzip command for sharding archives.
+package splitcmd // import "kythe.io/kythe/go/platform/tools/kzip/splitcmd"
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"kythe.io/kythe/go/platform/kzip"
+	"kythe.io/kythe/go/platform/tools/kzip/flags"
+	"kythe.io/kythe/go/platform/vfs"
+	"kythe.io/kythe/go/util/cmdutil"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/google/subcommands"
+)
+
+type splitCommand struct {
+	cmdutil.Info
+
+	input    string
+	output   string
+	shards   int
+	balance  string
+	encoding flags.EncodingFlag
+}
+
+// New creates a new subcommand for sharding kzip files.
+func New() subcommands.Command {
+	const usage = `--input path --output path --shards n [--balance units|bytes]
+
+Split the compilation units of the input kzip into n output kzips of roughly
+equal size, measured by either unit count or the total size of each unit's
+required inputs.  Each shard contains only the files required by its units.
+The shards are written to <output>-%05d-of-%05d.kzip, where <output> is the
+--output path without its .kzip extension.`
+
+	return &splitCommand{
+		Info:     cmdutil.NewInfo("split", "shard a kzip file into several smaller kzips", usage),
+		encoding: flags.EncodingFlag{Encoding: kzip.DefaultEncoding()},
+	}
+}
+
+// SetFlags implements the subcommands interface and provides command-specific flags
+// for sharding kzip files.
+func (c *splitCommand) SetFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.input, "input", "", "Path to input kzip file")
+	fs.StringVar(&c.output, "output", "", "Path prefix of the output kzip shards")
+	fs.IntVar(&c.shards, "shards", 0, "Number of output shards")
+	fs.StringVar(&c.balance, "balance", "units", "How to balance the shards; one of units (unit count) or bytes (total required input size)")
+	fs.Var(&c.encoding, "encoding", "Encoding to use on output, one of JSON, PROTO, or ALL")
+}
+
+// Execute implements the subcommands interface and splits the input file.
+func (c *splitCommand) Execute(ctx context.Context, fs *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	if c.input == "" {
+		return c.Fail("Required --input path missing")
+	} else if c.output == "" {
+		return c.Fail("Required --output path missing")
+	} else if c.shards <= 0 {
+		return c.Fail("--shards must be positive; found %d", c.shards)
+	} else if c.balance != "units" && c.balance != "bytes" {
+		return c.Fail("Unknown --balance %q; expected units or bytes", c.balance)
+	}
+
+	f, err := vfs.Open(ctx, c.input)
+	if err != nil {
+		return c.Fail("Error opening archive: %v", err)
+	}
+	defer f.Close()
+	stat, err := vfs.Stat(ctx, c.input)
+	if err != nil {
+		return c.Fail("Error opening archive: %v", err)
+	}
+	rd, err := kzip.NewReader(f, stat.Size())
+	if err != nil {
+		return c.Fail("Error creating reader: %v", err)
+	}
+
+	units, err := c.unitWeights(rd)
+	if err != nil {
+		return c.Fail("Error scanning archive: %v", err)
+	}
+	assignment := assignShards(units, c.shards)
+
+	prefix := strings.TrimSuffix(c.output, ".kzip")
+	paths := make([]string, c.shards)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("%s-%.5d-of-%.5d.kzip", prefix, i, c.shards)
+	}
+	if err := writeShards(ctx, rd, assignment, paths, kzip.WithEncoding(c.encoding.Encoding)); err != nil {
+		return c.Fail("Error writing shards: %v", err)
+	}
+	return subcommands.ExitSuccess
+}
+
+// A unitWeight is the relative cost of a compilation unit, by digest.
+type unitWeight struct {
+	digest string
+	weight int64
+}
+
+// unitWeights returns the weight of each unit in rd according to the
+// command's balancing mode.
+func (c *splitCommand) unitWeights(rd *kzip.Reader) ([]unitWeight, error) {
+	var units []unitWeight
+	err := rd.Scan(func(u *kzip.Unit) error {
+		w := unitWeight{digest: u.Digest, weight: 1}
+		if c.balance == "bytes" {
+			w.weight = 0
+			seen := stringset.New()
+			for _, ri := range u.Proto.RequiredInput {
+				if !seen.Add(ri.Info.Digest) {
+					continue
+				}
+				size, err := rd.FileSize(ri.Info.Digest)
+				if err != nil {
+					return fmt.Errorf("error finding size of %q: %v", ri.Info.Path, err)
+				}
+				w.weight += size
+			}
+		}
+		units = append(units, w)
+		return nil
+	})
+	return units, err
+}
+
+// assignShards greedily assigns each unit to one of n shards, heaviest units
+// first, always choosing the least-loaded shard.  It returns the shard of each
+// unit by digest.
+func assignShards(units []unitWeight, n int) map[string]int {
+	sort.Slice(units, func(i, j int) bool {
+		if units[i].weight != units[j].weight {
+			return units[i].weight > units[j].weight
+		}
+		return units[i].digest < units[j].digest
+	})
+	loads := make([]int64, n)
+	counts := make([]int, n)
+	assignment := make(map[string]int, len(units))
+	for _, u := range units {
+		shard := 0
+		for i := 1; i < n; i++ {
+			if loads[i] < loads[shard] || (loads[i] == loads[shard] && counts[i] < counts[shard]) {
+				shard = i
+			}
+		}
+		loads[shard] += u.weight
+		counts[shard]++
+		assignment[u.digest] = shard
+	}
+	return assignment
+}
+
+// writeShards copies each unit of rd, along with its required inputs, to the
+// shard given by assignment.  Each shard is written to a temporary file that
+// is renamed to its final path once all shards are complete.
+func writeShards(ctx context.Context, rd *kzip.Reader, assignment map[string]int, paths []string, opts ...kzip.WriterOption) (err error) {
+	type shard struct {
+		tmpName    string
+		out        io.WriteCloser
+		wr         *kzip.Writer
+		filesAdded stringset.Set
+	}
+	shards := make([]*shard, len(paths))
+	defer func() {
+		for _, s := range shards {
+			if s == nil {
+				continue
+			}
+			if s.wr != nil {
+				s.wr.Close()
+			} else if s.out != nil {
+				s.out.Close()
+			}
+			if err != nil {
+				vfs.Remove(ctx, s.tmpName)
+			}
+		}
+	}()
+	for i, path := range paths {
+		dir, file := filepath.Split(path)
+		if dir == "" {
+			dir = "."
+		}
+		out, err := vfs.CreateTempFile(ctx, dir, file)
+		if err != nil {
+			return fmt.Errorf("error creating temp output: %v", err)
+		}
+		s := &shard{tmpName: out.Name(), out: out, filesAdded: stringset.New()}
+		shards[i] = s
+		s.wr, err = kzip.NewWriteCloser(out, opts...)
+		if err != nil {
+			return fmt.Errorf("error creating writer: %v", err)
+		}
+	}
+
+	if err := rd.Scan(func(u *kzip.Unit) error {
+		s := shards[assignment[u.Digest]]
+		for _, ri := range u.Proto.RequiredInput {
+			if s.filesAdded.Add(ri.Info.Digest) {
+				r, err := rd.Open(ri.Info.Digest)
+				if err != nil {
+					return fmt.Errorf("error opening file: %v", err)
+				}
+				if _, err := s.wr.AddFile(r); err != nil {
+					r.Close()
+					return fmt.Errorf("error adding file: %v", err)
+				} else if err := r.Close(); err != nil {
+					return fmt.Errorf("error closing file: %v", err)
+				}
+			}
+		}
+		_, err := s.wr.AddUnit(u.Proto, u.Index)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	for _, s := range shards {
+		wr := s.wr
+		s.wr, s.out = nil, nil
+		if err := wr.Close(); err != nil {
+			return fmt.Errorf("error closing writer: %v", err)
+		}
+	}
+	for i, s := range shards {
+		if err := vfs.Rename(ctx, s.tmpName, paths[i]); err != nil {
+			return fmt.Errorf("error renaming tmp to output: %v", err)
+		}
+	}
+	return nil
+}
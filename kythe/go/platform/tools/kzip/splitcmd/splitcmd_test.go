@@ -0,0 +1,61 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package splitcmd
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAssignShards(t *testing.T) {
+	tests := []struct {
+		weights []int64
+		shards  int
+		loads   []int64
+		counts  []int
+	}{
+		{[]int64{1, 1, 1, 1, 1}, 2, []int64{3, 2}, []int{3, 2}},
+		{[]int64{1, 1}, 4, []int64{1, 1, 0, 0}, []int{1, 1, 0, 0}},
+		{[]int64{10, 7, 5, 4, 3, 1}, 3, []int64{10, 10, 10}, []int{1, 2, 3}},
+		{[]int64{0, 0, 0, 0}, 2, []int64{0, 0}, []int{2, 2}},
+		{nil, 3, []int64{0, 0, 0}, []int{0, 0, 0}},
+	}
+	for _, test := range tests {
+		var units []unitWeight
+		for i, w := range test.weights {
+			units = append(units, unitWeight{digest: fmt.Sprintf("unit%d", i), weight: w})
+		}
+		assignment := assignShards(units, test.shards)
+
+		loads := make([]int64, test.shards)
+		counts := make([]int, test.shards)
+		for _, u := range units {
+			shard, ok := assignment[u.digest]
+			if !ok {
+				t.Fatalf("assignShards(%v, %d): unit %q unassigned", test.weights, test.shards, u.digest)
+			}
+			loads[shard] += u.weight
+			counts[shard]++
+		}
+		if fmt.Sprint(loads) != fmt.Sprint(test.loads) {
+			t.Errorf("assignShards(%v, %d): found loads %v; expected %v", test.weights, test.shards, loads, test.loads)
+		}
+		if fmt.Sprint(counts) != fmt.Sprint(test.counts) {
+			t.Errorf("assignShards(%v, %d): found counts %v; expected %v", test.weights, test.shards, counts, test.counts)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package verifycmd provides the kzip command for checking archive integrity.
+package verifycmd // import "kythe.io/kythe/go/platform/tools/kzip/verifycmd"
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	"kythe.io/kythe/go/platform/kzip"
+	"kythe.io/kythe/go/platform/vfs"
+	"kythe.io/kythe/go/util/cmdutil"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/google/subcommands"
+	"google.golang.org/protobuf/proto"
+)
+
+type verifyCommand struct {
+	cmdutil.Info
+
+	concurrency int
+}
+
+// New creates a new subcommand for verifying kzip files.
+func New() subcommands.Command {
+	const usage = `[--concurrency n] kzip-file+
+
+Check the integrity of each .kzip file: every stored file must match its
+digest, every required input of every unit must be present, and an archive
+storing units in both the JSON and proto encodings must store every unit in
+both, with the encodings agreeing.  Files not required by any
+unit are reported as orphaned, but are not an error.`
+
+	return &verifyCommand{
+		Info: cmdutil.NewInfo("verify", "check the integrity of kzip archives", usage),
+	}
+}
+
+// SetFlags implements the subcommands interface and provides command-specific flags
+// for verifying kzip files.
+func (c *verifyCommand) SetFlags(fs *flag.FlagSet) {
+	fs.IntVar(&c.concurrency, "concurrency", runtime.NumCPU(), "Max number of files to verify concurrently. Defaults to the number of cpu cores.")
+}
+
+// Execute implements the subcommands interface and verifies the given files.
+func (c *verifyCommand) Execute(ctx context.Context, fs *flag.FlagSet, _ ...interface{}) subcommands.ExitStatus {
+	if fs.NArg() == 0 {
+		return c.Fail("Missing .kzip path")
+	}
+	var failed int
+	for _, path := range fs.Args() {
+		r := c.verifyArchive(ctx, path)
+		for _, msg := range r.errors {
+			fmt.Printf("%s: %s\n", path, msg)
+		}
+		for _, digest := range r.orphans {
+			fmt.Printf("%s: orphaned file %s\n", path, digest)
+		}
+		if len(r.errors) > 0 {
+			failed++
+			fmt.Printf("%s: FAILED (%d errors)\n", path, len(r.errors))
+		} else {
+			fmt.Printf("%s: OK (%d units, %d files, %d orphaned)\n", path, r.units, r.files, len(r.orphans))
+		}
+	}
+	if failed > 0 {
+		return c.Fail("%d of %d archives failed verification", failed, fs.NArg())
+	}
+	return subcommands.ExitSuccess
+}
+
+// A report records the problems found in a single archive.
+type report struct {
+	units, files int
+
+	errors  []string // integrity errors
+	orphans []string // digests of files required by no unit
+}
+
+func (r *report) errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (c *verifyCommand) verifyArchive(ctx context.Context, path string) *report {
+	r := new(report)
+	f, err := vfs.Open(ctx, path)
+	if err != nil {
+		r.errorf("error opening archive: %v", err)
+		return r
+	}
+	defer f.Close()
+	stat, err := vfs.Stat(ctx, path)
+	if err != nil {
+		r.errorf("error opening archive: %v", err)
+		return r
+	}
+	rd, err := kzip.NewReader(f, stat.Size())
+	if err != nil {
+		r.errorf("error reading archive: %v", err)
+		return r
+	}
+
+	fileDigests := rd.FileDigests()
+	files := stringset.New(fileDigests...)
+	required := stringset.New()
+
+	// Check the units stored in either encoding, since the reader only lists
+	// those of its canonical encoding.
+	units := stringset.New()
+	var encodings []kzip.Encoding // the encodings in which any unit is stored
+	for _, enc := range []kzip.Encoding{kzip.EncodingProto, kzip.EncodingJSON} {
+		digests, err := rd.UnitDigestsEncoding(enc)
+		if err != nil {
+			r.errorf("error listing units: %v", err)
+			return r
+		} else if len(digests) > 0 {
+			encodings = append(encodings, enc)
+			units.Add(digests...)
+		}
+	}
+	unitDigests := units.Elements()
+	r.units, r.files = len(unitDigests), len(fileDigests)
+
+	for _, digest := range unitDigests {
+		unit := verifyUnit(rd, digest, encodings, r)
+		if unit == nil {
+			continue
+		}
+		for _, ri := range unit.Proto.GetRequiredInput() {
+			fileDigest := ri.GetInfo().GetDigest()
+			required.Add(fileDigest)
+			if !files.Contains(fileDigest) {
+				r.errorf("unit %s: required input %q (%s) is missing", digest, ri.GetInfo().GetPath(), fileDigest)
+			}
+		}
+	}
+
+	r.errors = append(r.errors, verifyFiles(rd, fileDigests, c.concurrency)...)
+	r.orphans = files.Diff(required).Elements()
+	return r
+}
+
+// verifyUnit reads the unit with the given digest in each of the archive's
+// encodings, recording an error in r if it is missing from any of them, fails
+// to decode, or if they disagree.  It returns the unit, or nil if it could not
+// be read.
+func verifyUnit(rd *kzip.Reader, digest string, encodings []kzip.Encoding, r *report) *kzip.Unit {
+	var units []*kzip.Unit
+	for _, enc := range encodings {
+		u, err := rd.LookupEncoding(digest, enc)
+		if err == kzip.ErrDigestNotFound {
+			r.errorf("unit %s: missing from %v encoding", digest, enc)
+			continue
+		} else if err != nil {
+			r.errorf("unit %s: error reading %v encoding: %v", digest, enc, err)
+			return nil
+		}
+		units = append(units, u)
+	}
+	if len(units) == 0 {
+		return nil
+	} else if len(units) == 2 && (!proto.Equal(units[0].Proto, units[1].Proto) || !proto.Equal(units[0].Index, units[1].Index)) {
+		r.errorf("unit %s: %v and %v encodings differ", digest, kzip.EncodingProto, kzip.EncodingJSON)
+	}
+	return units[0]
+}
+
+// verifyFiles checks the content of each of the given files against its
+// digest using up to the given number of concurrent workers.  It returns a
+// description of each failure, ordered by digest.
+func verifyFiles(rd *kzip.Reader, digests []string, concurrency int) []string {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var (
+		mu       sync.Mutex
+		failures = make(map[string]string)
+		wg       sync.WaitGroup
+		queue    = make(chan string)
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for digest := range queue {
+				if err := verifyFile(rd, digest); err != nil {
+					mu.Lock()
+					failures[digest] = fmt.Sprintf("file %s: %v", digest, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, digest := range digests {
+		queue <- digest
+	}
+	close(queue)
+	wg.Wait()
+
+	var msgs []string
+	for _, digest := range digests {
+		if msg, ok := failures[digest]; ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
+}
+
+// verifyFile reports an error if the contents of the given file cannot be read
+// or do not match its digest.
+func verifyFile(rd *kzip.Reader, digest string) error {
+	f, err := rd.Open(digest)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return fmt.Errorf("error reading contents: %v", err)
+	}
+	if found := hex.EncodeToString(hash.Sum(nil)); found != digest {
+		return fmt.Errorf("contents have digest %s", found)
+	}
+	return nil
+}
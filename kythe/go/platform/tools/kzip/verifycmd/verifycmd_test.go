@@ -0,0 +1,198 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package verifycmd
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	apb "kythe.io/kythe/proto/analysis_go_proto"
+)
+
+func digestOf(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeArchive writes a kzip containing the given entries, keyed by path
+// relative to the archive root, and returns its path.
+func writeArchive(t *testing.T, dir, name string, entries map[string][]byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	if _, err := w.Create("root/"); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range entries {
+		e, err := w.Create("root/" + name)
+		if err != nil {
+			t.Fatal(err)
+		} else if _, err := e.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func unitRecords(t *testing.T, cu *apb.CompilationUnit) (pb, json []byte) {
+	t.Helper()
+	ic := &apb.IndexedCompilation{Unit: cu}
+	pb, err := proto.Marshal(ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	json, err = protojson.Marshal(ic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pb, json
+}
+
+func requiring(paths ...string) *apb.CompilationUnit {
+	cu := new(apb.CompilationUnit)
+	for _, p := range paths {
+		cu.RequiredInput = append(cu.RequiredInput, &apb.CompilationUnit_FileInput{
+			Info: &apb.FileInfo{Path: p, Digest: digestOf(p)},
+		})
+	}
+	return cu
+}
+
+func TestVerifyArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "verifycmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	pb, json := unitRecords(t, requiring("a", "b"))
+	otherPB, _ := unitRecords(t, requiring("a"))
+	tests := []struct {
+		name    string
+		entries map[string][]byte
+		errors  []string
+		orphans []string
+	}{{
+		name: "ok",
+		entries: map[string][]byte{
+			"pbunits/u1":             pb,
+			"units/u1":               json,
+			"files/" + digestOf("a"): []byte("a"),
+			"files/" + digestOf("b"): []byte("b"),
+		},
+	}, {
+		name: "orphan",
+		entries: map[string][]byte{
+			"units/u1":               json,
+			"files/" + digestOf("a"): []byte("a"),
+			"files/" + digestOf("b"): []byte("b"),
+			"files/" + digestOf("c"): []byte("c"),
+		},
+		orphans: []string{digestOf("c")},
+	}, {
+		name: "missing",
+		entries: map[string][]byte{
+			"pbunits/u1":             pb,
+			"files/" + digestOf("a"): []byte("a"),
+		},
+		errors: []string{fmt.Sprintf("unit u1: required input %q (%s) is missing", "b", digestOf("b"))},
+	}, {
+		name: "corrupt",
+		entries: map[string][]byte{
+			"pbunits/u1":             pb,
+			"files/" + digestOf("a"): []byte("a"),
+			"files/" + digestOf("b"): []byte("truncated"),
+		},
+		errors: []string{fmt.Sprintf("file %s: contents have digest %s", digestOf("b"), digestOf("truncated"))},
+	}, {
+		name: "disagree",
+		entries: map[string][]byte{
+			"pbunits/u1":             otherPB,
+			"units/u1":               json,
+			"files/" + digestOf("a"): []byte("a"),
+			"files/" + digestOf("b"): []byte("b"),
+		},
+		errors:  []string{"unit u1: Proto and JSON encodings differ"},
+		orphans: []string{digestOf("b")},
+	}, {
+		name: "missing encoding",
+		entries: map[string][]byte{
+			"pbunits/u1":             pb,
+			"pbunits/u2":             otherPB,
+			"units/u1":               json,
+			"files/" + digestOf("a"): []byte("a"),
+			"files/" + digestOf("b"): []byte("b"),
+		},
+		errors: []string{"unit u2: missing from JSON encoding"},
+	}, {
+		name: "undecodable",
+		entries: map[string][]byte{
+			"pbunits/u1": []byte("not a proto"),
+		},
+		errors: []string{"unit u1: error reading Proto encoding"},
+	}}
+
+	c := &verifyCommand{concurrency: 2}
+	for _, test := range tests {
+		path := writeArchive(t, dir, test.name+".kzip", test.entries)
+		r := c.verifyArchive(context.Background(), path)
+		if len(r.errors) != len(test.errors) {
+			t.Errorf("%s: found errors %q; expected %q", test.name, r.errors, test.errors)
+		} else {
+			for i, msg := range r.errors {
+				if !strings.HasPrefix(msg, test.errors[i]) {
+					t.Errorf("%s: found error %q; expected %q", test.name, msg, test.errors[i])
+				}
+			}
+		}
+		if strings.Join(r.orphans, ",") != strings.Join(test.orphans, ",") {
+			t.Errorf("%s: found orphans %q; expected %q", test.name, r.orphans, test.orphans)
+		}
+	}
+
+	// A truncated archive cannot be opened at all.
+	data, err := ioutil.ReadFile(filepath.Join(dir, "ok.kzip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	truncated := filepath.Join(dir, "truncated.kzip")
+	if err := ioutil.WriteFile(truncated, data[:len(data)/2], 0600); err != nil {
+		t.Fatal(err)
+	}
+	if r := c.verifyArchive(context.Background(), truncated); len(r.errors) != 1 {
+		t.Errorf("truncated: found errors %q; expected 1", r.errors)
+	}
+}
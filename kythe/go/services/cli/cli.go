@@ -22,12 +22,15 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strings"
 
+	"kythe.io/kythe/go/services/explore"
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graph"
+	"kythe.io/kythe/go/services/link"
 	"kythe.io/kythe/go/services/web"
 	"kythe.io/kythe/go/services/xrefs"
 	"kythe.io/kythe/go/serving/identifiers"
@@ -41,8 +44,8 @@ import (
 var DisplayJSON bool
 
 var (
-	logRequests = flag.Bool("log_requests", false, "Log all requests to stderr as JSON")
-	out         = os.Stdout
+	logRequests           = flag.Bool("log_requests", false, "Log all requests to stderr as JSON")
+	out         io.Writer = os.Stdout
 )
 
 var jsonMarshaler = web.JSONMarshaler
@@ -58,6 +61,8 @@ type API struct {
 	GraphService      graph.Service
 	FileTreeService   filetree.Service
 	IdentifierService identifiers.Service
+	LinkService       link.Service
+	ExploreService    explore.Service
 }
 
 // Execute registers all Kythe CLI commands to subcommands.DefaultCommander and
@@ -71,8 +76,16 @@ func Execute(ctx context.Context, api API) subcommands.ExitStatus {
 
 	RegisterCommand(&nodesCommand{}, "graph")
 	RegisterCommand(&edgesCommand{}, "graph")
+	RegisterCommand(&queryCommand{}, "graph")
+
+	RegisterCommand(&callgraphCommand{callers: true}, "explore")
+	RegisterCommand(&callgraphCommand{}, "explore")
+	RegisterCommand(&relativesCommand{parents: true}, "explore")
+	RegisterCommand(&relativesCommand{}, "explore")
+	RegisterCommand(&hierarchyCommand{}, "explore")
 
 	RegisterCommand(&identCommand{}, "")
+	RegisterCommand(&linkCommand{}, "")
 	RegisterCommand(&lsCommand{}, "")
 
 	RegisterCommand(&decorCommand{}, "xrefs")
@@ -0,0 +1,411 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"kythe.io/kythe/go/util/flagutil"
+	"kythe.io/kythe/go/util/markedsource"
+
+	"bitbucket.org/creachadair/stringset"
+	"google.golang.org/protobuf/proto"
+
+	epb "kythe.io/kythe/proto/explore_go_proto"
+)
+
+// exploreBatchSize is the maximum number of tickets sent in a single explore
+// request while expanding a call graph.
+const exploreBatchSize = 20
+
+type callgraphCommand struct {
+	baseKytheCommand
+	callers bool
+	depth   int
+	dot     bool
+}
+
+func (c callgraphCommand) Name() string {
+	if c.callers {
+		return "callers"
+	}
+	return "callees"
+}
+func (c callgraphCommand) Synopsis() string {
+	if c.callers {
+		return "display the (recursive) callers of the given functions"
+	}
+	return "display the (recursive) callees of the given functions"
+}
+func (callgraphCommand) Usage() string { return "<ticket>+" }
+func (c *callgraphCommand) SetFlags(flag *flag.FlagSet) {
+	flag.IntVar(&c.depth, "depth", 1, "Maximum depth of the call graph (<= 0 is unbounded)")
+	flag.BoolVar(&c.dot, "dot", false, "Display the call graph in Graphviz DOT format")
+}
+func (c callgraphCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no tickets given")
+	}
+
+	graph := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	seen := stringset.New(flag.Args()...)
+	frontier := flag.Args()
+	for depth := 0; len(frontier) > 0 && (c.depth <= 0 || depth < c.depth); depth++ {
+		var next []string
+		for len(frontier) > 0 {
+			n := len(frontier)
+			if n > exploreBatchSize {
+				n = exploreBatchSize
+			}
+			batch := frontier[:n]
+			frontier = frontier[n:]
+
+			g, err := c.expand(ctx, api, batch)
+			if err != nil {
+				return err
+			}
+			mergeGraph(graph, g)
+
+			// Only the newly found callers (callees) of the batch need to be
+			// expanded at the next depth.
+			for _, ticket := range batch {
+				for _, rel := range c.relatives(g.GetNodes()[ticket]) {
+					if seen.Add(rel) {
+						next = append(next, rel)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+
+	if DisplayJSON {
+		if c.callers {
+			return PrintJSONMessage(&epb.CallersReply{Graph: graph})
+		}
+		return PrintJSONMessage(&epb.CalleesReply{Graph: graph})
+	} else if c.dot {
+		return printDOTGraph(graph)
+	}
+	for _, root := range flag.Args() {
+		if err := printGraphTree(graph, root, c.relatives, 0, stringset.New()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expand returns the call graph one level away from the given tickets.
+func (c callgraphCommand) expand(ctx context.Context, api API, tickets []string) (*epb.Graph, error) {
+	if c.callers {
+		req := &epb.CallersRequest{Tickets: tickets}
+		LogRequest(req)
+		reply, err := api.ExploreService.Callers(ctx, req)
+		return reply.GetGraph(), err
+	}
+	req := &epb.CalleesRequest{Tickets: tickets}
+	LogRequest(req)
+	reply, err := api.ExploreService.Callees(ctx, req)
+	return reply.GetGraph(), err
+}
+
+// relatives returns the callers (callees) of the given node.
+func (c callgraphCommand) relatives(n *epb.GraphNode) []string {
+	if c.callers {
+		return n.GetPredecessors()
+	}
+	return n.GetSuccessors()
+}
+
+type relativesCommand struct {
+	baseKytheCommand
+	parents bool
+	dot     bool
+}
+
+func (c relativesCommand) Name() string {
+	if c.parents {
+		return "parents"
+	}
+	return "children"
+}
+func (c relativesCommand) Synopsis() string {
+	if c.parents {
+		return "display the parents of the given nodes"
+	}
+	return "display the children of the given nodes"
+}
+func (relativesCommand) Usage() string { return "<ticket>+" }
+func (c *relativesCommand) SetFlags(flag *flag.FlagSet) {
+	flag.BoolVar(&c.dot, "dot", false, "Display the relationships in Graphviz DOT format (edges point from child to parent)")
+}
+func (c relativesCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("no tickets given")
+	}
+
+	var (
+		relatives map[string]*epb.Tickets
+		reply     proto.Message
+	)
+	if c.parents {
+		req := &epb.ParentsRequest{Tickets: flag.Args()}
+		LogRequest(req)
+		r, err := api.ExploreService.Parents(ctx, req)
+		if err != nil {
+			return err
+		}
+		relatives, reply = r.GetInputToParents(), r
+	} else {
+		req := &epb.ChildrenRequest{Tickets: flag.Args()}
+		LogRequest(req)
+		r, err := api.ExploreService.Children(ctx, req)
+		if err != nil {
+			return err
+		}
+		relatives, reply = r.GetInputToChildren(), r
+	}
+
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+
+	// Normalize the relationships into a graph with edges from child to parent.
+	graph := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	for ticket, rels := range relatives {
+		for _, rel := range rels.GetTickets() {
+			if c.parents {
+				addGraphEdge(graph, ticket, rel)
+			} else {
+				addGraphEdge(graph, rel, ticket)
+			}
+		}
+	}
+	if c.dot {
+		return printDOTGraph(graph)
+	}
+	for _, ticket := range flag.Args() {
+		if _, err := fmt.Fprintf(out, "%s\n", ticket); err != nil {
+			return err
+		}
+		for _, rel := range sortedTickets(relatives[ticket].GetTickets()) {
+			if _, err := fmt.Fprintf(out, "  %s\n", rel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type hierarchyCommand struct {
+	baseKytheCommand
+	depth     int
+	direction string
+	languages flagutil.StringList
+	dot       bool
+}
+
+func (hierarchyCommand) Name() string      { return "hierarchy" }
+func (hierarchyCommand) Aliases() []string { return []string{"type_hierarchy"} }
+func (hierarchyCommand) Synopsis() string {
+	return "display the supertypes and subtypes of the given type"
+}
+func (hierarchyCommand) Usage() string { return "<ticket>" }
+func (c *hierarchyCommand) SetFlags(flag *flag.FlagSet) {
+	flag.IntVar(&c.depth, "depth", 0, "Maximum depth of the hierarchy (<= 0 is unbounded)")
+	flag.StringVar(&c.direction, "direction", "both", "Direction of the hierarchy to display (both, supertypes, or subtypes)")
+	flag.Var(&c.languages, "languages", "CSV list of languages with which to restrict the hierarchy")
+	flag.BoolVar(&c.dot, "dot", false, "Display the hierarchy in Graphviz DOT format (edges point from subtype to supertype)")
+}
+func (c hierarchyCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("type ticket missing")
+	} else if flag.NArg() > 1 {
+		return fmt.Errorf("only 1 type ticket may be given; found: %v", flag.Args())
+	}
+
+	req := &epb.TypeHierarchyRequest{
+		TypeTicket: flag.Arg(0),
+		MaxDepth:   int32(c.depth),
+	}
+	switch strings.ToLower(c.direction) {
+	case "both":
+		req.Direction = epb.TypeHierarchyRequest_BOTH
+	case "supertypes":
+		req.Direction = epb.TypeHierarchyRequest_SUPERTYPES
+	case "subtypes":
+		req.Direction = epb.TypeHierarchyRequest_SUBTYPES
+	default:
+		return fmt.Errorf("unknown hierarchy direction: %q", c.direction)
+	}
+	if len(c.languages) > 0 {
+		req.NodeFilter = &epb.NodeFilter{IncludedLanguages: c.languages}
+	}
+
+	LogRequest(req)
+	reply, err := api.ExploreService.TypeHierarchy(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	} else if c.dot {
+		return printDOTGraph(reply.GetGraph())
+	}
+
+	root := reply.GetTypeTicket()
+	if root == "" {
+		root = req.TypeTicket
+	}
+	if req.Direction != epb.TypeHierarchyRequest_SUBTYPES {
+		if _, err := fmt.Fprintln(out, "Supertypes:"); err != nil {
+			return err
+		}
+		if err := printGraphTree(reply.GetGraph(), root, (*epb.GraphNode).GetSuccessors, 1, stringset.New()); err != nil {
+			return err
+		}
+	}
+	if req.Direction != epb.TypeHierarchyRequest_SUPERTYPES {
+		if _, err := fmt.Fprintln(out, "Subtypes:"); err != nil {
+			return err
+		}
+		if err := printGraphTree(reply.GetGraph(), root, (*epb.GraphNode).GetPredecessors, 1, stringset.New()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeGraph adds the nodes and edges of src to dst.
+func mergeGraph(dst, src *epb.Graph) {
+	for ticket, n := range src.GetNodes() {
+		node := graphNode(dst, ticket)
+		if node.NodeData == nil {
+			node.NodeData = n.GetNodeData()
+		}
+		for _, succ := range n.GetSuccessors() {
+			addGraphEdge(dst, ticket, succ)
+		}
+		for _, pred := range n.GetPredecessors() {
+			addGraphEdge(dst, pred, ticket)
+		}
+	}
+}
+
+// addGraphEdge adds an edge from one ticket to another in g, if it does not
+// already exist.
+func addGraphEdge(g *epb.Graph, from, to string) {
+	src, dst := graphNode(g, from), graphNode(g, to)
+	if !stringset.Contains(src.Successors, to) {
+		src.Successors = append(src.Successors, to)
+		dst.Predecessors = append(dst.Predecessors, from)
+	}
+}
+
+// graphNode returns the node for the given ticket in g, adding it if
+// necessary.
+func graphNode(g *epb.Graph, ticket string) *epb.GraphNode {
+	n, ok := g.Nodes[ticket]
+	if !ok {
+		n = &epb.GraphNode{}
+		g.Nodes[ticket] = n
+	}
+	return n
+}
+
+// printGraphTree prints the tree of nodes reachable from ticket in g by
+// following the edges given by next.  Nodes already printed along the current
+// path are marked and not expanded again.
+func printGraphTree(g *epb.Graph, ticket string, next func(*epb.GraphNode) []string, indent int, path stringset.Set) error {
+	node := g.GetNodes()[ticket]
+	label := ticket
+	if id := nodeIdentifier(node); id != "" {
+		label += " [" + id + "]"
+	}
+	if path.Contains(ticket) {
+		_, err := fmt.Fprintf(out, "%s%s (cycle)\n", strings.Repeat("  ", indent), label)
+		return err
+	}
+	if _, err := fmt.Fprintf(out, "%s%s\n", strings.Repeat("  ", indent), label); err != nil {
+		return err
+	}
+
+	path.Add(ticket)
+	defer path.Discard(ticket)
+	for _, rel := range sortedTickets(next(node)) {
+		if err := printGraphTree(g, rel, next, indent+1, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printDOTGraph prints g in the Graphviz DOT format.
+func printDOTGraph(g *epb.Graph) error {
+	if _, err := fmt.Fprintln(out, "digraph kythe {"); err != nil {
+		return err
+	}
+	tickets := make([]string, 0, len(g.GetNodes()))
+	for ticket := range g.GetNodes() {
+		tickets = append(tickets, ticket)
+	}
+	sort.Strings(tickets)
+	for _, ticket := range tickets {
+		label := ticket
+		if id := nodeIdentifier(g.Nodes[ticket]); id != "" {
+			label = id
+		}
+		if _, err := fmt.Fprintf(out, "  %s [label=%s];\n", dotQuote(ticket), dotQuote(label)); err != nil {
+			return err
+		}
+	}
+	for _, ticket := range tickets {
+		for _, succ := range sortedTickets(g.Nodes[ticket].GetSuccessors()) {
+			if _, err := fmt.Fprintf(out, "  %s -> %s;\n", dotQuote(ticket), dotQuote(succ)); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := fmt.Fprintln(out, "}")
+	return err
+}
+
+// nodeIdentifier returns the simple identifier of the given node, if known.
+func nodeIdentifier(n *epb.GraphNode) string {
+	if code := n.GetNodeData().GetCode(); code != nil {
+		return markedsource.RenderSimpleIdentifier(code)
+	}
+	return ""
+}
+
+// dotQuote returns s as a quoted Graphviz DOT identifier.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// sortedTickets returns a sorted copy of the given tickets.
+func sortedTickets(tickets []string) []string {
+	sorted := append([]string(nil), tickets...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/services/explore"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/google/go-cmp/cmp"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	epb "kythe.io/kythe/proto/explore_go_proto"
+)
+
+// fakeExplore implements the call graph methods of explore.Service over a
+// fixed set of calls, recording the tickets of each request.
+type fakeExplore struct {
+	explore.Service
+	calls    map[string][]string // :: caller → callees
+	requests [][]string
+}
+
+func (f *fakeExplore) Callees(_ context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	f.requests = append(f.requests, req.Tickets)
+	g := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	for _, ticket := range req.Tickets {
+		for _, callee := range f.calls[ticket] {
+			addGraphEdge(g, ticket, callee)
+		}
+	}
+	return &epb.CalleesReply{Graph: g}, nil
+}
+
+func (f *fakeExplore) Callers(_ context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	f.requests = append(f.requests, req.Tickets)
+	g := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	for caller, callees := range f.calls {
+		for _, callee := range callees {
+			if stringset.Contains(req.Tickets, callee) {
+				addGraphEdge(g, caller, callee)
+			}
+		}
+	}
+	return &epb.CallersReply{Graph: g}, nil
+}
+
+// captureOutput redirects the CLI's output to a buffer until the returned
+// function is called.
+func captureOutput() (*bytes.Buffer, func()) {
+	var buf bytes.Buffer
+	old := out
+	out = &buf
+	return &buf, func() { out = old }
+}
+
+func runCallgraph(t *testing.T, api API, callers bool, args ...string) string {
+	t.Helper()
+	buf, restore := captureOutput()
+	defer restore()
+
+	c := &callgraphCommand{callers: callers}
+	fs := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	c.SetFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Run(context.Background(), fs, api); err != nil {
+		t.Fatalf("Run(%q) error: %v", args, err)
+	}
+	return buf.String()
+}
+
+func TestCallgraphDepth(t *testing.T) {
+	calls := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"d"},
+	}
+	tests := []struct {
+		args     []string
+		requests [][]string
+		output   string
+	}{{
+		args:     []string{"a"},
+		requests: [][]string{{"a"}},
+		output:   "a\n  b\n",
+	}, {
+		args:     []string{"--depth=2", "a"},
+		requests: [][]string{{"a"}, {"b"}},
+		output:   "a\n  b\n    c\n",
+	}, {
+		args:     []string{"--depth=0", "a"},
+		requests: [][]string{{"a"}, {"b"}, {"c"}, {"d"}},
+		output:   "a\n  b\n    c\n      d\n",
+	}, {
+		args:     []string{"--depth=0", "b", "c"},
+		requests: [][]string{{"b", "c"}, {"d"}},
+		output:   "b\n  c\n    d\nc\n  d\n",
+	}}
+	for _, test := range tests {
+		fake := &fakeExplore{calls: calls}
+		output := runCallgraph(t, API{ExploreService: fake}, false, test.args...)
+		if diff := cmp.Diff(test.requests, fake.requests); diff != "" {
+			t.Errorf("callees %q requests: (- expected; + found)\n%s", test.args, diff)
+		}
+		if output != test.output {
+			t.Errorf("callees %q output: got %q; want %q", test.args, output, test.output)
+		}
+	}
+}
+
+func TestCallgraphCallers(t *testing.T) {
+	fake := &fakeExplore{calls: map[string][]string{
+		"a": {"c"},
+		"b": {"c"},
+		"x": {"a"},
+	}}
+	output := runCallgraph(t, API{ExploreService: fake}, true, "--depth=0", "c")
+	if expected := [][]string{{"c"}, {"a", "b"}, {"x"}}; !cmp.Equal(expected, fake.requests, sortedRequests) {
+		t.Errorf("Unexpected callers requests: got %q; want %q", fake.requests, expected)
+	}
+	if expected := "c\n  a\n    x\n  b\n"; output != expected {
+		t.Errorf("Unexpected callers output: got %q; want %q", output, expected)
+	}
+}
+
+// sortedRequests compares requests regardless of the order of their tickets.
+var sortedRequests = cmp.Transformer("sortedTickets", sortedTickets)
+
+func TestCallgraphBatching(t *testing.T) {
+	calls := make(map[string][]string)
+	var callees []string
+	for i := 0; i < 2*exploreBatchSize+5; i++ {
+		callee := fmt.Sprintf("f%02d", i)
+		callees = append(callees, callee)
+		// Every callee calls the same function, which is expanded only once.
+		calls[callee] = []string{"shared"}
+	}
+	calls["root"] = callees
+
+	fake := &fakeExplore{calls: calls}
+	runCallgraph(t, API{ExploreService: fake}, false, "--depth=3", "root")
+
+	var sizes []int
+	for _, req := range fake.requests {
+		sizes = append(sizes, len(req))
+	}
+	if expected := []int{1, exploreBatchSize, exploreBatchSize, 5, 1}; !cmp.Equal(expected, sizes) {
+		t.Errorf("Unexpected request sizes: got %v; want %v", sizes, expected)
+	}
+	expanded := stringset.New()
+	for _, req := range fake.requests {
+		for _, ticket := range req {
+			if !expanded.Add(ticket) {
+				t.Errorf("Ticket %q expanded more than once", ticket)
+			}
+		}
+	}
+}
+
+func TestPrintGraphTreeCycles(t *testing.T) {
+	g := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	addGraphEdge(g, "a", "b")
+	addGraphEdge(g, "b", "a")
+	addGraphEdge(g, "b", "c")
+	addGraphEdge(g, "a", "c")
+	g.Nodes["c"].NodeData = &epb.NodeData{Code: &cpb.MarkedSource{
+		Kind:    cpb.MarkedSource_IDENTIFIER,
+		PreText: "fn",
+	}}
+
+	buf, restore := captureOutput()
+	defer restore()
+	if err := printGraphTree(g, "a", (*epb.GraphNode).GetSuccessors, 0, stringset.New()); err != nil {
+		t.Fatalf("printGraphTree error: %v", err)
+	}
+
+	// Only the repeated node along a path is a cycle; c is reached twice
+	// without one.
+	expected := strings.Join([]string{
+		"a",
+		"  b",
+		"    a (cycle)",
+		"    c [fn]",
+		"  c [fn]",
+	}, "\n") + "\n"
+	if found := buf.String(); found != expected {
+		t.Errorf("Unexpected tree:\n%s\nwant:\n%s", found, expected)
+	}
+}
+
+func TestPrintDOTGraph(t *testing.T) {
+	g := &epb.Graph{Nodes: make(map[string]*epb.GraphNode)}
+	addGraphEdge(g, `kythe:#a"b`, `kythe:#c\d`)
+	g.Nodes[`kythe:#c\d`].NodeData = &epb.NodeData{Code: &cpb.MarkedSource{
+		Kind:    cpb.MarkedSource_IDENTIFIER,
+		PreText: "multi\nline",
+	}}
+
+	buf, restore := captureOutput()
+	defer restore()
+	if err := printDOTGraph(g); err != nil {
+		t.Fatalf("printDOTGraph error: %v", err)
+	}
+
+	expected := `digraph kythe {
+  "kythe:#a\"b" [label="kythe:#a\"b"];
+  "kythe:#c\\d" [label="multi\nline"];
+  "kythe:#a\"b" -> "kythe:#c\\d";
+}
+`
+	if found := buf.String(); found != expected {
+		t.Errorf("Unexpected DOT graph:\n%s\nwant:\n%s", found, expected)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"kythe.io/kythe/go/util/flagutil"
+
+	linkpb "kythe.io/kythe/proto/link_go_proto"
+)
+
+type linkCommand struct {
+	baseKytheCommand
+	corpora, languages, kinds flagutil.StringList
+	include, exclude          flagutil.StringList
+
+	defKind      string
+	params       int
+	includeNodes bool
+}
+
+func (linkCommand) Name() string     { return "link" }
+func (linkCommand) Synopsis() string { return "resolve a qualified name to its definition locations" }
+func (linkCommand) Usage() string    { return "<identifier>" }
+func (c *linkCommand) SetFlags(flag *flag.FlagSet) {
+	flag.Var(&c.corpora, "corpora", "CSV list of corpora with which to restrict matches")
+	flag.Var(&c.languages, "languages", "CSV list of languages with which to restrict matches")
+	flag.Var(&c.kinds, "kinds", "CSV list of node kinds (kind or kind/subkind) with which to restrict matches")
+	flag.Var(&c.include, "include", "CSV list of RE2 path patterns; only definitions in matching files are returned")
+	flag.Var(&c.exclude, "exclude", "CSV list of RE2 path patterns; definitions in matching files are not returned")
+	flag.StringVar(&c.defKind, "definitions", "binding", "Kind of definitions to return (kinds: binding, full, or any)")
+	flag.IntVar(&c.params, "params", -1, "If >= 0, only return definitions with the given number of parameters")
+	flag.BoolVar(&c.includeNodes, "include_nodes", false, "Whether to display the nodes defined at each location")
+}
+func (c linkCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("identifier missing")
+	} else if flag.NArg() > 1 {
+		return fmt.Errorf("only 1 identifier may be given; found: %v", flag.Args())
+	}
+
+	req := &linkpb.LinkRequest{
+		Identifier:   flag.Arg(0),
+		Corpus:       c.corpora,
+		Language:     c.languages,
+		NodeKind:     c.kinds,
+		Include:      pathLocations(c.include),
+		Exclude:      pathLocations(c.exclude),
+		IncludeNodes: c.includeNodes,
+	}
+	switch c.defKind {
+	case "binding":
+		req.DefinitionKind = linkpb.LinkRequest_BINDING
+	case "full":
+		req.DefinitionKind = linkpb.LinkRequest_FULL
+	case "any":
+		req.DefinitionKind = linkpb.LinkRequest_ANY
+	default:
+		return fmt.Errorf("unknown definition kind: %q", c.defKind)
+	}
+	if c.params >= 0 {
+		req.Params = &linkpb.LinkRequest_Params{Count: int32(c.params)}
+	}
+
+	LogRequest(req)
+	reply, err := api.LinkService.Resolve(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.displayLinks(reply)
+}
+
+func (c linkCommand) displayLinks(reply *linkpb.LinkReply) error {
+	if DisplayJSON {
+		return PrintJSONMessage(reply)
+	}
+
+	for _, l := range reply.Links {
+		if _, err := fmt.Fprintf(out, "%s\t[%d:%d-%d:%d)\n", l.FileTicket,
+			l.Span.GetStart().GetLineNumber(), l.Span.GetStart().GetColumnOffset(),
+			l.Span.GetEnd().GetLineNumber(), l.Span.GetEnd().GetColumnOffset()); err != nil {
+			return err
+		}
+		for _, n := range l.Nodes {
+			if _, err := fmt.Fprintf(out, "  %s\t%s\n", n.Ticket, n.Identifier); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pathLocations returns a LinkRequest_Location for each of the given path
+// patterns.
+func pathLocations(paths []string) []*linkpb.LinkRequest_Location {
+	var locs []*linkpb.LinkRequest_Location
+	for _, path := range paths {
+		if path = strings.TrimSpace(path); path != "" {
+			locs = append(locs, &linkpb.LinkRequest_Location{Path: path})
+		}
+	}
+	return locs
+}
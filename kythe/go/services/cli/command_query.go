@@ -0,0 +1,81 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"kythe.io/kythe/go/services/query"
+	"kythe.io/kythe/go/storage/gsutil"
+)
+
+type queryCommand struct {
+	baseKytheCommand
+	graphStore string
+}
+
+func (queryCommand) Name() string     { return "query" }
+func (queryCommand) Synopsis() string { return "evaluate a graph query" }
+func (queryCommand) Usage() string {
+	return `<query>
+
+Queries are path patterns over nodes with optional fact conditions, e.g.
+
+  ?x -[childof]-> ?y WHERE ?y.node/kind = "record" LIMIT 10
+
+Without --graphstore, queries are evaluated against the graph serving tables,
+so each pattern must be connected to a constant (quoted) ticket.`
+}
+func (c *queryCommand) SetFlags(flag *flag.FlagSet) {
+	flag.StringVar(&c.graphStore, "graphstore", "", "If set, evaluate the query against the given GraphStore rather than the graph serving tables")
+}
+func (c queryCommand) Run(ctx context.Context, flag *flag.FlagSet, api API) error {
+	if flag.NArg() == 0 {
+		return errors.New("query missing")
+	}
+	q, err := query.Parse(strings.Join(flag.Args(), " "))
+	if err != nil {
+		return err
+	}
+
+	var g query.Graph = query.Serving{Service: api.GraphService}
+	if c.graphStore != "" {
+		gs, err := gsutil.ParseGraphStore(c.graphStore)
+		if err != nil {
+			return fmt.Errorf("error opening GraphStore: %v", err)
+		}
+		defer gsutil.LogClose(ctx, gs)
+		g = query.GraphStore{Service: gs}
+	}
+
+	vars := q.Vars()
+	return q.Eval(ctx, g, func(r query.Result) error {
+		if DisplayJSON {
+			return PrintJSON(r)
+		}
+		parts := make([]string, len(vars))
+		for i, v := range vars {
+			parts[i] = fmt.Sprintf("?%s=%s", v, r[v])
+		}
+		_, err := fmt.Fprintln(out, strings.Join(parts, "\t"))
+		return err
+	})
+}
@@ -19,6 +19,15 @@ package explore // import "kythe.io/kythe/go/services/explore"
 
 import (
 	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"kythe.io/kythe/go/services/web"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	epb "kythe.io/kythe/proto/explore_go_proto"
 )
@@ -27,7 +36,6 @@ import (
 type Service interface {
 	// Returns the hierarchy (supertypes and subtypes, including implementations)
 	// of a specified type, as a directed acyclic graph.
-	// NOT YET IMPLEMENTED
 	TypeHierarchy(context.Context, *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error)
 
 	// Returns the (recursive) callers of a specified function, as a directed
@@ -44,7 +52,6 @@ type Service interface {
 	Callees(context.Context, *epb.CalleesRequest) (*epb.CalleesReply, error)
 
 	// Returns the parameters of a specified function.
-	// NOT YET IMPLEMENTED
 	Parameters(context.Context, *epb.ParametersRequest) (*epb.ParametersReply, error)
 
 	// Returns the parents of a specified node
@@ -64,3 +71,185 @@ type BoundedRequests struct {
 	MaxTickets int
 	Service
 }
+
+// Callers implements part of the Service interface.
+func (b BoundedRequests) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	if len(req.Tickets) > b.MaxTickets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tickets requested: %d (max %d)", len(req.Tickets), b.MaxTickets)
+	}
+	return b.Service.Callers(ctx, req)
+}
+
+// Callees implements part of the Service interface.
+func (b BoundedRequests) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	if len(req.Tickets) > b.MaxTickets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tickets requested: %d (max %d)", len(req.Tickets), b.MaxTickets)
+	}
+	return b.Service.Callees(ctx, req)
+}
+
+// Parameters implements part of the Service interface.
+func (b BoundedRequests) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	if len(req.FunctionTickets) > b.MaxTickets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tickets requested: %d (max %d)", len(req.FunctionTickets), b.MaxTickets)
+	}
+	return b.Service.Parameters(ctx, req)
+}
+
+// Parents implements part of the Service interface.
+func (b BoundedRequests) Parents(ctx context.Context, req *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	if len(req.Tickets) > b.MaxTickets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tickets requested: %d (max %d)", len(req.Tickets), b.MaxTickets)
+	}
+	return b.Service.Parents(ctx, req)
+}
+
+// Children implements part of the Service interface.
+func (b BoundedRequests) Children(ctx context.Context, req *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	if len(req.Tickets) > b.MaxTickets {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tickets requested: %d (max %d)", len(req.Tickets), b.MaxTickets)
+	}
+	return b.Service.Children(ctx, req)
+}
+
+type webClient struct{ addr string }
+
+// TypeHierarchy implements part of the Service interface.
+func (w *webClient) TypeHierarchy(ctx context.Context, q *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
+	var reply epb.TypeHierarchyReply
+	return &reply, web.Call(w.addr, "type_hierarchy", q, &reply)
+}
+
+// Callers implements part of the Service interface.
+func (w *webClient) Callers(ctx context.Context, q *epb.CallersRequest) (*epb.CallersReply, error) {
+	var reply epb.CallersReply
+	return &reply, web.Call(w.addr, "callers", q, &reply)
+}
+
+// Callees implements part of the Service interface.
+func (w *webClient) Callees(ctx context.Context, q *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	var reply epb.CalleesReply
+	return &reply, web.Call(w.addr, "callees", q, &reply)
+}
+
+// Parameters implements part of the Service interface.
+func (w *webClient) Parameters(ctx context.Context, q *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	var reply epb.ParametersReply
+	return &reply, web.Call(w.addr, "parameters", q, &reply)
+}
+
+// Parents implements part of the Service interface.
+func (w *webClient) Parents(ctx context.Context, q *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	var reply epb.ParentsReply
+	return &reply, web.Call(w.addr, "parents", q, &reply)
+}
+
+// Children implements part of the Service interface.
+func (w *webClient) Children(ctx context.Context, q *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	var reply epb.ChildrenReply
+	return &reply, web.Call(w.addr, "children", q, &reply)
+}
+
+// WebClient returns an explore Service based on a remote web server.
+func WebClient(addr string) Service {
+	return &webClient{addr}
+}
+
+// RegisterHTTPHandlers registers JSON HTTP handlers with mux using the given
+// explore Service.  The following methods will be exposed:
+//
+//   GET /type_hierarchy
+//     Request: JSON encoded explore.TypeHierarchyRequest
+//     Response: JSON encoded explore.TypeHierarchyReply
+//   GET /callers
+//     Request: JSON encoded explore.CallersRequest
+//     Response: JSON encoded explore.CallersReply
+//   GET /callees
+//     Request: JSON encoded explore.CalleesRequest
+//     Response: JSON encoded explore.CalleesReply
+//   GET /parameters
+//     Request: JSON encoded explore.ParametersRequest
+//     Response: JSON encoded explore.ParametersReply
+//   GET /parents
+//     Request: JSON encoded explore.ParentsRequest
+//     Response: JSON encoded explore.ParentsReply
+//   GET /children
+//     Request: JSON encoded explore.ChildrenRequest
+//     Response: JSON encoded explore.ChildrenReply
+//
+// Note: each method will return its response as a serialized protobuf if the
+// "proto" query parameter is set.
+func RegisterHTTPHandlers(ctx context.Context, es Service, mux *http.ServeMux) {
+	handle(mux, "/type_hierarchy", "explore.TypeHierarchy", func(r *http.Request) (proto.Message, error) {
+		var req epb.TypeHierarchyRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			return nil, badRequest{err}
+		}
+		return es.TypeHierarchy(ctx, &req)
+	})
+	handle(mux, "/callers", "explore.Callers", func(r *http.Request) (proto.Message, error) {
+		var req epb.CallersRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			return nil, badRequest{err}
+		}
+		return es.Callers(ctx, &req)
+	})
+	handle(mux, "/callees", "explore.Callees", func(r *http.Request) (proto.Message, error) {
+		var req epb.CalleesRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			return nil, badRequest{err}
+		}
+		return es.Callees(ctx, &req)
+	})
+	handle(mux, "/parameters", "explore.Parameters", func(r *http.Request) (proto.Message, error) {
+		var req epb.ParametersRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			return nil, badRequest{err}
+		}
+		return es.Parameters(ctx, &req)
+	})
+	handle(mux, "/parents", "explore.Parents", func(r *http.Request) (proto.Message, error) {
+		var req epb.ParentsRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			return nil, badRequest{err}
+		}
+		return es.Parents(ctx, &req)
+	})
+	handle(mux, "/children", "explore.Children", func(r *http.Request) (proto.Message, error) {
+		var req epb.ChildrenRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			return nil, badRequest{err}
+		}
+		return es.Children(ctx, &req)
+	})
+}
+
+// badRequest marks a request decoding error so that handle replies with
+// http.StatusBadRequest rather than http.StatusInternalServerError.
+type badRequest struct{ err error }
+
+func (e badRequest) Error() string { return e.err.Error() }
+
+// handle registers a handler for pattern that logs its latency under name and
+// writes the reply returned by call.
+func handle(mux *http.ServeMux, pattern, name string, call func(*http.Request) (proto.Message, error)) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("%s:\t%s", name, time.Since(start))
+		}()
+
+		reply, err := call(r)
+		if err != nil {
+			code := http.StatusInternalServerError
+			if _, ok := err.(badRequest); ok {
+				code = http.StatusBadRequest
+			}
+			http.Error(w, err.Error(), code)
+			return
+		}
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+}
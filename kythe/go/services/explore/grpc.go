@@ -0,0 +1,191 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explore
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	epb "kythe.io/kythe/proto/explore_go_proto"
+)
+
+// grpcServiceName is the full name of the ExploreService in explore.proto.
+const grpcServiceName = "kythe.proto.ExploreService"
+
+type grpcClient struct{ cc grpc.ClientConnInterface }
+
+// TypeHierarchy implements part of the Service interface.
+func (c grpcClient) TypeHierarchy(ctx context.Context, req *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
+	var reply epb.TypeHierarchyReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/TypeHierarchy", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Callers implements part of the Service interface.
+func (c grpcClient) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	var reply epb.CallersReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Callers", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Callees implements part of the Service interface.
+func (c grpcClient) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	var reply epb.CalleesReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Callees", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Parameters implements part of the Service interface.
+func (c grpcClient) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	var reply epb.ParametersReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Parameters", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Parents implements part of the Service interface.
+func (c grpcClient) Parents(ctx context.Context, req *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	var reply epb.ParentsReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Parents", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Children implements part of the Service interface.
+func (c grpcClient) Children(ctx context.Context, req *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	var reply epb.ChildrenReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Children", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GRPCClient returns an explore Service based on a remote gRPC server
+// connection.
+func GRPCClient(cc grpc.ClientConnInterface) Service { return grpcClient{cc} }
+
+// RegisterGRPCServer registers es as the kythe.proto.ExploreService of s.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, es Service) {
+	s.RegisterService(&grpcServiceDesc, es)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*Service)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "TypeHierarchy", Handler: typeHierarchyHandler},
+		{MethodName: "Callers", Handler: callersHandler},
+		{MethodName: "Callees", Handler: calleesHandler},
+		{MethodName: "Parameters", Handler: parametersHandler},
+		{MethodName: "Parents", Handler: parentsHandler},
+		{MethodName: "Children", Handler: childrenHandler},
+	},
+	Metadata: "kythe/proto/explore.proto",
+}
+
+func typeHierarchyHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(epb.TypeHierarchyRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).TypeHierarchy(ctx, req.(*epb.TypeHierarchyRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/TypeHierarchy"}, call)
+}
+
+func callersHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(epb.CallersRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Callers(ctx, req.(*epb.CallersRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Callers"}, call)
+}
+
+func calleesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(epb.CalleesRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Callees(ctx, req.(*epb.CalleesRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Callees"}, call)
+}
+
+func parametersHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(epb.ParametersRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Parameters(ctx, req.(*epb.ParametersRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Parameters"}, call)
+}
+
+func parentsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(epb.ParentsRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Parents(ctx, req.(*epb.ParentsRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Parents"}, call)
+}
+
+func childrenHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(epb.ChildrenRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Children(ctx, req.(*epb.ChildrenRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Children"}, call)
+}
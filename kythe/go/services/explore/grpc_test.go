@@ -0,0 +1,172 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package explore
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"kythe.io/kythe/go/test/testutil"
+
+	epb "kythe.io/kythe/proto/explore_go_proto"
+)
+
+// fakeService returns canned replies and records the last request it received.
+type fakeService struct {
+	typeHierarchy *epb.TypeHierarchyReply
+	callers       *epb.CallersReply
+	callees       *epb.CalleesReply
+	parameters    *epb.ParametersReply
+	parents       *epb.ParentsReply
+	children      *epb.ChildrenReply
+	req           proto.Message
+}
+
+func (f *fakeService) TypeHierarchy(ctx context.Context, req *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
+	f.req = req
+	return f.typeHierarchy, nil
+}
+
+func (f *fakeService) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	f.req = req
+	return f.callers, nil
+}
+
+func (f *fakeService) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	f.req = req
+	return f.callees, nil
+}
+
+func (f *fakeService) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	f.req = req
+	return f.parameters, nil
+}
+
+func (f *fakeService) Parents(ctx context.Context, req *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	f.req = req
+	return f.parents, nil
+}
+
+func (f *fakeService) Children(ctx context.Context, req *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	f.req = req
+	return f.children, nil
+}
+
+func TestGRPC(t *testing.T) {
+	graph := &epb.Graph{Nodes: map[string]*epb.GraphNode{"kythe:#caller": {}}}
+	svc := &fakeService{
+		typeHierarchy: &epb.TypeHierarchyReply{TypeTicket: "kythe:#type", Graph: graph},
+		callers:       &epb.CallersReply{Graph: graph},
+		callees:       &epb.CalleesReply{Graph: graph},
+		parameters: &epb.ParametersReply{
+			FunctionToParameters:  map[string]*epb.Tickets{"kythe:#f": {Tickets: []string{"kythe:#p"}}},
+			FunctionToReturnValue: map[string]string{"kythe:#f": "kythe:#r"},
+		},
+		parents:  &epb.ParentsReply{InputToParents: map[string]*epb.Tickets{"kythe:#c": {Tickets: []string{"kythe:#p"}}}},
+		children: &epb.ChildrenReply{InputToChildren: map[string]*epb.Tickets{"kythe:#p": {Tickets: []string{"kythe:#c"}}}},
+	}
+
+	l := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	RegisterGRPCServer(srv, svc)
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	cc, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := GRPCClient(cc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	tests := []struct {
+		method string
+		req    proto.Message
+		reply  proto.Message
+		call   func(proto.Message) (proto.Message, error)
+	}{{
+		method: "TypeHierarchy",
+		req:    &epb.TypeHierarchyRequest{TypeTicket: "kythe:#type", MaxDepth: 2},
+		reply:  svc.typeHierarchy,
+		call: func(req proto.Message) (proto.Message, error) {
+			return client.TypeHierarchy(ctx, req.(*epb.TypeHierarchyRequest))
+		},
+	}, {
+		method: "Callers",
+		req:    &epb.CallersRequest{Tickets: []string{"kythe:#callee"}},
+		reply:  svc.callers,
+		call: func(req proto.Message) (proto.Message, error) {
+			return client.Callers(ctx, req.(*epb.CallersRequest))
+		},
+	}, {
+		method: "Callees",
+		req:    &epb.CalleesRequest{Tickets: []string{"kythe:#caller"}},
+		reply:  svc.callees,
+		call: func(req proto.Message) (proto.Message, error) {
+			return client.Callees(ctx, req.(*epb.CalleesRequest))
+		},
+	}, {
+		method: "Parameters",
+		req:    &epb.ParametersRequest{FunctionTickets: []string{"kythe:#f"}},
+		reply:  svc.parameters,
+		call: func(req proto.Message) (proto.Message, error) {
+			return client.Parameters(ctx, req.(*epb.ParametersRequest))
+		},
+	}, {
+		method: "Parents",
+		req:    &epb.ParentsRequest{Tickets: []string{"kythe:#c"}},
+		reply:  svc.parents,
+		call: func(req proto.Message) (proto.Message, error) {
+			return client.Parents(ctx, req.(*epb.ParentsRequest))
+		},
+	}, {
+		method: "Children",
+		req:    &epb.ChildrenRequest{Tickets: []string{"kythe:#p"}},
+		reply:  svc.children,
+		call: func(req proto.Message) (proto.Message, error) {
+			return client.Children(ctx, req.(*epb.ChildrenRequest))
+		},
+	}}
+
+	for _, test := range tests {
+		if reply, err := test.call(test.req); err != nil {
+			t.Errorf("%s error: %v", test.method, err)
+		} else if !proto.Equal(reply, test.reply) {
+			t.Errorf("%s reply: found %v; expected %v", test.method, reply, test.reply)
+		} else if !proto.Equal(svc.req, test.req) {
+			t.Errorf("%s request: found %v; expected %v", test.method, svc.req, test.req)
+		}
+	}
+}
+
+func TestGRPCServiceDesc(t *testing.T) {
+	sd := epb.File_kythe_proto_explore_proto.Services().ByName("ExploreService")
+	if err := testutil.ServiceDescEqual(sd, &grpcServiceDesc); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filetree
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	ftpb "kythe.io/kythe/proto/filetree_go_proto"
+)
+
+// grpcServiceName is the full name of the FileTreeService in filetree.proto.
+const grpcServiceName = "kythe.proto.FileTreeService"
+
+type grpcClient struct{ cc grpc.ClientConnInterface }
+
+// CorpusRoots implements part of the Service interface.
+func (c grpcClient) CorpusRoots(ctx context.Context, req *ftpb.CorpusRootsRequest) (*ftpb.CorpusRootsReply, error) {
+	var reply ftpb.CorpusRootsReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/CorpusRoots", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Directory implements part of the Service interface.
+func (c grpcClient) Directory(ctx context.Context, req *ftpb.DirectoryRequest) (*ftpb.DirectoryReply, error) {
+	var reply ftpb.DirectoryReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Directory", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GRPCClient returns a filetree Service based on a remote gRPC server
+// connection.
+func GRPCClient(cc grpc.ClientConnInterface) Service { return grpcClient{cc} }
+
+// RegisterGRPCServer registers ft as the kythe.proto.FileTreeService of s.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, ft Service) {
+	s.RegisterService(&grpcServiceDesc, ft)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*Service)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "CorpusRoots", Handler: corpusRootsHandler},
+		{MethodName: "Directory", Handler: directoryHandler},
+	},
+	Metadata: "kythe/proto/filetree.proto",
+}
+
+func corpusRootsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(ftpb.CorpusRootsRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).CorpusRoots(ctx, req.(*ftpb.CorpusRootsRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/CorpusRoots"}, call)
+}
+
+func directoryHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(ftpb.DirectoryRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Directory(ctx, req.(*ftpb.DirectoryRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Directory"}, call)
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package filetree
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"kythe.io/kythe/go/test/testutil"
+
+	ftpb "kythe.io/kythe/proto/filetree_go_proto"
+)
+
+// fakeService returns canned replies and records the last request it received.
+type fakeService struct {
+	roots *ftpb.CorpusRootsReply
+	dir   *ftpb.DirectoryReply
+	req   proto.Message
+}
+
+func (f *fakeService) CorpusRoots(ctx context.Context, req *ftpb.CorpusRootsRequest) (*ftpb.CorpusRootsReply, error) {
+	f.req = req
+	return f.roots, nil
+}
+
+func (f *fakeService) Directory(ctx context.Context, req *ftpb.DirectoryRequest) (*ftpb.DirectoryReply, error) {
+	f.req = req
+	return f.dir, nil
+}
+
+func TestGRPC(t *testing.T) {
+	svc := &fakeService{
+		roots: &ftpb.CorpusRootsReply{Corpus: []*ftpb.CorpusRootsReply_Corpus{{
+			Name:        "kythe",
+			Root:        []string{"", "bazel-out"},
+			BuildConfig: []string{"opt"},
+		}}},
+		dir: &ftpb.DirectoryReply{
+			Corpus: "kythe",
+			Path:   "kythe/go",
+			Entry: []*ftpb.DirectoryReply_Entry{{
+				Kind: ftpb.DirectoryReply_DIRECTORY,
+				Name: "services",
+			}},
+		},
+	}
+
+	l := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	RegisterGRPCServer(srv, svc)
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	cc, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := GRPCClient(cc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	rootsReq := &ftpb.CorpusRootsRequest{}
+	if reply, err := client.CorpusRoots(ctx, rootsReq); err != nil {
+		t.Errorf("CorpusRoots error: %v", err)
+	} else if !proto.Equal(reply, svc.roots) {
+		t.Errorf("CorpusRoots reply: found %v; expected %v", reply, svc.roots)
+	} else if !proto.Equal(svc.req, rootsReq) {
+		t.Errorf("CorpusRoots request: found %v; expected %v", svc.req, rootsReq)
+	}
+
+	dirReq := &ftpb.DirectoryRequest{Corpus: "kythe", Root: "bazel-out", Path: "kythe/go"}
+	if reply, err := client.Directory(ctx, dirReq); err != nil {
+		t.Errorf("Directory error: %v", err)
+	} else if !proto.Equal(reply, svc.dir) {
+		t.Errorf("Directory reply: found %v; expected %v", reply, svc.dir)
+	} else if !proto.Equal(svc.req, dirReq) {
+		t.Errorf("Directory request: found %v; expected %v", svc.req, dirReq)
+	}
+}
+
+func TestGRPCServiceDesc(t *testing.T) {
+	sd := ftpb.File_kythe_proto_filetree_proto.Services().ByName("FileTreeService")
+	if err := testutil.ServiceDescEqual(sd, &grpcServiceDesc); err != nil {
+		t.Error(err)
+	}
+}
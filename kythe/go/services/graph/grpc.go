@@ -0,0 +1,94 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graph
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	gpb "kythe.io/kythe/proto/graph_go_proto"
+)
+
+// grpcServiceName is the full name of the GraphService in graph.proto.
+const grpcServiceName = "kythe.proto.GraphService"
+
+type grpcClient struct{ cc grpc.ClientConnInterface }
+
+// Nodes implements part of the Service interface.
+func (c grpcClient) Nodes(ctx context.Context, req *gpb.NodesRequest) (*gpb.NodesReply, error) {
+	var reply gpb.NodesReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Nodes", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Edges implements part of the Service interface.
+func (c grpcClient) Edges(ctx context.Context, req *gpb.EdgesRequest) (*gpb.EdgesReply, error) {
+	var reply gpb.EdgesReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Edges", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GRPCClient returns a graph Service based on a remote gRPC server connection.
+func GRPCClient(cc grpc.ClientConnInterface) Service { return grpcClient{cc} }
+
+// RegisterGRPCServer registers gs as the kythe.proto.GraphService of s.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, gs Service) {
+	s.RegisterService(&grpcServiceDesc, gs)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*Service)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Nodes", Handler: nodesHandler},
+		{MethodName: "Edges", Handler: edgesHandler},
+	},
+	Metadata: "kythe/proto/graph.proto",
+}
+
+func nodesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(gpb.NodesRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Nodes(ctx, req.(*gpb.NodesRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Nodes"}, call)
+}
+
+func edgesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(gpb.EdgesRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Edges(ctx, req.(*gpb.EdgesRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Edges"}, call)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graph
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"kythe.io/kythe/go/test/testutil"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	gpb "kythe.io/kythe/proto/graph_go_proto"
+)
+
+// fakeService returns canned replies and records the last request it received.
+type fakeService struct {
+	nodes *gpb.NodesReply
+	edges *gpb.EdgesReply
+	req   proto.Message
+}
+
+func (f *fakeService) Nodes(ctx context.Context, req *gpb.NodesRequest) (*gpb.NodesReply, error) {
+	f.req = req
+	return f.nodes, nil
+}
+
+func (f *fakeService) Edges(ctx context.Context, req *gpb.EdgesRequest) (*gpb.EdgesReply, error) {
+	f.req = req
+	return f.edges, nil
+}
+
+func TestGRPC(t *testing.T) {
+	svc := &fakeService{
+		nodes: &gpb.NodesReply{Nodes: map[string]*cpb.NodeInfo{
+			"kythe:#sig": {Facts: map[string][]byte{"/kythe/node/kind": []byte("record")}},
+		}},
+		edges: &gpb.EdgesReply{NextPageToken: "next"},
+	}
+
+	l := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	RegisterGRPCServer(srv, svc)
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	cc, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := GRPCClient(cc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	nodesReq := &gpb.NodesRequest{Ticket: []string{"kythe:#sig"}, Filter: []string{"/kythe/node/kind"}}
+	if reply, err := client.Nodes(ctx, nodesReq); err != nil {
+		t.Errorf("Nodes error: %v", err)
+	} else if !proto.Equal(reply, svc.nodes) {
+		t.Errorf("Nodes reply: found %v; expected %v", reply, svc.nodes)
+	} else if !proto.Equal(svc.req, nodesReq) {
+		t.Errorf("Nodes request: found %v; expected %v", svc.req, nodesReq)
+	}
+
+	edgesReq := &gpb.EdgesRequest{Ticket: []string{"kythe:#sig"}, Kind: []string{"/kythe/edge/childof"}, PageToken: "page"}
+	if reply, err := client.Edges(ctx, edgesReq); err != nil {
+		t.Errorf("Edges error: %v", err)
+	} else if !proto.Equal(reply, svc.edges) {
+		t.Errorf("Edges reply: found %v; expected %v", reply, svc.edges)
+	} else if !proto.Equal(svc.req, edgesReq) {
+		t.Errorf("Edges request: found %v; expected %v", svc.req, edgesReq)
+	}
+}
+
+func TestGRPCServiceDesc(t *testing.T) {
+	sd := gpb.File_kythe_proto_graph_proto.Services().ByName("GraphService")
+	if err := testutil.ServiceDescEqual(sd, &grpcServiceDesc); err != nil {
+		t.Error(err)
+	}
+}
@@ -63,8 +63,8 @@ type Service interface {
 	// update, an entry (source, kind, target, fact, value) is written into the store,
 	// replacing any existing entry (source, kind, target, fact, value') that may
 	// exist. Note that this operation cannot delete any data from the store; entries are
-	// only ever inserted or updated. Apart from acting atomically, no other constraints
-	// are placed on the implementation.
+	// only ever inserted or updated (see Deleter). Apart from acting atomically, no other
+	// constraints are placed on the implementation.
 	Write(ctx context.Context, req *spb.WriteRequest) error
 
 	// Close and release any underlying resources used by the store.
@@ -72,6 +72,41 @@ type Service interface {
 	Close(ctx context.Context) error
 }
 
+// Deleter is an optional interface for a Service that can remove entries from
+// the store.
+type Deleter interface {
+	Service
+
+	// Delete removes each entry with the given source VName.
+	Delete(ctx context.Context, source *spb.VName) error
+
+	// DeleteRange removes each entry whose source VName has the given corpus
+	// and root and a path within pathPrefix (see InPathRange), regardless of
+	// its signature or language.  Passing a file's path removes the file node
+	// along with its anchors; passing a directory path (ending in "/") removes
+	// every file beneath it.
+	DeleteRange(ctx context.Context, corpus, root, pathPrefix string) error
+}
+
+// ErrDeleteUnsupported is returned when deleting entries from a Service that
+// does not implement Deleter.
+var ErrDeleteUnsupported = errors.New("graphstore: deletion not supported")
+
+// InPathRange reports whether v has the given corpus and root and a path
+// within pathPrefix.  A pathPrefix ending in "/" (or empty) names a directory
+// and matches every path beneath it; any other pathPrefix matches only that
+// exact path, so "foo.h" does not match "foo.hpp".
+func InPathRange(v *spb.VName, corpus, root, pathPrefix string) bool {
+	if v.GetCorpus() != corpus || v.GetRoot() != root {
+		return false
+	}
+	path := v.GetPath()
+	if pathPrefix == "" || strings.HasSuffix(pathPrefix, "/") {
+		return strings.HasPrefix(path, pathPrefix)
+	}
+	return path == pathPrefix
+}
+
 // Sharded represents a store that can be arbitrarily sharded for parallel
 // processing.  Depending on the implementation, these methods may not return
 // consistent results when the store is being written to.  Shards are indexed
@@ -57,8 +57,8 @@ type proxyService struct {
 	stores []graphstore.Service
 }
 
-// New returns a graphstore.Service that forwards Reads, Writes, and Scans to a
-// set of stores in parallel, and merges their results.
+// New returns a graphstore.Service that forwards Reads, Writes, Scans, and
+// Deletes to a set of stores in parallel, and merges their results.
 func New(stores ...graphstore.Service) graphstore.Service { return &proxyService{stores} }
 
 // Read implements graphstore.Service and forwards the request to the proxied stores.
@@ -84,6 +84,30 @@ func (p *proxyService) Write(ctx context.Context, req *spb.WriteRequest) error {
 	}))
 }
 
+// Delete implements part of graphstore.Deleter by forwarding the request to the
+// proxied stores.  Each proxied store must implement graphstore.Deleter.
+func (p *proxyService) Delete(ctx context.Context, source *spb.VName) error {
+	return p.forEachDeleter(func(d graphstore.Deleter) error { return d.Delete(ctx, source) })
+}
+
+// DeleteRange implements part of graphstore.Deleter by forwarding the request
+// to the proxied stores.  Each proxied store must implement
+// graphstore.Deleter.
+func (p *proxyService) DeleteRange(ctx context.Context, corpus, root, pathPrefix string) error {
+	return p.forEachDeleter(func(d graphstore.Deleter) error { return d.DeleteRange(ctx, corpus, root, pathPrefix) })
+}
+
+func (p *proxyService) forEachDeleter(f func(graphstore.Deleter) error) error {
+	for _, s := range p.stores {
+		if _, ok := s.(graphstore.Deleter); !ok {
+			return graphstore.ErrDeleteUnsupported
+		}
+	}
+	return waitErr(p.foreach(func(i int, s graphstore.Service) error {
+		return f(s.(graphstore.Deleter))
+	}))
+}
+
 // Close implements part of graphstore.Service by calling Close on each proxied
 // store.  All the stores are given an opportunity to close, even in case of
 // error, but only one error is returned.
@@ -24,6 +24,8 @@ import (
 	"testing"
 
 	"kythe.io/kythe/go/services/graphstore"
+	"kythe.io/kythe/go/storage/inmemory"
+	gstest "kythe.io/kythe/go/test/services/graphstore"
 
 	"google.golang.org/protobuf/proto"
 
@@ -155,6 +157,17 @@ func TestProxy(t *testing.T) {
 }
 
 // Verify that a proxy store behaves sensibly if an operation fails.
+func TestDelete(t *testing.T) {
+	gstest.DeleteTest(t, func() (gstest.Service, gstest.DestroyFunc, error) {
+		return New(new(inmemory.GraphStore), new(inmemory.GraphStore)), gstest.NullDestroy, nil
+	})
+
+	p := New(new(inmemory.GraphStore), &mockGraphStore{}).(graphstore.Deleter)
+	if err := p.Delete(ctx, &spb.VName{Signature: "sig"}); err != graphstore.ErrDeleteUnsupported {
+		t.Errorf("Delete with a non-Deleter store: found error %v; expected %v", err, graphstore.ErrDeleteUnsupported)
+	}
+}
+
 func TestCancellation(t *testing.T) {
 	bomb := entry{K: "bomb", F: "die", V: "horrible catastrophe"}
 	stores := []graphstore.Service{
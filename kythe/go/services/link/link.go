@@ -21,10 +21,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"sort"
 	"time"
 
+	"kythe.io/kythe/go/services/web"
 	"kythe.io/kythe/go/util/kytheuri"
 	"kythe.io/kythe/go/util/schema/edges"
 	"kythe.io/kythe/go/util/schema/facts"
@@ -42,6 +44,12 @@ import (
 	xpb "kythe.io/kythe/proto/xref_go_proto"
 )
 
+// Service defines the interface for resolving links.
+type Service interface {
+	// Resolve returns the links matching the specified request.
+	Resolve(context.Context, *linkpb.LinkRequest) (*linkpb.LinkReply, error)
+}
+
 // A Resolver implements the link service resolver by dispatching to a Kythe
 // XRefService and IdentifierService to resolve qualified names.
 type Resolver struct {
@@ -375,3 +383,54 @@ func findAnchors(ticket string, rsp *xpb.CrossReferencesReply, include, exclude
 
 	return result
 }
+
+// RegisterHTTPHandlers registers a JSON HTTP handler with mux using the given
+// link Service.  The following method will be exposed:
+//
+//   GET /resolve_link
+//     Request: JSON encoded link.LinkRequest
+//     Response: JSON encoded link.LinkReply
+//
+// Note: /resolve_link will return its response as a serialized protobuf if the
+// "proto" query parameter is set.
+func RegisterHTTPHandlers(ctx context.Context, s Service, mux *http.ServeMux) {
+	mux.HandleFunc("/resolve_link", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("link.Resolve:\t%s", time.Since(start))
+		}()
+		var req linkpb.LinkRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reply, err := s.Resolve(ctx, &req)
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		} else if status.Code(err) == codes.NotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := web.WriteResponse(w, r, reply); err != nil {
+			log.Println(err)
+		}
+	})
+}
+
+type webClient struct{ addr string }
+
+// Resolve implements the Service interface.
+func (w *webClient) Resolve(ctx context.Context, q *linkpb.LinkRequest) (*linkpb.LinkReply, error) {
+	var reply linkpb.LinkReply
+	return &reply, web.Call(w.addr, "resolve_link", q, &reply)
+}
+
+// WebClient returns a link Service based on a remote web server.
+func WebClient(addr string) Service {
+	return &webClient{addr}
+}
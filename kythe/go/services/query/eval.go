@@ -0,0 +1,362 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+)
+
+// A Graph is a Kythe graph against which a Query can be evaluated.
+type Graph interface {
+	// Facts returns the facts of the given node.
+	Facts(ctx context.Context, ticket string) (map[string][]byte, error)
+
+	// Edges calls f with the kind and target ticket of each edge from the given
+	// node with one of the given kinds ("*" matches any kind).  If reverse is
+	// true, f is instead called with the kind and source ticket of each such edge
+	// to the given node.  f may be called more than once for the same edge.
+	Edges(ctx context.Context, ticket string, kinds []string, reverse bool, f func(kind, ticket string) error) error
+
+	// ScanEdges calls f for each edge in the graph with one of the given kinds.
+	// f may be called more than once for the same edge.  ScanEdges returns
+	// ErrScanUnsupported if the graph cannot be scanned.
+	ScanEdges(ctx context.Context, kinds []string, f func(source, kind, target string) error) error
+
+	// ScanNodes calls f with the ticket of each node in the graph with the
+	// given fact.  If value is non-nil, only nodes whose fact has the given value
+	// are passed to f.  ScanNodes returns ErrScanUnsupported if the graph cannot
+	// be scanned.
+	ScanNodes(ctx context.Context, fact string, value []byte, f func(ticket string) error) error
+}
+
+// ErrScanUnsupported is returned by a Graph that does not support scans.  A
+// query can still be evaluated against such a Graph if each of its patterns is
+// connected to a constant ticket.
+var ErrScanUnsupported = errors.New("graph does not support scans; anchor each pattern of the query with a ticket")
+
+// A Result is an assignment of node tickets to the variables of a Query.
+type Result map[string]string
+
+// String returns the variable assignments of r ordered by variable name.
+func (r Result) String() string {
+	vars := stringset.FromKeys(r).Elements()
+	parts := make([]string, len(vars))
+	for i, v := range vars {
+		parts[i] = fmt.Sprintf("?%s=%s", v, r[v])
+	}
+	return strings.Join(parts, " ")
+}
+
+// errLimit is used internally to stop an evaluation once its limit is reached.
+var errLimit = errors.New("query limit reached")
+
+// Eval evaluates q against g, calling f with each distinct Result.  If f
+// returns an error, evaluation stops and that error is returned.
+func (q *Query) Eval(ctx context.Context, g Graph, f func(Result) error) error {
+	e := &evaluator{
+		q:     q,
+		g:     g,
+		f:     f,
+		bound: make(Result),
+		done:  make([]bool, len(q.Edges)),
+		facts: make(map[string]map[string][]byte),
+		seen:  stringset.New(),
+	}
+
+	// Conditions on constant tickets are independent of any variable.
+	for _, c := range q.Conditions {
+		if c.Node.Var != "" {
+			continue
+		}
+		if ok, err := e.accepts(ctx, c, c.Node.Ticket); err != nil || !ok {
+			return err
+		}
+	}
+
+	if err := e.solve(ctx); err != errLimit {
+		return err
+	}
+	return nil
+}
+
+type evaluator struct {
+	q *Query
+	g Graph
+	f func(Result) error
+
+	bound Result                       // current variable assignments
+	done  []bool                       // edge patterns satisfied by bound
+	facts map[string]map[string][]byte // cache of node facts
+	seen  stringset.Set                // results already emitted
+	found int                          // number of results emitted
+}
+
+// solve extends the current variable assignments to satisfy each remaining
+// edge pattern and variable of the query, emitting each complete result.
+func (e *evaluator) solve(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Prefer an edge pattern with at least one endpoint already resolved.
+	next := -1
+	for i, p := range e.q.Edges {
+		if e.done[i] {
+			continue
+		}
+		if _, ok := e.resolve(p.Source); ok {
+			next = i
+			break
+		} else if _, ok := e.resolve(p.Target); ok {
+			next = i
+			break
+		} else if next < 0 {
+			next = i
+		}
+	}
+
+	if next < 0 {
+		// All edge patterns are satisfied; bind any remaining free variables.
+		for _, v := range e.q.vars {
+			if _, ok := e.bound[v]; !ok {
+				return e.seed(ctx, v)
+			}
+		}
+		return e.emit()
+	}
+
+	p := e.q.Edges[next]
+	src, srcOK := e.resolve(p.Source)
+	tgt, tgtOK := e.resolve(p.Target)
+	if !srcOK && !tgtOK {
+		// Neither endpoint is resolved.  Prefer seeding a variable restricted by
+		// a condition over scanning every edge of the pattern's kinds.
+		if e.seedable(p.Source.Var) {
+			return e.seed(ctx, p.Source.Var)
+		} else if e.seedable(p.Target.Var) {
+			return e.seed(ctx, p.Target.Var)
+		}
+		type edge struct{ source, target string }
+		var edges []edge
+		if err := e.g.ScanEdges(ctx, p.Kinds, func(source, kind, target string) error {
+			if p.Matches(kind) {
+				edges = append(edges, edge{source, target})
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, edge := range distinct(len(edges), func(i int) string { return edges[i].source + "\x00" + edges[i].target }) {
+			if err := e.withEdge(next, func() error {
+				return e.bindAll(ctx, []string{p.Source.Var, p.Target.Var}, []string{edges[edge].source, edges[edge].target})
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Follow the edges from (or to) the resolved endpoint.
+	from, v, want, reverse := src, p.Target.Var, tgt, false
+	if !srcOK {
+		from, v, want, reverse = tgt, p.Source.Var, src, true
+	}
+	var others []string
+	if err := e.g.Edges(ctx, from, p.Kinds, reverse, func(kind, ticket string) error {
+		if p.Matches(kind) {
+			others = append(others, ticket)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, i := range distinct(len(others), func(i int) string { return others[i] }) {
+		other := others[i]
+		if srcOK && tgtOK {
+			if other != want {
+				continue
+			}
+			return e.withEdge(next, func() error { return e.solve(ctx) })
+		}
+		if err := e.withEdge(next, func() error {
+			return e.bindAll(ctx, []string{v}, []string{other})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withEdge marks the given edge pattern as satisfied while calling f.
+func (e *evaluator) withEdge(i int, f func() error) error {
+	e.done[i] = true
+	defer func() { e.done[i] = false }()
+	return f()
+}
+
+// bindAll binds each variable in vars to the corresponding ticket, if
+// consistent with the current assignments and the query's conditions, and then
+// continues solving the query.
+func (e *evaluator) bindAll(ctx context.Context, vars, tickets []string) error {
+	var added []string
+	defer func() {
+		for _, v := range added {
+			delete(e.bound, v)
+		}
+	}()
+	for i, v := range vars {
+		if v == "" {
+			// A constant term; it was already resolved.
+			continue
+		} else if t, ok := e.bound[v]; ok {
+			if t != tickets[i] {
+				return nil
+			}
+			continue
+		}
+		if ok, err := e.acceptsAll(ctx, v, tickets[i]); err != nil || !ok {
+			return err
+		}
+		e.bound[v] = tickets[i]
+		added = append(added, v)
+	}
+	return e.solve(ctx)
+}
+
+// seedable reports whether the given variable is restricted by a condition
+// usable to scan for its candidate values.
+func (e *evaluator) seedable(v string) bool {
+	return v != "" && e.seedCondition(v) != nil
+}
+
+// seedCondition returns the best condition on v with which to scan for its
+// candidate values, or nil if there is none.
+func (e *evaluator) seedCondition(v string) *Condition {
+	var best *Condition
+	for _, c := range e.q.Conditions {
+		if c.Node.Var != v || c.Op == NotEqual {
+			continue
+		} else if c.Op == Equal {
+			return c
+		} else if best == nil {
+			best = c
+		}
+	}
+	return best
+}
+
+// seed binds the variable v to each node satisfying its conditions.
+func (e *evaluator) seed(ctx context.Context, v string) error {
+	c := e.seedCondition(v)
+	if c == nil {
+		return fmt.Errorf("query: variable ?%s must be restricted by an edge or by a %q or %q condition", v, Equal, Matches)
+	}
+	var value []byte
+	if c.Op == Equal {
+		value = []byte(c.Value)
+	}
+	var tickets []string
+	if err := e.g.ScanNodes(ctx, c.Fact, value, func(ticket string) error {
+		tickets = append(tickets, ticket)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, i := range distinct(len(tickets), func(i int) string { return tickets[i] }) {
+		if err := e.bindAll(ctx, []string{v}, []string{tickets[i]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve returns the ticket for the given term, if it is a constant or a
+// bound variable.
+func (e *evaluator) resolve(t Term) (string, bool) {
+	if t.Var == "" {
+		return t.Ticket, true
+	}
+	ticket, ok := e.bound[t.Var]
+	return ticket, ok
+}
+
+// acceptsAll reports whether binding v to ticket satisfies each of the
+// conditions on v.
+func (e *evaluator) acceptsAll(ctx context.Context, v, ticket string) (bool, error) {
+	for _, c := range e.q.Conditions {
+		if c.Node.Var != v {
+			continue
+		}
+		if ok, err := e.accepts(ctx, c, ticket); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// accepts reports whether the given node satisfies c.
+func (e *evaluator) accepts(ctx context.Context, c *Condition, ticket string) (bool, error) {
+	facts, ok := e.facts[ticket]
+	if !ok {
+		var err error
+		facts, err = e.g.Facts(ctx, ticket)
+		if err != nil {
+			return false, fmt.Errorf("error reading facts for %q: %v", ticket, err)
+		}
+		e.facts[ticket] = facts
+	}
+	return c.Accepts(facts[c.Fact]), nil
+}
+
+// emit passes the current assignments to the result callback, unless they
+// were already emitted.
+func (e *evaluator) emit() error {
+	key := e.bound.String()
+	if !e.seen.Add(key) {
+		return nil
+	}
+	res := make(Result, len(e.bound))
+	for v, t := range e.bound {
+		res[v] = t
+	}
+	if err := e.f(res); err != nil {
+		return err
+	}
+	e.found++
+	if e.q.Limit > 0 && e.found >= e.q.Limit {
+		return errLimit
+	}
+	return nil
+}
+
+// distinct returns the indices of the first occurrence of each distinct key
+// among n elements, as given by key.
+func distinct(n int, key func(int) string) []int {
+	seen := stringset.New()
+	var idx []int
+	for i := 0; i < n; i++ {
+		if seen.Add(key(i)) {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
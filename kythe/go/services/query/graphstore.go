@@ -0,0 +1,103 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"kythe.io/kythe/go/services/graphstore"
+	"kythe.io/kythe/go/util/kytheuri"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// GraphStore implements the Graph interface using a graphstore.Service.
+// Reverse edges and scans are implemented using the Service's Scan method.
+type GraphStore struct{ Service graphstore.Service }
+
+// Facts implements part of the Graph interface.
+func (g GraphStore) Facts(ctx context.Context, ticket string) (map[string][]byte, error) {
+	src, err := kytheuri.ToVName(ticket)
+	if err != nil {
+		return nil, err
+	}
+	facts := make(map[string][]byte)
+	return facts, g.Service.Read(ctx, &spb.ReadRequest{Source: src}, func(e *spb.Entry) error {
+		facts[e.FactName] = e.FactValue
+		return nil
+	})
+}
+
+// Edges implements part of the Graph interface.
+func (g GraphStore) Edges(ctx context.Context, ticket string, kinds []string, reverse bool, f func(kind, ticket string) error) error {
+	vname, err := kytheuri.ToVName(ticket)
+	if err != nil {
+		return err
+	}
+	kind := scanKind(kinds)
+	if reverse {
+		return g.Service.Scan(ctx, &spb.ScanRequest{Target: vname, EdgeKind: kind}, func(e *spb.Entry) error {
+			if e.EdgeKind == "" {
+				return nil
+			}
+			return f(e.EdgeKind, kytheuri.ToString(e.Source))
+		})
+	}
+	if kind == "" {
+		kind = "*"
+	}
+	return g.Service.Read(ctx, &spb.ReadRequest{Source: vname, EdgeKind: kind}, func(e *spb.Entry) error {
+		if e.EdgeKind == "" {
+			return nil
+		}
+		return f(e.EdgeKind, kytheuri.ToString(e.Target))
+	})
+}
+
+// ScanEdges implements part of the Graph interface.
+func (g GraphStore) ScanEdges(ctx context.Context, kinds []string, f func(source, kind, target string) error) error {
+	return g.Service.Scan(ctx, &spb.ScanRequest{EdgeKind: scanKind(kinds)}, func(e *spb.Entry) error {
+		if e.EdgeKind == "" {
+			return nil
+		}
+		return f(kytheuri.ToString(e.Source), e.EdgeKind, kytheuri.ToString(e.Target))
+	})
+}
+
+// ScanNodes implements part of the Graph interface.
+func (g GraphStore) ScanNodes(ctx context.Context, fact string, value []byte, f func(ticket string) error) error {
+	if fact == "" {
+		return fmt.Errorf("missing fact name")
+	}
+	return g.Service.Scan(ctx, &spb.ScanRequest{FactPrefix: fact}, func(e *spb.Entry) error {
+		if e.EdgeKind != "" || e.FactName != fact || (value != nil && !bytes.Equal(value, e.FactValue)) {
+			return nil
+		}
+		return f(kytheuri.ToString(e.Source))
+	})
+}
+
+// scanKind returns the edge kind with which to filter a Read or Scan for edges
+// of the given kinds, or "" if no single kind may be used.
+func scanKind(kinds []string) string {
+	if len(kinds) == 1 && kinds[0] != "*" {
+		return kinds[0]
+	}
+	return ""
+}
@@ -0,0 +1,532 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package query implements a small declarative query language over a Kythe
+// graph.  A query is a set of path patterns over nodes, optionally restricted
+// by conditions on the facts of those nodes:
+//
+//	?x -[childof]-> ?y WHERE ?y.node/kind = "record"
+//
+// The grammar of a query is:
+//
+//	query     := path { "," path } [ "WHERE" cond { "AND" cond } ] [ "LIMIT" int ]
+//	path      := term { edge term }
+//	term      := "?" name | quoted-ticket
+//	edge      := "-[" kinds "]->" | "<-[" kinds "]-"
+//	kinds     := kind { "|" kind }
+//	cond      := term "." fact op quoted-value
+//	op        := "=" | "!=" | "=~"
+//
+// Edge kinds and fact names not starting with a '/' are assumed to be
+// abbreviated Kythe schema names: "childof" is "/kythe/edge/childof" and
+// "node/kind" is "/kythe/node/kind".  The edge kind "*" matches an edge of any
+// kind.  The "=~" operator matches fact values against an RE2 regular
+// expression.  Quoted strings use Go syntax.
+//
+// The result of a query is each distinct assignment of node tickets to its
+// variables that satisfies all of its patterns and conditions.
+package query // import "kythe.io/kythe/go/services/query"
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"kythe.io/kythe/go/util/kytheuri"
+)
+
+// A Query is a parsed graph query.
+type Query struct {
+	// Edges are the edge patterns that must be satisfied, in source order.
+	Edges []*EdgePattern
+
+	// Conditions are the fact conditions that must be satisfied.
+	Conditions []*Condition
+
+	// Limit is the maximum number of results of the query (if > 0).
+	Limit int
+
+	vars []string
+}
+
+// A Term is either a variable or a constant node ticket.
+type Term struct {
+	Var    string // the variable name (without its leading '?'), if non-empty
+	Ticket string // the constant node ticket, if Var is empty
+}
+
+// String returns the query syntax for t.
+func (t Term) String() string {
+	if t.Var != "" {
+		return "?" + t.Var
+	}
+	return strconv.Quote(t.Ticket)
+}
+
+// An EdgePattern matches an edge from Source to Target with one of the given
+// Kinds.
+type EdgePattern struct {
+	Source, Target Term
+
+	// Kinds are the matching edge kinds.  A kind of "*" matches any edge.
+	Kinds []string
+}
+
+// Matches reports whether an edge of the given kind satisfies p.
+func (p *EdgePattern) Matches(kind string) bool {
+	for _, k := range p.Kinds {
+		if k == "*" || k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the query syntax for p.
+func (p *EdgePattern) String() string {
+	return fmt.Sprintf("%s -[%s]-> %s", p.Source, strings.Join(p.Kinds, "|"), p.Target)
+}
+
+// An Operator compares a fact value in a Condition.
+type Operator int
+
+// Supported condition operators.
+const (
+	Equal    Operator = iota // =
+	NotEqual                 // !=
+	Matches                  // =~
+)
+
+// String returns the query syntax for op.
+func (op Operator) String() string {
+	switch op {
+	case Equal:
+		return "="
+	case NotEqual:
+		return "!="
+	case Matches:
+		return "=~"
+	default:
+		return fmt.Sprintf("Operator(%d)", int(op))
+	}
+}
+
+// A Condition restricts the value of a fact of a node.
+type Condition struct {
+	Node  Term
+	Fact  string
+	Op    Operator
+	Value string
+
+	re *regexp.Regexp
+}
+
+// Accepts reports whether the given fact value (nil if the fact is missing)
+// satisfies c.  A missing fact only satisfies a NotEqual condition.
+func (c *Condition) Accepts(value []byte) bool {
+	switch c.Op {
+	case Equal:
+		return value != nil && string(value) == c.Value
+	case NotEqual:
+		return value == nil || string(value) != c.Value
+	case Matches:
+		return value != nil && c.re.Match(value)
+	default:
+		return false
+	}
+}
+
+// String returns the query syntax for c.
+func (c *Condition) String() string {
+	return fmt.Sprintf("%s.%s %s %q", c.Node, c.Fact, c.Op, c.Value)
+}
+
+// Vars returns the names of the variables of q in order of their first
+// appearance.
+func (q *Query) Vars() []string { return q.vars }
+
+// String returns the canonical query syntax for q.
+func (q *Query) String() string {
+	var parts []string
+	for _, e := range q.Edges {
+		parts = append(parts, e.String())
+	}
+	for _, v := range q.vars {
+		if !q.inEdge(v) {
+			parts = append(parts, "?"+v)
+		}
+	}
+	s := strings.Join(parts, ", ")
+	for i, c := range q.Conditions {
+		if i == 0 {
+			s += " WHERE "
+		} else {
+			s += " AND "
+		}
+		s += c.String()
+	}
+	if q.Limit > 0 {
+		s += fmt.Sprintf(" LIMIT %d", q.Limit)
+	}
+	return s
+}
+
+func (q *Query) inEdge(v string) bool {
+	for _, e := range q.Edges {
+		if e.Source.Var == v || e.Target.Var == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse parses the given query.
+func Parse(s string) (*Query, error) {
+	p := &parser{lex: lexer{src: s}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	return p.parseQuery()
+}
+
+// EdgeKind returns the full Kythe edge kind for the given (possibly
+// abbreviated) kind.
+func EdgeKind(kind string) string {
+	if kind == "*" || strings.HasPrefix(kind, "/") {
+		return kind
+	}
+	return "/kythe/edge/" + kind
+}
+
+// FactName returns the full Kythe fact name for the given (possibly
+// abbreviated) fact name.
+func FactName(name string) string {
+	if strings.HasPrefix(name, "/") {
+		return name
+	}
+	return "/kythe/" + name
+}
+
+type parser struct {
+	lex lexer
+	tok token
+	q   Query
+}
+
+func (p *parser) next() (err error) {
+	p.tok, err = p.lex.next()
+	return
+}
+
+func (p *parser) errorf(msg string, args ...interface{}) error {
+	return fmt.Errorf("query: offset %d: %s", p.tok.pos, fmt.Sprintf(msg, args...))
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	tok := p.tok
+	if tok.kind != kind {
+		return tok, p.errorf("expected %s; found %s", what, tok)
+	}
+	return tok, p.next()
+}
+
+func (p *parser) isKeyword(kw string) bool {
+	return p.tok.kind == tokWord && strings.EqualFold(p.tok.text, kw)
+}
+
+func (p *parser) parseQuery() (*Query, error) {
+	for {
+		if err := p.parsePath(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokComma {
+			break
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.isKeyword("WHERE") {
+		for {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			if err := p.parseCondition(); err != nil {
+				return nil, err
+			}
+			if !p.isKeyword("AND") {
+				break
+			}
+		}
+	}
+
+	if p.isKeyword("LIMIT") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		tok, err := p.expect(tokWord, "limit")
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(tok.text)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("query: offset %d: invalid limit %q", tok.pos, tok.text)
+		}
+		p.q.Limit = n
+	}
+
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return &p.q, nil
+}
+
+func (p *parser) parsePath() error {
+	src, err := p.parseTerm()
+	if err != nil {
+		return err
+	}
+	for p.tok.kind == tokOutStart || p.tok.kind == tokInStart {
+		reverse := p.tok.kind == tokInStart
+		if err := p.next(); err != nil {
+			return err
+		}
+		var kinds []string
+		for {
+			tok, err := p.expect(tokWord, "edge kind")
+			if err != nil {
+				return err
+			}
+			kinds = append(kinds, EdgeKind(tok.text))
+			if p.tok.kind != tokBar {
+				break
+			}
+			if err := p.next(); err != nil {
+				return err
+			}
+		}
+		if reverse {
+			_, err = p.expect(tokInEnd, `"]-"`)
+		} else {
+			_, err = p.expect(tokOutEnd, `"]->"`)
+		}
+		if err != nil {
+			return err
+		}
+
+		tgt, err := p.parseTerm()
+		if err != nil {
+			return err
+		}
+		if reverse {
+			p.q.Edges = append(p.q.Edges, &EdgePattern{Source: tgt, Target: src, Kinds: kinds})
+		} else {
+			p.q.Edges = append(p.q.Edges, &EdgePattern{Source: src, Target: tgt, Kinds: kinds})
+		}
+		src = tgt
+	}
+	return nil
+}
+
+func (p *parser) parseTerm() (Term, error) {
+	switch tok := p.tok; tok.kind {
+	case tokVar:
+		p.addVar(tok.text)
+		return Term{Var: tok.text}, p.next()
+	case tokString:
+		ticket, err := kytheuri.Fix(tok.text)
+		if err != nil {
+			return Term{}, fmt.Errorf("query: offset %d: invalid ticket %q: %v", tok.pos, tok.text, err)
+		}
+		return Term{Ticket: ticket}, p.next()
+	default:
+		return Term{}, p.errorf("expected variable or ticket; found %s", tok)
+	}
+}
+
+func (p *parser) addVar(v string) {
+	for _, x := range p.q.vars {
+		if x == v {
+			return
+		}
+	}
+	p.q.vars = append(p.q.vars, v)
+}
+
+func (p *parser) parseCondition() error {
+	node, err := p.parseTerm()
+	if err != nil {
+		return err
+	}
+	if _, err := p.expect(tokDot, `"."`); err != nil {
+		return err
+	}
+	fact, err := p.expect(tokWord, "fact name")
+	if err != nil {
+		return err
+	}
+	c := &Condition{Node: node, Fact: FactName(fact.text)}
+	switch p.tok.kind {
+	case tokEq:
+		c.Op = Equal
+	case tokNotEq:
+		c.Op = NotEqual
+	case tokMatch:
+		c.Op = Matches
+	default:
+		return p.errorf("expected operator; found %s", p.tok)
+	}
+	if err := p.next(); err != nil {
+		return err
+	}
+	val, err := p.expect(tokString, "quoted value")
+	if err != nil {
+		return err
+	}
+	c.Value = val.text
+	if c.Op == Matches {
+		if c.re, err = regexp.Compile(c.Value); err != nil {
+			return fmt.Errorf("query: offset %d: invalid regexp: %v", val.pos, err)
+		}
+	}
+	p.q.Conditions = append(p.q.Conditions, c)
+	return nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF      tokenKind = iota
+	tokVar                // ?name
+	tokString             // "quoted"
+	tokWord               // kinds, fact names, keywords, and numbers
+	tokComma              // ,
+	tokDot                // .
+	tokBar                // |
+	tokEq                 // =
+	tokNotEq              // !=
+	tokMatch              // =~
+	tokOutStart           // -[
+	tokOutEnd             // ]->
+	tokInStart            // <-[
+	tokInEnd              // ]-
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of query"
+	case tokString:
+		return strconv.Quote(t.text)
+	case tokVar:
+		return strconv.Quote("?" + t.text)
+	default:
+		return strconv.Quote(t.text)
+	}
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+// punctuation is ordered so that longer tokens are matched first.
+var punctuation = []struct {
+	text string
+	kind tokenKind
+}{
+	{"<-[", tokInStart},
+	{"]->", tokOutEnd},
+	{"-[", tokOutStart},
+	{"]-", tokInEnd},
+	{"!=", tokNotEq},
+	{"=~", tokMatch},
+	{"=", tokEq},
+	{",", tokComma},
+	{".", tokDot},
+	{"|", tokBar},
+}
+
+func isWordStart(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("/_*%", r)
+}
+
+func isWordPart(r rune) bool { return isWordStart(r) || r == '.' || r == '-' }
+
+func isVarPart(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' }
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.src) && unicode.IsSpace(rune(l.src[l.pos])) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, pos: start}, nil
+	}
+
+	rest := l.src[l.pos:]
+	for _, p := range punctuation {
+		if strings.HasPrefix(rest, p.text) {
+			l.pos += len(p.text)
+			return token{kind: p.kind, text: p.text, pos: start}, nil
+		}
+	}
+
+	switch c := rest[0]; {
+	case c == '?':
+		n := strings.IndexFunc(rest[1:], func(r rune) bool { return !isVarPart(r) })
+		if n < 0 {
+			n = len(rest) - 1
+		}
+		if n == 0 {
+			return token{}, fmt.Errorf("query: offset %d: missing variable name", start)
+		}
+		l.pos += 1 + n
+		return token{kind: tokVar, text: rest[1 : 1+n], pos: start}, nil
+	case c == '"' || c == '`':
+		quoted, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return token{}, fmt.Errorf("query: offset %d: invalid quoted string", start)
+		}
+		text, err := strconv.Unquote(quoted)
+		if err != nil {
+			return token{}, fmt.Errorf("query: offset %d: invalid quoted string: %v", start, err)
+		}
+		l.pos += len(quoted)
+		return token{kind: tokString, text: text, pos: start}, nil
+	case isWordStart(rune(c)):
+		n := strings.IndexFunc(rest, func(r rune) bool { return !isWordPart(r) })
+		if n < 0 {
+			n = len(rest)
+		}
+		// A word never ends in '-', so that "kind]-" and "kind-[" are lexed as
+		// expected.
+		n = len(strings.TrimRight(rest[:n], "-"))
+		l.pos += n
+		return token{kind: tokWord, text: rest[:n], pos: start}, nil
+	default:
+		return token{}, fmt.Errorf("query: offset %d: unexpected character %q", start, c)
+	}
+}
@@ -0,0 +1,287 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"kythe.io/kythe/go/storage/inmemory"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema/edges"
+
+	"github.com/google/go-cmp/cmp"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	gpb "kythe.io/kythe/proto/graph_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct{ query, canonical string }{
+		{`?x -[childof]-> ?y WHERE ?y.node/kind = "record"`,
+			`?x -[/kythe/edge/childof]-> ?y WHERE ?y./kythe/node/kind = "record"`},
+		{`?x<-[childof|/kythe/edge/param.0]-?y`,
+			`?y -[/kythe/edge/childof|/kythe/edge/param.0]-> ?x`},
+		{`?a -[*]-> ?b -[ref]-> "kythe://c?lang=go#sig", ?c limit 3`,
+			`?a -[*]-> ?b, ?b -[/kythe/edge/ref]-> "kythe://c?lang=go#sig", ?c LIMIT 3`},
+		{`?f where ?f.node/kind != "file" and ?f.text =~ "^pack.*"`,
+			`?f WHERE ?f./kythe/node/kind != "file" AND ?f./kythe/text =~ "^pack.*"`},
+	}
+	for _, test := range tests {
+		q, err := Parse(test.query)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", test.query, err)
+			continue
+		}
+		if found := q.String(); found != test.canonical {
+			t.Errorf("Parse(%q): found %s; expected %s", test.query, found, test.canonical)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, query := range []string{
+		``,
+		`?`,
+		`?x -[childof]- ?y`,
+		`?x -[]-> ?y`,
+		`?x WHERE ?x.node/kind`,
+		`?x WHERE ?x.node/kind = record`,
+		`?x WHERE ?x.text =~ "("`,
+		`?x LIMIT 0`,
+		`?x ?y`,
+		`"kythe:#a\"`,
+	} {
+		if q, err := Parse(query); err == nil {
+			t.Errorf("Parse(%q): expected error; found %s", query, q)
+		}
+	}
+}
+
+var testEntries = []*spb.Entry{
+	fact("file", "/kythe/node/kind", "file"),
+	fact("rec", "/kythe/node/kind", "record"),
+	fact("rec", "/kythe/subkind", "class"),
+	fact("fn", "/kythe/node/kind", "function"),
+	fact("p0", "/kythe/node/kind", "variable"),
+	fact("p1", "/kythe/node/kind", "variable"),
+	edge("rec", edges.ChildOf, "file"),
+	edge("fn", edges.ChildOf, "rec"),
+	edge("fn", edges.ParamIndex(0), "p0"),
+	edge("fn", edges.ParamIndex(1), "p1"),
+	edge("p0", edges.ChildOf, "fn"),
+	edge("p1", edges.ChildOf, "fn"),
+}
+
+var evalTests = []struct {
+	query    string
+	expected []string // sorted Result strings
+	anchored bool     // whether the query can be evaluated without scans
+}{{
+	query: `?x -[childof]-> ?y WHERE ?y.node/kind = "record"`,
+	expected: []string{
+		"?x=kythe:#fn ?y=kythe:#rec",
+	},
+}, {
+	query: `?x -[childof]-> "kythe:#fn"`,
+	expected: []string{
+		"?x=kythe:#p0",
+		"?x=kythe:#p1",
+	},
+	anchored: true,
+}, {
+	query: `"kythe:#fn" -[param.1]-> ?p`,
+	expected: []string{
+		"?p=kythe:#p1",
+	},
+	anchored: true,
+}, {
+	query: `"kythe:#file" <-[childof]- ?r <-[childof]- ?f -[param.0|param.1]-> ?p`,
+	expected: []string{
+		"?f=kythe:#fn ?p=kythe:#p0 ?r=kythe:#rec",
+		"?f=kythe:#fn ?p=kythe:#p1 ?r=kythe:#rec",
+	},
+	anchored: true,
+}, {
+	query: `?p -[childof]-> ?f, ?f -[*]-> ?p WHERE ?f.node/kind = "function" AND ?p.node/kind = "variable"`,
+	expected: []string{
+		"?f=kythe:#fn ?p=kythe:#p0",
+		"?f=kythe:#fn ?p=kythe:#p1",
+	},
+}, {
+	query: `?n WHERE ?n.node/kind =~ "^(record|file)$" AND ?n.subkind != "class"`,
+	expected: []string{
+		"?n=kythe:#file",
+	},
+}, {
+	query: `?x -[childof]-> ?y WHERE ?x.node/kind = "variable" LIMIT 1`,
+	expected: []string{
+		"?x=kythe:#p0 ?y=kythe:#fn",
+	},
+}, {
+	query:    `"kythe:#rec" -[childof]-> "kythe:#file" WHERE "kythe:#rec".subkind = "class"`,
+	expected: []string{""},
+	anchored: true,
+}, {
+	query:    `"kythe:#rec" -[childof]-> ?x WHERE "kythe:#rec".subkind = "struct"`,
+	anchored: true,
+}}
+
+func TestEvalGraphStore(t *testing.T) {
+	ctx := context.Background()
+	gs := new(inmemory.GraphStore)
+	for _, e := range testEntries {
+		if err := gs.Write(ctx, &spb.WriteRequest{
+			Source: e.Source,
+			Update: []*spb.WriteRequest_Update{{
+				EdgeKind:  e.EdgeKind,
+				Target:    e.Target,
+				FactName:  e.FactName,
+				FactValue: e.FactValue,
+			}},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range evalTests {
+		if diff := cmp.Diff(test.expected, evalQuery(t, test.query, GraphStore{gs})); diff != "" {
+			t.Errorf("%s: (- expected; + found)\n%s", test.query, diff)
+		}
+	}
+}
+
+func TestEvalServing(t *testing.T) {
+	g := Serving{fakeGraphService(testEntries)}
+	for _, test := range evalTests {
+		if !test.anchored {
+			q, err := Parse(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := q.Eval(context.Background(), g, func(Result) error { return nil }); err != ErrScanUnsupported {
+				t.Errorf("%s: expected ErrScanUnsupported; found %v", test.query, err)
+			}
+			continue
+		}
+		if diff := cmp.Diff(test.expected, evalQuery(t, test.query, g)); diff != "" {
+			t.Errorf("%s: (- expected; + found)\n%s", test.query, diff)
+		}
+	}
+}
+
+func TestEvalUnrestrictedVariable(t *testing.T) {
+	q, err := Parse(`?x`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := q.Eval(context.Background(), GraphStore{new(inmemory.GraphStore)}, func(Result) error { return nil }); err == nil {
+		t.Error("expected error for unrestricted variable")
+	}
+}
+
+func evalQuery(t *testing.T, query string, g Graph) []string {
+	t.Helper()
+	q, err := Parse(query)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", query, err)
+	}
+	var results []string
+	if err := q.Eval(context.Background(), g, func(r Result) error {
+		results = append(results, r.String())
+		return nil
+	}); err != nil {
+		t.Fatalf("Eval(%q): %v", query, err)
+	}
+	sort.Strings(results)
+	return results
+}
+
+func fact(sig, name, value string) *spb.Entry {
+	return &spb.Entry{Source: &spb.VName{Signature: sig}, FactName: name, FactValue: []byte(value)}
+}
+
+func edge(src, kind, tgt string) *spb.Entry {
+	return &spb.Entry{
+		Source:   &spb.VName{Signature: src},
+		EdgeKind: kind,
+		Target:   &spb.VName{Signature: tgt},
+		FactName: "/",
+	}
+}
+
+// fakeGraphService is a graph.Service over a set of entries, with edges
+// mirrored and grouped like those of the serving tables.
+type fakeGraphService []*spb.Entry
+
+func (f fakeGraphService) Nodes(_ context.Context, req *gpb.NodesRequest) (*gpb.NodesReply, error) {
+	reply := &gpb.NodesReply{Nodes: make(map[string]*cpb.NodeInfo)}
+	for _, e := range f {
+		ticket := kytheuri.ToString(e.Source)
+		if e.EdgeKind != "" || !contains(req.Ticket, ticket) {
+			continue
+		}
+		n, ok := reply.Nodes[ticket]
+		if !ok {
+			n = &cpb.NodeInfo{Facts: make(map[string][]byte)}
+			reply.Nodes[ticket] = n
+		}
+		n.Facts[e.FactName] = e.FactValue
+	}
+	return reply, nil
+}
+
+func (f fakeGraphService) Edges(_ context.Context, req *gpb.EdgesRequest) (*gpb.EdgesReply, error) {
+	reply := &gpb.EdgesReply{EdgeSets: make(map[string]*gpb.EdgeSet)}
+	add := func(src, kind, tgt string) {
+		base, ordinal, _ := edges.ParseOrdinal(kind)
+		if !contains(req.Ticket, src) || (len(req.Kind) > 0 && !contains(req.Kind, base)) {
+			return
+		}
+		set, ok := reply.EdgeSets[src]
+		if !ok {
+			set = &gpb.EdgeSet{Groups: make(map[string]*gpb.EdgeSet_Group)}
+			reply.EdgeSets[src] = set
+		}
+		grp, ok := set.Groups[base]
+		if !ok {
+			grp = &gpb.EdgeSet_Group{}
+			set.Groups[base] = grp
+		}
+		grp.Edge = append(grp.Edge, &gpb.EdgeSet_Group_Edge{TargetTicket: tgt, Ordinal: int32(ordinal)})
+	}
+	for _, e := range f {
+		if e.EdgeKind == "" {
+			continue
+		}
+		src, tgt := kytheuri.ToString(e.Source), kytheuri.ToString(e.Target)
+		add(src, e.EdgeKind, tgt)
+		add(tgt, edges.Mirror(e.EdgeKind), src)
+	}
+	return reply, nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"kythe.io/kythe/go/services/graph"
+	"kythe.io/kythe/go/util/schema/edges"
+
+	"bitbucket.org/creachadair/stringset"
+
+	gpb "kythe.io/kythe/proto/graph_go_proto"
+)
+
+// Serving implements the Graph interface using a graph.Service, such as the
+// serving tables in kythe.io/kythe/go/serving/graph.  Reverse edges are read
+// from the service's mirrored edges.  Serving tables cannot be scanned, so each
+// pattern of a query evaluated against a Serving graph must be connected to a
+// constant ticket.
+type Serving struct{ Service graph.Service }
+
+// Facts implements part of the Graph interface.
+func (g Serving) Facts(ctx context.Context, ticket string) (map[string][]byte, error) {
+	reply, err := g.Service.Nodes(ctx, &gpb.NodesRequest{
+		Ticket: []string{ticket},
+		Filter: []string{"**"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply.GetNodes()[ticket].GetFacts(), nil
+}
+
+// Edges implements part of the Graph interface.
+func (g Serving) Edges(ctx context.Context, ticket string, kinds []string, reverse bool, f func(kind, ticket string) error) error {
+	req := &gpb.EdgesRequest{Ticket: []string{ticket}}
+	requested := stringset.New()
+	for _, k := range kinds {
+		if k == "*" {
+			requested = nil
+			break
+		}
+		// Ordinals are stored separately from the kinds of serving edges.
+		base, _, _ := edges.ParseOrdinal(k)
+		if reverse {
+			base = edges.Mirror(base)
+		}
+		requested.Add(base)
+	}
+	req.Kind = requested.Elements()
+
+	for {
+		reply, err := g.Service.Edges(ctx, req)
+		if err != nil {
+			return err
+		}
+		for kind, grp := range reply.GetEdgeSets()[ticket].GetGroups() {
+			if edges.IsReverse(kind) != reverse {
+				continue
+			}
+			kind = edges.Canonical(kind)
+			for _, e := range grp.GetEdge() {
+				k := kind
+				if edges.OrdinalKind(kind) {
+					k = fmt.Sprintf("%s.%d", kind, e.GetOrdinal())
+				}
+				if err := f(k, e.GetTargetTicket()); err != nil {
+					return err
+				}
+			}
+		}
+		if reply.GetNextPageToken() == "" {
+			return nil
+		}
+		req.PageToken = reply.GetNextPageToken()
+	}
+}
+
+// ScanEdges implements part of the Graph interface.  It always returns
+// ErrScanUnsupported.
+func (Serving) ScanEdges(context.Context, []string, func(source, kind, target string) error) error {
+	return ErrScanUnsupported
+}
+
+// ScanNodes implements part of the Graph interface.  It always returns
+// ErrScanUnsupported.
+func (Serving) ScanNodes(context.Context, string, []byte, func(ticket string) error) error {
+	return ErrScanUnsupported
+}
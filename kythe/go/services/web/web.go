@@ -18,7 +18,9 @@
 package web // import "kythe.io/kythe/go/services/web"
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -33,7 +35,14 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-const jsonBodyType = "application/json; charset=utf-8"
+const (
+	jsonBodyType   = "application/json; charset=utf-8"
+	ndjsonBodyType = "application/x-ndjson"
+)
+
+// StreamErrorTrailer is the HTTP trailer used to report an error that occurs
+// after a StreamWriter has begun writing its response.
+const StreamErrorTrailer = "X-Kythe-Stream-Error"
 
 // JSONMarshaler is the marshaler used to encode all JSON web requests.
 var JSONMarshaler = Marshaler{
@@ -92,6 +101,93 @@ func Call(server, method string, req, reply proto.Message) error {
 	return nil
 }
 
+// CallStream sends req to the given server method as a JSON-encoded body and
+// calls f with each line of the newline-delimited JSON response body, such as
+// one written by a StreamWriter.  The call is canceled when ctx is done.
+func CallStream(ctx context.Context, server, method string, req proto.Message, f func(line []byte) error) error {
+	body := new(bytes.Buffer)
+	if err := JSONMarshaler.Marshal(body, req); err != nil {
+		return fmt.Errorf("error marshaling %T: %v", req, err)
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(server, "/")+"/"+strings.Trim(method, "/"), body)
+	if err != nil {
+		return fmt.Errorf("http error: %v", err)
+	}
+	hreq.Header.Set("Content-Type", jsonBodyType)
+	resp, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return fmt.Errorf("http error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		rec, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("remote method error (code %d): %s", resp.StatusCode, string(rec))
+	}
+
+	r := bufio.NewReader(resp.Body)
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if err := f(line); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("error reading response body: %v", err)
+		}
+	}
+	if msg := resp.Trailer.Get(StreamErrorTrailer); msg != "" {
+		return fmt.Errorf("remote method error: %s", msg)
+	}
+	return nil
+}
+
+// A StreamWriter writes a stream of protobufs to an HTTP response as
+// newline-delimited JSON, flushing each message as it is written.
+type StreamWriter struct {
+	w       http.ResponseWriter
+	written bool
+}
+
+// NewStreamWriter returns a StreamWriter for w.
+func NewStreamWriter(w http.ResponseWriter) *StreamWriter {
+	w.Header().Set("Content-Type", ndjsonBodyType)
+	w.Header().Set("Trailer", StreamErrorTrailer)
+	return &StreamWriter{w: w}
+}
+
+// Write writes msg to the response as a single line of JSON.
+func (s *StreamWriter) Write(msg proto.Message) error {
+	rec, err := JSONMarshaler.MarshalToString(msg)
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %v", msg, err)
+	}
+	s.written = true
+	if _, err := s.w.Write(append(rec, '\n')); err != nil {
+		return err
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// Finish ends the stream.  If err != nil, it is reported to the client as an
+// error response if nothing has yet been written; otherwise, it is reported in
+// the StreamErrorTrailer.
+func (s *StreamWriter) Finish(err error) {
+	if err == nil {
+		return
+	} else if !s.written {
+		http.Error(s.w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.w.Header().Set(StreamErrorTrailer, err.Error())
+}
+
 // ReadJSONBody reads the entire body of r and unmarshals it from JSON into msg.
 // If the request body is empty, no error is returned and msg is unchanged.
 func ReadJSONBody(r *http.Request, msg proto.Message) error {
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xrefs
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	xpb "kythe.io/kythe/proto/xref_go_proto"
+)
+
+// grpcServiceName is the full name of the XRefService in xref.proto.
+const grpcServiceName = "kythe.proto.XRefService"
+
+type grpcClient struct{ cc grpc.ClientConnInterface }
+
+// Decorations implements part of the Service interface.
+func (c grpcClient) Decorations(ctx context.Context, req *xpb.DecorationsRequest) (*xpb.DecorationsReply, error) {
+	var reply xpb.DecorationsReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Decorations", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// CrossReferences implements part of the Service interface.
+func (c grpcClient) CrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest) (*xpb.CrossReferencesReply, error) {
+	var reply xpb.CrossReferencesReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/CrossReferences", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Documentation implements part of the Service interface.
+func (c grpcClient) Documentation(ctx context.Context, req *xpb.DocumentationRequest) (*xpb.DocumentationReply, error) {
+	var reply xpb.DocumentationReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Documentation", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GRPCClient returns an xrefs Service based on a remote gRPC server
+// connection.
+func GRPCClient(cc grpc.ClientConnInterface) Service { return grpcClient{cc} }
+
+// RegisterGRPCServer registers xs as the kythe.proto.XRefService of s.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, xs Service) {
+	s.RegisterService(&grpcServiceDesc, xs)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*Service)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Decorations", Handler: decorationsHandler},
+		{MethodName: "CrossReferences", Handler: crossReferencesHandler},
+		{MethodName: "Documentation", Handler: documentationHandler},
+	},
+	Metadata: "kythe/proto/xref.proto",
+}
+
+func decorationsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(xpb.DecorationsRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Decorations(ctx, req.(*xpb.DecorationsRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Decorations"}, call)
+}
+
+func crossReferencesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(xpb.CrossReferencesRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).CrossReferences(ctx, req.(*xpb.CrossReferencesRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/CrossReferences"}, call)
+}
+
+func documentationHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(xpb.DocumentationRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Documentation(ctx, req.(*xpb.DocumentationRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Documentation"}, call)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xrefs
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"kythe.io/kythe/go/test/testutil"
+
+	xpb "kythe.io/kythe/proto/xref_go_proto"
+)
+
+// fakeService returns canned replies and records whether each request arrived
+// with a deadline.
+type fakeService struct {
+	xrefs       *xpb.CrossReferencesReply
+	hadDeadline bool
+}
+
+func (f *fakeService) Decorations(ctx context.Context, req *xpb.DecorationsRequest) (*xpb.DecorationsReply, error) {
+	return nil, ErrDecorationsNotFound
+}
+
+func (f *fakeService) CrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest) (*xpb.CrossReferencesReply, error) {
+	_, f.hadDeadline = ctx.Deadline()
+	return f.xrefs, nil
+}
+
+func (f *fakeService) Documentation(ctx context.Context, req *xpb.DocumentationRequest) (*xpb.DocumentationReply, error) {
+	return &xpb.DocumentationReply{}, nil
+}
+
+func TestGRPC(t *testing.T) {
+	svc := &fakeService{xrefs: &xpb.CrossReferencesReply{
+		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+			"kythe:#sig": {Ticket: "kythe:#sig"},
+		},
+		NextPageToken: "next",
+	}}
+
+	l := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	RegisterGRPCServer(srv, svc)
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	cc, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := GRPCClient(cc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	reply, err := client.CrossReferences(ctx, &xpb.CrossReferencesRequest{Ticket: []string{"kythe:#sig"}})
+	if err != nil {
+		t.Fatalf("CrossReferences error: %v", err)
+	}
+	if !proto.Equal(reply, svc.xrefs) {
+		t.Errorf("CrossReferences reply: found %v; expected %v", reply, svc.xrefs)
+	}
+	if !svc.hadDeadline {
+		t.Error("CrossReferences deadline was not propagated to the server")
+	}
+
+	if _, err := client.Decorations(ctx, &xpb.DecorationsRequest{}); status.Code(err) != codes.NotFound {
+		t.Errorf("Decorations error: found %v; expected code %v", err, codes.NotFound)
+	}
+}
+
+func TestGRPCServiceDesc(t *testing.T) {
+	sd := xpb.File_kythe_proto_xref_proto.Services().ByName("XRefService")
+	if err := testutil.ServiceDescEqual(sd, &grpcServiceDesc); err != nil {
+		t.Error(err)
+	}
+}
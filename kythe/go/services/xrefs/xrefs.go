@@ -20,6 +20,7 @@ package xrefs // import "kythe.io/kythe/go/services/xrefs"
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"regexp"
@@ -33,6 +34,8 @@ import (
 	"bitbucket.org/creachadair/stringset"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	cpb "kythe.io/kythe/proto/common_go_proto"
 	xpb "kythe.io/kythe/proto/xref_go_proto"
@@ -54,6 +57,52 @@ type Service interface {
 	Documentation(context.Context, *xpb.DocumentationRequest) (*xpb.DocumentationReply, error)
 }
 
+// StreamingService is an optional interface for xrefs Services that can stream
+// cross-references as they are read rather than returning them page by page.
+type StreamingService interface {
+	Service
+
+	// StreamCrossReferences calls f with a sequence of replies that together
+	// hold the global cross-references for the given nodes.  The paging fields
+	// of the request are ignored.  Streaming stops with the first error
+	// returned by f or once ctx is done.
+	StreamCrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error
+}
+
+// StreamCrossReferences calls f with a sequence of replies that together hold
+// the cross-references requested from xs.  If xs is not a StreamingService, its
+// pages of cross-references are passed to f in turn (see StreamPages).
+func StreamCrossReferences(ctx context.Context, xs Service, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	if ss, ok := xs.(StreamingService); ok {
+		return ss.StreamCrossReferences(ctx, req, f)
+	}
+	return StreamPages(ctx, xs, req, f)
+}
+
+// StreamPages calls f with each page of the cross-references requested from
+// xs, starting from the first page.  It is a fallback implementation of the
+// StreamingService method for any xrefs Service.
+func StreamPages(ctx context.Context, xs Service, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	req = proto.Clone(req).(*xpb.CrossReferencesRequest)
+	req.PageToken = ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		reply, err := xs.CrossReferences(ctx, req)
+		if err != nil {
+			return err
+		}
+		next := reply.NextPageToken
+		if err := f(reply); err != nil {
+			return err
+		} else if next == "" {
+			return nil
+		}
+		req.PageToken = next
+	}
+}
+
 var (
 	// ErrPermissionDenied is returned by an implementation of a method when the
 	// user is not allowed to view the content because of some restrictions.
@@ -250,6 +299,14 @@ func (b BoundedRequests) CrossReferences(ctx context.Context, req *xpb.CrossRefe
 	return b.Service.CrossReferences(ctx, req)
 }
 
+// StreamCrossReferences implements part of the StreamingService interface.
+func (b BoundedRequests) StreamCrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	if len(req.Ticket) > b.MaxTickets {
+		return status.Errorf(codes.InvalidArgument, "too many tickets requested: %d (max %d)", len(req.Ticket), b.MaxTickets)
+	}
+	return StreamCrossReferences(ctx, b.Service, req, f)
+}
+
 // Documentation implements part of the Service interface.
 func (b BoundedRequests) Documentation(ctx context.Context, req *xpb.DocumentationRequest) (*xpb.DocumentationReply, error) {
 	if len(req.Ticket) > b.MaxTickets {
@@ -272,13 +329,25 @@ func (w *webClient) CrossReferences(ctx context.Context, q *xpb.CrossReferencesR
 	return &reply, web.Call(w.addr, "xrefs", q, &reply)
 }
 
+// StreamCrossReferences implements part of the StreamingService interface.
+func (w *webClient) StreamCrossReferences(ctx context.Context, q *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	return web.CallStream(ctx, w.addr, "xrefs/stream", q, func(line []byte) error {
+		var reply xpb.CrossReferencesReply
+		if err := protojson.Unmarshal(line, &reply); err != nil {
+			return fmt.Errorf("error unmarshaling %T: %v", &reply, err)
+		}
+		return f(&reply)
+	})
+}
+
 // Documentation implements part of the Service interface.
 func (w *webClient) Documentation(ctx context.Context, q *xpb.DocumentationRequest) (*xpb.DocumentationReply, error) {
 	var reply xpb.DocumentationReply
 	return &reply, web.Call(w.addr, "documentation", q, &reply)
 }
 
-// WebClient returns an xrefs Service based on a remote web server.
+// WebClient returns an xrefs Service based on a remote web server.  The
+// returned Service is also a StreamingService.
 func WebClient(addr string) Service {
 	return &webClient{addr}
 }
@@ -292,6 +361,9 @@ func WebClient(addr string) Service {
 //   GET /xrefs
 //     Request: JSON encoded xrefs.CrossReferencesRequest
 //     Response: JSON encoded xrefs.CrossReferencesReply
+//   GET /xrefs/stream
+//     Request: JSON encoded xrefs.CrossReferencesRequest
+//     Response: newline-delimited JSON encoded xrefs.CrossReferencesReply stream
 //   GET /documentation
 //     Request: JSON encoded xrefs.DocumentationRequest
 //     Response: JSON encoded xrefs.DocumentationReply
@@ -319,6 +391,33 @@ func RegisterHTTPHandlers(ctx context.Context, xs Service, mux *http.ServeMux) {
 			log.Println(err)
 		}
 	})
+	mux.HandleFunc("/xrefs/stream", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		defer func() {
+			log.Printf("xrefs.StreamCrossReferences:\t%s", time.Since(start))
+		}()
+		var req xpb.CrossReferencesRequest
+		if err := web.ReadJSONBody(r, &req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Stop streaming if either the server or the client is done.
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-r.Context().Done():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		sw := web.NewStreamWriter(w)
+		sw.Finish(StreamCrossReferences(ctx, xs, &req, func(reply *xpb.CrossReferencesReply) error {
+			return sw.Write(reply)
+		}))
+	})
 	mux.HandleFunc("/decorations", func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		defer func() {
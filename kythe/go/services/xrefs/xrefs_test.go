@@ -17,10 +17,20 @@
 package xrefs
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"kythe.io/kythe/go/util/schema/facts"
+
+	"google.golang.org/protobuf/proto"
+
+	xpb "kythe.io/kythe/proto/xref_go_proto"
 )
 
 func TestFilterRegexp(t *testing.T) {
@@ -54,3 +64,118 @@ func TestFilterRegexp(t *testing.T) {
 		}
 	}
 }
+
+// pagedService is an xrefs Service serving each of its replies as a page of
+// cross-references.
+type pagedService struct {
+	Service
+	pages []*xpb.CrossReferencesReply
+}
+
+func (p pagedService) CrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest) (*xpb.CrossReferencesReply, error) {
+	var i int
+	if req.PageToken != "" {
+		var err error
+		if i, err = strconv.Atoi(req.PageToken); err != nil || i >= len(p.pages) {
+			return nil, errors.New("invalid page token")
+		}
+	}
+	reply := proto.Clone(p.pages[i]).(*xpb.CrossReferencesReply)
+	if i+1 < len(p.pages) {
+		reply.NextPageToken = strconv.Itoa(i + 1)
+	}
+	return reply, nil
+}
+
+// failingStream is a StreamingService that fails after streaming its replies.
+type failingStream struct{ pagedService }
+
+func (f failingStream) StreamCrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest, stream func(*xpb.CrossReferencesReply) error) error {
+	for _, reply := range f.pages {
+		if err := stream(reply); err != nil {
+			return err
+		}
+	}
+	return errors.New("stream failure")
+}
+
+func testPages(refs ...string) []*xpb.CrossReferencesReply {
+	var pages []*xpb.CrossReferencesReply
+	for _, ref := range refs {
+		pages = append(pages, &xpb.CrossReferencesReply{
+			CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+				"kythe:#node": {
+					Ticket: "kythe:#node",
+					Reference: []*xpb.CrossReferencesReply_RelatedAnchor{{
+						Anchor: &xpb.Anchor{Ticket: ref},
+					}},
+				},
+			},
+		})
+	}
+	return pages
+}
+
+func streamedRefs(ctx context.Context, xs Service, req *xpb.CrossReferencesRequest) ([]string, error) {
+	var refs []string
+	err := StreamCrossReferences(ctx, xs, req, func(reply *xpb.CrossReferencesReply) error {
+		for _, ra := range reply.CrossReferences["kythe:#node"].GetReference() {
+			refs = append(refs, ra.Anchor.Ticket)
+		}
+		return nil
+	})
+	return refs, err
+}
+
+func TestStreamPages(t *testing.T) {
+	ctx := context.Background()
+	xs := pagedService{pages: testPages("kythe:#a", "kythe:#b", "kythe:#c")}
+	req := &xpb.CrossReferencesRequest{Ticket: []string{"kythe:#node"}, PageToken: "1"}
+
+	refs, err := streamedRefs(ctx, xs, req)
+	if err != nil {
+		t.Fatalf("StreamCrossReferences error: %v", err)
+	} else if found := strings.Join(refs, " "); found != "kythe:#a kythe:#b kythe:#c" {
+		t.Errorf("Streamed references: found %q; expected all pages", found)
+	}
+	if req.PageToken != "1" {
+		t.Errorf("Request was modified: %v", req)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := streamedRefs(ctx, xs, req); err != context.Canceled {
+		t.Errorf("Expected %v; found %v", context.Canceled, err)
+	}
+}
+
+func TestStreamHTTP(t *testing.T) {
+	ctx := context.Background()
+	pages := testPages("kythe:#a", "kythe:#b")
+	tests := []struct {
+		xs       Service
+		expected string
+		err      string
+	}{
+		{xs: pagedService{pages: pages}, expected: "kythe:#a kythe:#b"},
+		{xs: failingStream{pagedService{pages: pages}}, expected: "kythe:#a kythe:#b", err: "stream failure"},
+		{xs: failingStream{}, err: "stream failure"},
+		{xs: BoundedRequests{MaxTickets: 0, Service: pagedService{pages: pages}}, err: "too many tickets"},
+	}
+	for _, test := range tests {
+		mux := http.NewServeMux()
+		RegisterHTTPHandlers(ctx, test.xs, mux)
+		srv := httptest.NewServer(mux)
+
+		refs, err := streamedRefs(ctx, WebClient(srv.URL), &xpb.CrossReferencesRequest{Ticket: []string{"kythe:#node"}})
+		if found := strings.Join(refs, " "); found != test.expected {
+			t.Errorf("Streamed references from %T: found %q; expected %q", test.xs, found, test.expected)
+		}
+		if test.err == "" && err != nil {
+			t.Errorf("Unexpected error streaming from %T: %v", test.xs, err)
+		} else if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+			t.Errorf("Streaming from %T: found error %v; expected %q", test.xs, err, test.err)
+		}
+		srv.Close()
+	}
+}
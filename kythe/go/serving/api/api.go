@@ -14,8 +14,8 @@
  * limitations under the License.
  */
 
-// Package api provides a union of the filetree, xrefs, and graph interfaces
-// and a command-line flag parser.
+// Package api provides a union of the filetree, xrefs, graph, identifiers,
+// link, and explore interfaces and a command-line flag parser.
 package api // import "kythe.io/kythe/go/serving/api"
 
 import (
@@ -25,9 +25,12 @@ import (
 	"os"
 	"strings"
 
+	"kythe.io/kythe/go/services/explore"
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graph"
+	"kythe.io/kythe/go/services/link"
 	"kythe.io/kythe/go/services/xrefs"
+	esrv "kythe.io/kythe/go/serving/explore"
 	ftsrv "kythe.io/kythe/go/serving/filetree"
 	gsrv "kythe.io/kythe/go/serving/graph"
 	"kythe.io/kythe/go/serving/identifiers"
@@ -35,18 +38,25 @@ import (
 	"kythe.io/kythe/go/storage/leveldb"
 	"kythe.io/kythe/go/storage/table"
 
+	"google.golang.org/grpc"
+
+	epb "kythe.io/kythe/proto/explore_go_proto"
 	ftpb "kythe.io/kythe/proto/filetree_go_proto"
 	gpb "kythe.io/kythe/proto/graph_go_proto"
 	ipb "kythe.io/kythe/proto/identifier_go_proto"
+	linkpb "kythe.io/kythe/proto/link_go_proto"
 	xpb "kythe.io/kythe/proto/xref_go_proto"
 )
 
-// Interface is a union of the xrefs and filetree interfaces.
+// Interface is a union of the xrefs, graph, filetree, identifiers, link, and
+// explore interfaces.
 type Interface interface {
 	xrefs.Service
 	graph.Service
 	filetree.Service
 	identifiers.Service
+	link.Service
+	explore.Service
 
 	// Close releases the underlying resources for the API.
 	Close(context.Context) error
@@ -57,7 +67,7 @@ const (
 	CommonDefault = "https://xrefs-dot-kythe-repo.appspot.com"
 
 	// CommonFlagUsage is the common Kythe usage description used for Flag
-	CommonFlagUsage = "Backing API specification (e.g. JSON HTTP server: https://xrefs-dot-kythe-repo.appspot.com or local serving table path: /var/kythe_serving)"
+	CommonFlagUsage = "Backing API specification (e.g. JSON HTTP server: https://xrefs-dot-kythe-repo.appspot.com, gRPC server: grpc://localhost:9090, or local serving table path: /var/kythe_serving)"
 )
 
 // Flag defines an api Interface flag with specified name, default value, and
@@ -74,6 +84,7 @@ func Flag(name, value, usage string) *Interface {
 // API Interface.  The following formats are currently supported:
 //   - http:// URL pointed at a JSON web API
 //   - https:// URL pointed at a JSON web API
+//   - grpc://host:port address of an insecure gRPC server
 //   - local path to a LevelDB serving table
 func ParseSpec(apiSpec string) (Interface, error) {
 	api := &apiCloser{}
@@ -82,6 +93,21 @@ func ParseSpec(apiSpec string) (Interface, error) {
 		api.gs = graph.WebClient(apiSpec)
 		api.ft = filetree.WebClient(apiSpec)
 		api.id = identifiers.WebClient(apiSpec)
+		api.ls = link.WebClient(apiSpec)
+		api.es = explore.WebClient(apiSpec)
+	} else if strings.HasPrefix(apiSpec, "grpc://") {
+		cc, err := grpc.Dial(strings.TrimPrefix(apiSpec, "grpc://"), grpc.WithInsecure())
+		if err != nil {
+			return nil, fmt.Errorf("error dialing gRPC server at %q: %v", apiSpec, err)
+		}
+		api.closer = func(context.Context) error { return cc.Close() }
+
+		api.xs = xrefs.GRPCClient(cc)
+		api.gs = graph.GRPCClient(cc)
+		api.ft = filetree.GRPCClient(cc)
+		api.id = identifiers.GRPCClient(cc)
+		api.ls = &link.Resolver{Client: api}
+		api.es = explore.GRPCClient(cc)
 	} else if _, err := os.Stat(apiSpec); err == nil {
 		db, err := leveldb.Open(apiSpec, nil)
 		if err != nil {
@@ -95,6 +121,8 @@ func ParseSpec(apiSpec string) (Interface, error) {
 		tbl := &table.KVProto{db}
 		api.ft = &ftsrv.Table{tbl, true}
 		api.id = &identifiers.Table{tbl}
+		api.ls = &link.Resolver{Client: api}
+		api.es = esrv.NewCombinedTables(tbl)
 	} else {
 		return nil, fmt.Errorf("unknown API spec format: %q", apiSpec)
 	}
@@ -126,6 +154,8 @@ type apiCloser struct {
 	gs graph.Service
 	ft filetree.Service
 	id identifiers.Service
+	ls link.Service
+	es explore.Service
 
 	closer func(context.Context) error
 }
@@ -158,6 +188,12 @@ func (api apiCloser) CrossReferences(ctx context.Context, req *xpb.CrossReferenc
 	return api.xs.CrossReferences(ctx, req)
 }
 
+// StreamCrossReferences implements part of the xrefs StreamingService
+// interface.
+func (api apiCloser) StreamCrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	return xrefs.StreamCrossReferences(ctx, api.xs, req, f)
+}
+
 // Documentation implements part of the xrefs Service interface.
 func (api apiCloser) Documentation(ctx context.Context, req *xpb.DocumentationRequest) (*xpb.DocumentationReply, error) {
 	return api.xs.Documentation(ctx, req)
@@ -177,3 +213,38 @@ func (api apiCloser) CorpusRoots(ctx context.Context, req *ftpb.CorpusRootsReque
 func (api apiCloser) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
 	return api.id.Find(ctx, req)
 }
+
+// Resolve implements part of the link Service interface.
+func (api apiCloser) Resolve(ctx context.Context, req *linkpb.LinkRequest) (*linkpb.LinkReply, error) {
+	return api.ls.Resolve(ctx, req)
+}
+
+// TypeHierarchy implements part of the explore Service interface.
+func (api apiCloser) TypeHierarchy(ctx context.Context, req *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
+	return api.es.TypeHierarchy(ctx, req)
+}
+
+// Callers implements part of the explore Service interface.
+func (api apiCloser) Callers(ctx context.Context, req *epb.CallersRequest) (*epb.CallersReply, error) {
+	return api.es.Callers(ctx, req)
+}
+
+// Callees implements part of the explore Service interface.
+func (api apiCloser) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.CalleesReply, error) {
+	return api.es.Callees(ctx, req)
+}
+
+// Parameters implements part of the explore Service interface.
+func (api apiCloser) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
+	return api.es.Parameters(ctx, req)
+}
+
+// Parents implements part of the explore Service interface.
+func (api apiCloser) Parents(ctx context.Context, req *epb.ParentsRequest) (*epb.ParentsReply, error) {
+	return api.es.Parents(ctx, req)
+}
+
+// Children implements part of the explore Service interface.
+func (api apiCloser) Children(ctx context.Context, req *epb.ChildrenRequest) (*epb.ChildrenReply, error) {
+	return api.es.Children(ctx, req)
+}
@@ -18,22 +18,30 @@
 // ExploreService defined in kythe/proto/explore.proto.
 //
 // Table format:
-//   <parent ticket>   -> srvpb.Relatives (children)
-//   <child ticket>    -> srvpb.Relatives (parents)
-//   <called ticket>   -> srvpb.Callgraph (callers)
-//   <calling ticket>  -> srvpb.Callgraph (callees)
+//   <parent ticket>    -> srvpb.Relatives (children)
+//   <child ticket>     -> srvpb.Relatives (parents)
+//   <called ticket>    -> srvpb.Callgraph (callers)
+//   <calling ticket>   -> srvpb.Callgraph (callees)
+//   <subtype ticket>   -> srvpb.TypeRelatives (supertypes)
+//   <supertype ticket> -> srvpb.TypeRelatives (subtypes)
+//   <function ticket>  -> srvpb.FunctionParameters
 package explore // import "kythe.io/kythe/go/serving/explore"
 
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/kytheuri"
 
 	"bitbucket.org/creachadair/stringset"
+	"google.golang.org/protobuf/proto"
 
 	epb "kythe.io/kythe/proto/explore_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
 )
 
 // Tables implements the explore.Service interface using separate static lookup tables
@@ -52,13 +60,207 @@ type Tables struct {
 	// FunctionToCallees is a table of srvpb.Callgraph keyed by function ticket
 	// that points to the callees of the specified function.
 	FunctionToCallees table.ProtoLookup
+
+	// TypeToSupertypes is a table of srvpb.TypeRelatives keyed by type ticket
+	// that points to the direct supertypes of the specified type.
+	TypeToSupertypes table.ProtoLookup
+
+	// TypeToSubtypes is a table of srvpb.TypeRelatives keyed by type ticket
+	// that points to the direct subtypes (including implementations) of the
+	// specified type.
+	TypeToSubtypes table.ProtoLookup
+
+	// FunctionToParameters is a table of srvpb.FunctionParameters keyed by
+	// function ticket.
+	FunctionToParameters table.ProtoLookup
+}
+
+// Key prefixes for the tables constructed by NewCombinedTables.
+const (
+	childrenTablePrefix   = "exploreChildren:"
+	parentsTablePrefix    = "exploreParents:"
+	callersTablePrefix    = "exploreCallers:"
+	calleesTablePrefix    = "exploreCallees:"
+	supertypesTablePrefix = "exploreSupertypes:"
+	subtypesTablePrefix   = "exploreSubtypes:"
+	parametersTablePrefix = "exploreParams:"
+)
+
+// NewCombinedTables returns a Tables for the given combined explore lookup
+// table.  The table's keys are expected to be constructed using only the *Key
+// functions.
+func NewCombinedTables(t table.ProtoLookup) *Tables {
+	return &Tables{
+		ParentToChildren:     &prefixedTable{childrenTablePrefix, t},
+		ChildToParents:       &prefixedTable{parentsTablePrefix, t},
+		FunctionToCallers:    &prefixedTable{callersTablePrefix, t},
+		FunctionToCallees:    &prefixedTable{calleesTablePrefix, t},
+		TypeToSupertypes:     &prefixedTable{supertypesTablePrefix, t},
+		TypeToSubtypes:       &prefixedTable{subtypesTablePrefix, t},
+		FunctionToParameters: &prefixedTable{parametersTablePrefix, t},
+	}
+}
+
+// ChildrenKey returns the CombinedTable key for the srvpb.Relatives holding
+// the children of the given parent ticket.
+func ChildrenKey(ticket string) []byte { return []byte(childrenTablePrefix + ticket) }
+
+// ParentsKey returns the CombinedTable key for the srvpb.Relatives holding the
+// parents of the given child ticket.
+func ParentsKey(ticket string) []byte { return []byte(parentsTablePrefix + ticket) }
+
+// CallersKey returns the CombinedTable key for the srvpb.Callgraph holding the
+// callers of the given function ticket.
+func CallersKey(ticket string) []byte { return []byte(callersTablePrefix + ticket) }
+
+// CalleesKey returns the CombinedTable key for the srvpb.Callgraph holding the
+// callees of the given function ticket.
+func CalleesKey(ticket string) []byte { return []byte(calleesTablePrefix + ticket) }
+
+// SupertypesKey returns the CombinedTable key for the srvpb.TypeRelatives
+// holding the supertypes of the given type ticket.
+func SupertypesKey(ticket string) []byte { return []byte(supertypesTablePrefix + ticket) }
+
+// SubtypesKey returns the CombinedTable key for the srvpb.TypeRelatives holding
+// the subtypes of the given type ticket.
+func SubtypesKey(ticket string) []byte { return []byte(subtypesTablePrefix + ticket) }
+
+// ParametersKey returns the CombinedTable key for the srvpb.FunctionParameters
+// of the given function ticket.
+func ParametersKey(ticket string) []byte { return []byte(parametersTablePrefix + ticket) }
+
+// prefixedTable is a table.ProtoLookup that prepends a fixed prefix to each key.
+type prefixedTable struct {
+	prefix string
+	table.ProtoLookup
+}
+
+// Lookup implements part of the table.ProtoLookup interface.
+func (p *prefixedTable) Lookup(ctx context.Context, key []byte, msg proto.Message) error {
+	return p.ProtoLookup.Lookup(ctx, append([]byte(p.prefix), key...), msg)
 }
 
 // TypeHierarchy returns the hierarchy (supertypes and subtypes, including implementations)
-// of a specified type, as a directed acyclic graph.
-// TODO: not yet implemented
+// of a specified type, as a directed acyclic graph.  Edges point from subtypes
+// to their supertypes.  Types excluded by the request's NodeFilter are neither
+// returned nor traversed.
 func (t *Tables) TypeHierarchy(ctx context.Context, req *epb.TypeHierarchyRequest) (*epb.TypeHierarchyReply, error) {
-	return nil, nil
+	ticket := req.TypeTicket
+	if ticket == "" {
+		return nil, fmt.Errorf("missing input ticket: %v", req)
+	}
+	keep := func(ticket string) bool { return matchesNodeFilter(req.NodeFilter, ticket) }
+
+	// succMap maps nodes onto sets of successor (super)type nodes
+	succMap := map[string]stringset.Set{ticket: stringset.New()}
+
+	dir := req.Direction
+	if dir == epb.TypeHierarchyRequest_BOTH || dir == epb.TypeHierarchyRequest_SUPERTYPES {
+		err := t.walkTypeHierarchy(ctx, ticket, req.MaxDepth, t.TypeToSupertypes, srvpb.TypeRelatives_SUPERTYPES, keep,
+			func(sub, super string) { addSuccessor(succMap, sub, super) })
+		if err != nil {
+			return nil, err
+		}
+	}
+	if dir == epb.TypeHierarchyRequest_BOTH || dir == epb.TypeHierarchyRequest_SUBTYPES {
+		err := t.walkTypeHierarchy(ctx, ticket, req.MaxDepth, t.TypeToSubtypes, srvpb.TypeRelatives_SUBTYPES, keep,
+			func(super, sub string) { addSuccessor(succMap, sub, super) })
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &epb.TypeHierarchyReply{
+		TypeTicket: ticket,
+		Graph:      convertSuccMapToGraph(succMap),
+	}, nil
+}
+
+// walkTypeHierarchy performs a breadth-first traversal of tbl starting at
+// ticket, calling addEdge(from, to) for each relation found to a node for which
+// keep returns true.  The traversal stops after maxDepth levels (if
+// maxDepth > 0) and visits each node at most once, so that cycles in the
+// underlying data cannot cause it to loop.
+//
+// At the moment, this is our policy for missing data: if a ticket has no
+// record in the table, it is treated as having no relatives.  Other table
+// access errors result in returning an error.
+func (t *Tables) walkTypeHierarchy(ctx context.Context, ticket string, maxDepth int32, tbl table.ProtoLookup, want srvpb.TypeRelatives_Type, keep func(string) bool, addEdge func(from, to string)) error {
+	if tbl == nil {
+		return nil
+	}
+	visited := stringset.New(ticket)
+	frontier := []string{ticket}
+	for depth := int32(0); len(frontier) > 0 && (maxDepth <= 0 || depth < maxDepth); depth++ {
+		var next []string
+		for _, from := range frontier {
+			var relatives srvpb.TypeRelatives
+			if err := tbl.Lookup(ctx, []byte(from), &relatives); err == table.ErrNoSuchKey {
+				continue // skip tickets with no mappings
+			} else if err != nil {
+				return fmt.Errorf("error looking up %s with ticket %q: %v", strings.ToLower(want.String()), from, err)
+			}
+
+			// This can only happen in the context of a postprocessor bug.
+			if relatives.Type != want {
+				return fmt.Errorf("type of type relatives is not '%s': %v", want, &relatives)
+			}
+
+			for _, to := range relatives.Tickets {
+				if to == from || !keep(to) {
+					continue // a type is never its own supertype or subtype
+				}
+				addEdge(from, to)
+				if visited.Add(to) {
+					next = append(next, to)
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
+}
+
+// matchesNodeFilter reports whether the node with the given ticket satisfies
+// f.  A nil filter is satisfied by every node.
+func matchesNodeFilter(f *epb.NodeFilter, ticket string) bool {
+	if len(f.GetIncludedLanguages()) == 0 && len(f.GetIncludedFiles()) == 0 {
+		return true
+	}
+	uri, err := kytheuri.Parse(ticket)
+	if err != nil {
+		return false
+	}
+	if langs := f.GetIncludedLanguages(); len(langs) > 0 && !stringset.New(langs...).Contains(uri.Language) {
+		return false
+	}
+	if files := f.GetIncludedFiles(); len(files) > 0 {
+		for _, file := range files {
+			if matchesFile(file, uri) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// matchesFile reports whether uri resides in the given file, ignoring the
+// unset parts of file.
+func matchesFile(file *spb.VName, uri *kytheuri.URI) bool {
+	return (file.Corpus == "" || file.Corpus == uri.Corpus) &&
+		(file.Root == "" || file.Root == uri.Root) &&
+		(file.Path == "" || file.Path == uri.Path)
+}
+
+// addSuccessor records succ as a successor of ticket in succMap.
+func addSuccessor(succMap map[string]stringset.Set, ticket, succ string) {
+	set, ok := succMap[ticket]
+	if !ok {
+		set = stringset.New()
+		succMap[ticket] = set
+	}
+	set.Add(succ)
 }
 
 // Callers returns the callers of a specified function, as a directed graph.
@@ -167,9 +369,65 @@ func (t *Tables) Callees(ctx context.Context, req *epb.CalleesRequest) (*epb.Cal
 }
 
 // Parameters returns the parameters of a specified function.
-// TODO: not yet implemented
 func (t *Tables) Parameters(ctx context.Context, req *epb.ParametersRequest) (*epb.ParametersReply, error) {
-	return nil, nil
+	tickets := req.FunctionTickets
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("missing input tickets: %v", req)
+	}
+
+	reply := &epb.ParametersReply{
+		FunctionToParameters:  make(map[string]*epb.Tickets),
+		FunctionToReturnValue: make(map[string]string),
+		NodeData:              make(map[string]*epb.NodeData),
+	}
+
+	// At the moment, this is our policy for missing data: if an input ticket has
+	// no record in the table, we don't include data for that ticket in the response.
+	// Other table access errors result in returning an error.
+	for _, ticket := range tickets {
+		var params srvpb.FunctionParameters
+		if err := t.FunctionToParameters.Lookup(ctx, []byte(ticket), &params); err == table.ErrNoSuchKey {
+			continue // skip tickets with no mappings
+		} else if err != nil {
+			return nil, fmt.Errorf("error looking up parameters with ticket %q: %v", ticket, err)
+		}
+
+		// Order the parameters by their param.N ordinals; the postprocessor
+		// should already have done so, but this is cheap to guarantee.
+		sort.SliceStable(params.Parameter, func(i, j int) bool {
+			return params.Parameter[i].GetOrdinal() < params.Parameter[j].GetOrdinal()
+		})
+
+		paramTickets := &epb.Tickets{}
+		for _, p := range params.Parameter {
+			n := p.GetNode()
+			if n.GetTicket() == "" {
+				return nil, fmt.Errorf("missing ticket for parameter %d of %q", p.GetOrdinal(), ticket)
+			}
+			paramTickets.Tickets = append(paramTickets.Tickets, n.Ticket)
+			addNodeData(reply.NodeData, n)
+		}
+		reply.FunctionToParameters[ticket] = paramTickets
+
+		if rv := params.ReturnValue; rv.GetTicket() != "" {
+			reply.FunctionToReturnValue[ticket] = rv.Ticket
+			addNodeData(reply.NodeData, rv)
+		}
+	}
+
+	return reply, nil
+}
+
+// addNodeData records the epb.NodeData for n in nodeData.
+func addNodeData(nodeData map[string]*epb.NodeData, n *srvpb.FunctionParameters_Node) {
+	if _, ok := nodeData[n.Ticket]; ok {
+		return
+	}
+	nodeData[n.Ticket] = &epb.NodeData{
+		Kind:    n.Kind,
+		Subkind: n.Subkind,
+		Code:    n.MarkedSource,
+	}
 }
 
 // Parents returns the parents of a specified node
@@ -23,12 +23,15 @@ import (
 
 	"kythe.io/kythe/go/storage/table"
 	"kythe.io/kythe/go/test/testutil"
+	"kythe.io/kythe/go/util/compare"
 
 	"bitbucket.org/creachadair/stringset"
 	"google.golang.org/protobuf/proto"
 
+	cpb "kythe.io/kythe/proto/common_go_proto"
 	epb "kythe.io/kythe/proto/explore_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
 )
 
 const (
@@ -45,6 +48,23 @@ const (
 	f2r1     = "kythe:#f2caller1"
 	f3       = "kythe:#function3_recursive"
 	dne      = "kythe:#does_not_exist"
+	iface    = "kythe:#interface"
+	base     = "kythe:#base"
+	impl1    = "kythe:#impl1"
+	impl2    = "kythe:#impl2"
+	sub      = "kythe:#impl1sub"
+	cyc1     = "kythe:#cycle1"
+	cyc2     = "kythe:#cycle2"
+	fp       = "kythe:#fparams"
+	fp0      = "kythe:#fparam0"
+	fp1      = "kythe:#fparam1"
+	fpRet    = "kythe:#freturn"
+	fnoParam = "kythe:#fnoparams"
+
+	goIface  = "kythe://corpus?lang=go#Iface"
+	goImpl   = "kythe://corpus?lang=go?path=impl.go#Impl"
+	goSub    = "kythe://corpus?lang=go?path=sub.go#Sub"
+	javaImpl = "kythe://corpus?lang=java?path=Impl.java#Impl"
 )
 
 var (
@@ -128,8 +148,343 @@ var (
 			Type:    srvpb.Callgraph_CALLER,
 		},
 	}
+
+	typeToSupertypes = &protoTable{
+		impl1: &srvpb.TypeRelatives{
+			Tickets: []string{iface, base},
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		},
+		impl2: &srvpb.TypeRelatives{
+			Tickets: []string{iface},
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		},
+		sub: &srvpb.TypeRelatives{
+			Tickets: []string{impl1},
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		},
+		cyc1: &srvpb.TypeRelatives{
+			Tickets: []string{cyc2},
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		},
+		cyc2: &srvpb.TypeRelatives{
+			Tickets: []string{cyc1},
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		},
+		badType: &srvpb.TypeRelatives{
+			Tickets: []string{dontcare},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+	}
+
+	typeToSubtypes = &protoTable{
+		goIface: &srvpb.TypeRelatives{
+			Tickets: []string{goImpl, javaImpl},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		goImpl: &srvpb.TypeRelatives{
+			Tickets: []string{goSub},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		javaImpl: &srvpb.TypeRelatives{
+			Tickets: []string{goSub},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		iface: &srvpb.TypeRelatives{
+			Tickets: []string{impl1, impl2},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		base: &srvpb.TypeRelatives{
+			Tickets: []string{impl1},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		impl1: &srvpb.TypeRelatives{
+			Tickets: []string{sub},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		cyc1: &srvpb.TypeRelatives{
+			Tickets: []string{cyc2},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		cyc2: &srvpb.TypeRelatives{
+			Tickets: []string{cyc1},
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		},
+		badType: &srvpb.TypeRelatives{
+			Tickets: []string{dontcare},
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		},
+	}
+
+	fp1Code = &cpb.MarkedSource{
+		Kind:     cpb.MarkedSource_IDENTIFIER,
+		PreText:  "y",
+		PostText: " int",
+	}
+
+	functionToParameters = &protoTable{
+		// Parameters are stored out of order to check that the reply is sorted.
+		fp: &srvpb.FunctionParameters{
+			Parameter: []*srvpb.FunctionParameters_Parameter{{
+				Ordinal: 1,
+				Node: &srvpb.FunctionParameters_Node{
+					Ticket:       fp1,
+					Kind:         "variable",
+					Subkind:      "local/parameter",
+					MarkedSource: fp1Code,
+				},
+			}, {
+				Ordinal: 0,
+				Node: &srvpb.FunctionParameters_Node{
+					Ticket: fp0,
+					Kind:   "variable",
+				},
+			}},
+			ReturnValue: &srvpb.FunctionParameters_Node{
+				Ticket: fpRet,
+				Kind:   "tbuiltin",
+			},
+		},
+		fnoParam: &srvpb.FunctionParameters{},
+		badType: &srvpb.FunctionParameters{
+			Parameter: []*srvpb.FunctionParameters_Parameter{{}},
+		},
+	}
 )
 
+func TestParameters_badData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{
+		FunctionTickets: []string{badType},
+	})
+	if err == nil {
+		t.Errorf("Expected Parameters error for bad data, got: %v", reply)
+	}
+}
+
+func TestParameters_noData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{
+		FunctionTickets: []string{dne},
+	})
+	testutil.FatalOnErrT(t, "Parameters error: %v", err)
+	if len(reply.FunctionToParameters) != 0 || len(reply.NodeData) != 0 {
+		t.Errorf("Expected empty response for missing key, got: %v", reply)
+	}
+}
+
+func TestParameters(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.Parameters(ctx, &epb.ParametersRequest{
+		FunctionTickets: []string{fp, fnoParam, dne},
+	})
+	testutil.FatalOnErrT(t, "Parameters error: %v", err)
+
+	expected := &epb.ParametersReply{
+		FunctionToParameters: map[string]*epb.Tickets{
+			fp:       {Tickets: []string{fp0, fp1}},
+			fnoParam: {},
+		},
+		FunctionToReturnValue: map[string]string{
+			fp: fpRet,
+		},
+		NodeData: map[string]*epb.NodeData{
+			fp0: {Kind: "variable"},
+			fp1: {
+				Kind:    "variable",
+				Subkind: "local/parameter",
+				Code:    fp1Code,
+			},
+			fpRet: {Kind: "tbuiltin"},
+		},
+	}
+	if diff := compare.ProtoDiff(expected, reply); diff != "" {
+		t.Errorf("Unexpected Parameters reply: (- expected; + found)\n%s", diff)
+	}
+}
+
+func TestTypeHierarchy_badData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: badType,
+	})
+	if err == nil {
+		t.Errorf("Expected TypeHierarchy error for bad data, got: %v", reply)
+	}
+}
+
+func TestTypeHierarchy_noData(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: dne,
+	})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{dne: {}},
+	}
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+}
+
+func TestTypeHierarchy(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: impl1,
+	})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+	if reply.TypeTicket != impl1 {
+		t.Errorf("Expected reply type ticket %q, got %q", impl1, reply.TypeTicket)
+	}
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			impl1: {
+				Predecessors: []string{sub},
+				Successors:   []string{iface, base},
+			},
+			iface: {
+				Predecessors: []string{impl1},
+			},
+			base: {
+				Predecessors: []string{impl1},
+			},
+			sub: {
+				Successors: []string{impl1},
+			},
+		},
+	}
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+}
+
+func TestTypeHierarchy_subtypes(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: iface,
+		Direction:  epb.TypeHierarchyRequest_SUBTYPES,
+	})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			iface: {
+				Predecessors: []string{impl1, impl2},
+			},
+			impl1: {
+				Predecessors: []string{sub},
+				Successors:   []string{iface},
+			},
+			impl2: {
+				Successors: []string{iface},
+			},
+			sub: {
+				Successors: []string{impl1},
+			},
+		},
+	}
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+}
+
+func TestTypeHierarchy_maxDepth(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: sub,
+		Direction:  epb.TypeHierarchyRequest_SUPERTYPES,
+		MaxDepth:   1,
+	})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			sub: {
+				Successors: []string{impl1},
+			},
+			impl1: {
+				Predecessors: []string{sub},
+			},
+		},
+	}
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+}
+
+func TestTypeHierarchy_cycle(t *testing.T) {
+	svc := construct(t)
+
+	reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+		TypeTicket: cyc1,
+	})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+
+	expectedGraph := &epb.Graph{
+		Nodes: map[string]*epb.GraphNode{
+			cyc1: {
+				Predecessors: []string{cyc2},
+				Successors:   []string{cyc2},
+			},
+			cyc2: {
+				Predecessors: []string{cyc1},
+				Successors:   []string{cyc1},
+			},
+		},
+	}
+	checkEqualGraphs(t, expectedGraph, reply.Graph)
+}
+
+func TestTypeHierarchy_nodeFilter(t *testing.T) {
+	svc := construct(t)
+
+	tests := []struct {
+		filter   *epb.NodeFilter
+		expected *epb.Graph
+	}{{
+		filter: &epb.NodeFilter{IncludedLanguages: []string{"go"}},
+		expected: &epb.Graph{
+			Nodes: map[string]*epb.GraphNode{
+				goIface: {Predecessors: []string{goImpl}},
+				goImpl: {
+					Predecessors: []string{goSub},
+					Successors:   []string{goIface},
+				},
+				goSub: {Successors: []string{goImpl}},
+			},
+		},
+	}, {
+		// Excluded types are not traversed, so goSub is reached only through
+		// javaImpl.
+		filter: &epb.NodeFilter{IncludedFiles: []*spb.VName{{Path: "Impl.java"}, {Path: "sub.go"}}},
+		expected: &epb.Graph{
+			Nodes: map[string]*epb.GraphNode{
+				goIface: {Predecessors: []string{javaImpl}},
+				javaImpl: {
+					Predecessors: []string{goSub},
+					Successors:   []string{goIface},
+				},
+				goSub: {Successors: []string{javaImpl}},
+			},
+		},
+	}, {
+		filter: &epb.NodeFilter{IncludedLanguages: []string{"c++"}},
+		expected: &epb.Graph{
+			Nodes: map[string]*epb.GraphNode{goIface: {}},
+		},
+	}}
+	for _, test := range tests {
+		reply, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{
+			TypeTicket: goIface,
+			Direction:  epb.TypeHierarchyRequest_SUBTYPES,
+			NodeFilter: test.filter,
+		})
+		testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+		checkEqualGraphs(t, test.expected, reply.Graph)
+	}
+}
+
 func TestChildren_badData(t *testing.T) {
 	svc := construct(t)
 
@@ -459,5 +814,48 @@ func construct(t *testing.T) *Tables {
 		ChildToParents:    childToParents,
 		FunctionToCallers: functionToCallers,
 		FunctionToCallees: functionToCallees,
+		TypeToSupertypes:  typeToSupertypes,
+		TypeToSubtypes:    typeToSubtypes,
+
+		FunctionToParameters: functionToParameters,
+	}
+}
+
+func TestCombinedTables(t *testing.T) {
+	combined := protoTable{
+		string(ChildrenKey(p1)):    (*parentToChildren)[p1],
+		string(ParentsKey(p1c1)):   (*childToParents)[p1c1],
+		string(CallersKey(f1)):     (*functionToCallers)[f1],
+		string(SupertypesKey(sub)): (*typeToSupertypes)[sub],
+		string(ParametersKey(fp)):  (*functionToParameters)[fp],
+	}
+	svc := NewCombinedTables(combined)
+
+	children, err := svc.Children(ctx, &epb.ChildrenRequest{Tickets: []string{p1, p1c1}})
+	testutil.FatalOnErrT(t, "Children error: %v", err)
+	if len(children.InputToChildren) != 1 {
+		t.Errorf("Expected children only for %q, got: %v", p1, children)
 	}
+
+	parents, err := svc.Parents(ctx, &epb.ParentsRequest{Tickets: []string{p1c1}})
+	testutil.FatalOnErrT(t, "Parents error: %v", err)
+	checkEquivalentLists(t, []string{p1}, parents.InputToParents[p1c1].GetTickets(), "parents")
+
+	callers, err := svc.Callers(ctx, &epb.CallersRequest{Tickets: []string{f1}})
+	testutil.FatalOnErrT(t, "Callers error: %v", err)
+	checkEquivalentLists(t, []string{f1r1, fr}, callers.Graph.Nodes[f1].GetPredecessors(), "callers")
+
+	callees, err := svc.Callees(ctx, &epb.CalleesRequest{Tickets: []string{f1}})
+	testutil.FatalOnErrT(t, "Callees error: %v", err)
+	if len(callees.Graph.Nodes) != 0 {
+		t.Errorf("Expected no callees for %q, got: %v", f1, callees)
+	}
+
+	hierarchy, err := svc.TypeHierarchy(ctx, &epb.TypeHierarchyRequest{TypeTicket: sub})
+	testutil.FatalOnErrT(t, "TypeHierarchy error: %v", err)
+	checkEquivalentLists(t, []string{impl1}, hierarchy.Graph.Nodes[sub].GetSuccessors(), "supertypes")
+
+	params, err := svc.Parameters(ctx, &epb.ParametersRequest{FunctionTickets: []string{fp}})
+	testutil.FatalOnErrT(t, "Parameters error: %v", err)
+	checkEquivalentLists(t, []string{fp0, fp1}, params.FunctionToParameters[fp].GetTickets(), "parameters")
 }
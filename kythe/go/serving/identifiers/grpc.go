@@ -0,0 +1,72 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifiers
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
+)
+
+// grpcServiceName is the full name of the IdentifierService in
+// identifier.proto.
+const grpcServiceName = "kythe.proto.IdentifierService"
+
+type grpcClient struct{ cc grpc.ClientConnInterface }
+
+// Find implements the Service interface.
+func (c grpcClient) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
+	var reply ipb.FindReply
+	if err := c.cc.Invoke(ctx, "/"+grpcServiceName+"/Find", req, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GRPCClient returns an identifiers Service based on a remote gRPC server
+// connection.
+func GRPCClient(cc grpc.ClientConnInterface) Service { return grpcClient{cc} }
+
+// RegisterGRPCServer registers it as the kythe.proto.IdentifierService of s.
+func RegisterGRPCServer(s grpc.ServiceRegistrar, it Service) {
+	s.RegisterService(&grpcServiceDesc, it)
+}
+
+var grpcServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcServiceName,
+	HandlerType: (*Service)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Find", Handler: findHandler},
+	},
+	Metadata: "kythe/proto/identifier.proto",
+}
+
+func findHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(ipb.FindRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	call := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Service).Find(ctx, req.(*ipb.FindRequest))
+	}
+	if interceptor == nil {
+		return call(ctx, req)
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + grpcServiceName + "/Find"}, call)
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifiers
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"kythe.io/kythe/go/test/testutil"
+
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
+)
+
+// fakeService returns a canned reply and records the last request it received.
+type fakeService struct {
+	find *ipb.FindReply
+	req  *ipb.FindRequest
+}
+
+func (f *fakeService) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
+	f.req = req
+	return f.find, nil
+}
+
+func TestGRPC(t *testing.T) {
+	svc := &fakeService{find: &ipb.FindReply{
+		Matches: []*ipb.FindReply_Match{{
+			Ticket:   "kythe://corpus?lang=go#pkg.Foo",
+			NodeKind: "record",
+			BaseName: "Foo",
+		}},
+		NextPageToken: "next",
+	}}
+
+	l := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	RegisterGRPCServer(srv, svc)
+	go srv.Serve(l)
+	defer srv.Stop()
+
+	cc, err := grpc.Dial("bufconn", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cc.Close()
+	client := GRPCClient(cc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	req := &ipb.FindRequest{
+		Identifier: "pkg.Foo",
+		Corpus:     []string{"corpus"},
+		Languages:  []string{"go"},
+		MatchKind:  ipb.FindRequest_PREFIX,
+	}
+	reply, err := client.Find(ctx, req)
+	if err != nil {
+		t.Fatalf("Find error: %v", err)
+	}
+	if !proto.Equal(reply, svc.find) {
+		t.Errorf("Find reply: found %v; expected %v", reply, svc.find)
+	}
+	if !proto.Equal(svc.req, req) {
+		t.Errorf("Find request: found %v; expected %v", svc.req, req)
+	}
+}
+
+func TestGRPCServiceDesc(t *testing.T) {
+	sd := ipb.File_kythe_proto_identifier_proto.Services().ByName("IdentifierService")
+	if err := testutil.ServiceDescEqual(sd, &grpcServiceDesc); err != nil {
+		t.Error(err)
+	}
+}
@@ -18,19 +18,31 @@
 // identifiers.Service.
 // The table is structured as:
 // 		qualifed_name -> IdentifierMatch
+// 		idtri:<trigram>[#<shard>] -> IdentifierPostings
 package identifiers // import "kythe.io/kythe/go/serving/identifiers"
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"kythe.io/kythe/go/services/web"
 	"kythe.io/kythe/go/storage/table"
 	"kythe.io/kythe/go/util/kytheuri"
 
+	"bitbucket.org/creachadair/stringset"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
 	ipb "kythe.io/kythe/proto/identifier_go_proto"
+	inpb "kythe.io/kythe/proto/internal_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
 )
 
@@ -46,37 +58,235 @@ type Table struct {
 	table.Proto
 }
 
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	// minSimilarity is the minimum trigram similarity of a FUZZY match.
+	minSimilarity = 0.3
+
+	// maxFuzzyCandidates is the maximum number of candidate names looked up
+	// for a FUZZY match.
+	maxFuzzyCandidates = 1000
+)
+
 // Find implements the Service interface for Table
 func (it *Table) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
 	var (
-		qname     = req.GetIdentifier()
 		corpora   = req.GetCorpus()
 		languages = req.GetLanguages()
-		match     srvpb.IdentifierMatch
 		reply     ipb.FindReply
 	)
 
-	if err := it.Lookup(ctx, []byte(qname), &match); err != nil {
-		return &reply, nil
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	start, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	skip := start
+
+	matches, err := it.lookupMatches(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, match := range matches {
+		for _, node := range match.GetNode() {
+			if !validCorpusAndLang(corpora, languages, node) {
+				continue
+			} else if skip > 0 {
+				skip--
+				continue
+			} else if len(reply.Matches) == pageSize {
+				// There is at least one more match past this page.
+				reply.NextPageToken, err = encodePageToken(start + len(reply.Matches))
+				return &reply, err
+			}
+
+			matchNode := ipb.FindReply_Match{
+				Ticket:        node.GetTicket(),
+				NodeKind:      node.GetNodeKind(),
+				NodeSubkind:   node.GetNodeSubkind(),
+				BaseName:      match.GetBaseName(),
+				QualifiedName: match.GetQualifiedName(),
+			}
+
+			reply.Matches = append(reply.GetMatches(), &matchNode)
+		}
 	}
 
-	for _, node := range match.GetNode() {
-		if !validCorpusAndLang(corpora, languages, node) {
+	return &reply, nil
+}
+
+// lookupMatches returns the IdentifierMatches satisfying req, in the order in
+// which they should be returned.
+func (it *Table) lookupMatches(ctx context.Context, req *ipb.FindRequest) ([]*srvpb.IdentifierMatch, error) {
+	ident := req.GetIdentifier()
+	kind := req.GetMatchKind()
+	if kind == ipb.FindRequest_EXACT && !req.GetCaseInsensitive() {
+		var match srvpb.IdentifierMatch
+		if err := it.Lookup(ctx, []byte(ident), &match); err != nil {
+			return nil, nil
+		}
+		return []*srvpb.IdentifierMatch{&match}, nil
+	}
+
+	// Determine the trigrams of the identifier that any matching name must
+	// also contain.
+	lower := strings.ToLower(ident)
+	var query stringset.Set
+	switch kind {
+	case ipb.FindRequest_EXACT, ipb.FindRequest_FUZZY:
+		query = paddedTrigrams(ident)
+	case ipb.FindRequest_PREFIX:
+		query = trigrams("  " + lower)
+	case ipb.FindRequest_SUBSTRING:
+		if utf8.RuneCountInString(ident) < 3 {
+			return nil, status.Errorf(codes.InvalidArgument, "SUBSTRING identifier must be at least 3 characters: %q", ident)
+		}
+		query = trigrams(lower)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown match_kind: %v", kind)
+	}
+
+	// Find the candidate qualified names with the number of the query's
+	// trigrams that each contains.
+	candidates := make(map[string]int)
+	for _, tri := range query.Elements() {
+		if err := it.lookupPostings(ctx, tri, func(qname string) { candidates[qname]++ }); err != nil {
+			return nil, err
+		}
+	}
+
+	type scoredMatch struct {
+		*srvpb.IdentifierMatch
+		score float64
+	}
+	var scored []scoredMatch
+	for _, qname := range candidateNames(kind, query.Len(), candidates) {
+		var match srvpb.IdentifierMatch
+		if err := it.Lookup(ctx, []byte(qname), &match); err == table.ErrNoSuchKey {
 			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error looking up identifier %q: %v", qname, err)
+		}
+		if kind == ipb.FindRequest_FUZZY {
+			score := similarity(query, paddedTrigrams(match.GetBaseName()))
+			if score >= minSimilarity {
+				scored = append(scored, scoredMatch{&match, score})
+			}
+		} else if matchesName(kind, req.GetCaseInsensitive(), ident, &match) {
+			scored = append(scored, scoredMatch{&match, 0})
 		}
+	}
 
-		matchNode := ipb.FindReply_Match{
-			Ticket:        node.GetTicket(),
-			NodeKind:      node.GetNodeKind(),
-			NodeSubkind:   node.GetNodeSubkind(),
-			BaseName:      match.GetBaseName(),
-			QualifiedName: match.GetQualifiedName(),
+	// Order matches by descending score and then by qualified name.
+	sort.Slice(scored, func(i, j int) bool {
+		if scored[i].score != scored[j].score {
+			return scored[i].score > scored[j].score
 		}
+		return scored[i].GetQualifiedName() < scored[j].GetQualifiedName()
+	})
+	matches := make([]*srvpb.IdentifierMatch, len(scored))
+	for i, m := range scored {
+		matches[i] = m.IdentifierMatch
+	}
+	return matches, nil
+}
 
-		reply.Matches = append(reply.GetMatches(), &matchNode)
+// lookupPostings calls f with each qualified name in the postings of the
+// given trigram.
+func (it *Table) lookupPostings(ctx context.Context, trigram string, f func(string)) error {
+	for shard := 0; ; shard++ {
+		var postings srvpb.IdentifierPostings
+		if err := it.Lookup(ctx, TrigramShardKey(trigram, shard), &postings); err == table.ErrNoSuchKey {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error looking up trigram %q: %v", trigram, err)
+		}
+		for _, qname := range postings.GetQualifiedName() {
+			f(qname)
+		}
 	}
+}
 
-	return &reply, nil
+// candidateNames returns the names of the candidates worth looking up, given
+// the number of the query's trigrams that each contains.  Non-FUZZY matches
+// must contain all of the query's trigrams.  A FUZZY match's similarity cannot
+// exceed the fraction of the query's trigrams it contains, so those below
+// minSimilarity are dropped and only the maxFuzzyCandidates containing the
+// most trigrams are kept.
+func candidateNames(kind ipb.FindRequest_MatchKind, queryLen int, candidates map[string]int) []string {
+	var names []string
+	for qname, n := range candidates {
+		if kind != ipb.FindRequest_FUZZY && n != queryLen {
+			continue // missing at least one of the required trigrams
+		} else if kind == ipb.FindRequest_FUZZY && float64(n)/float64(queryLen) < minSimilarity {
+			continue // too few shared trigrams to be similar enough
+		}
+		names = append(names, qname)
+	}
+	if kind != ipb.FindRequest_FUZZY || len(names) <= maxFuzzyCandidates {
+		return names
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if ni, nj := candidates[names[i]], candidates[names[j]]; ni != nj {
+			return ni > nj
+		}
+		return names[i] < names[j]
+	})
+	return names[:maxFuzzyCandidates]
+}
+
+// matchesName reports whether the names of match satisfy the given non-FUZZY
+// kind of match against ident.
+func matchesName(kind ipb.FindRequest_MatchKind, caseInsensitive bool, ident string, match *srvpb.IdentifierMatch) bool {
+	base, qname := match.GetBaseName(), match.GetQualifiedName()
+	if caseInsensitive {
+		ident, base, qname = strings.ToLower(ident), strings.ToLower(base), strings.ToLower(qname)
+	}
+	switch kind {
+	case ipb.FindRequest_EXACT:
+		return qname == ident
+	case ipb.FindRequest_PREFIX:
+		return strings.HasPrefix(base, ident) || strings.HasPrefix(qname, ident)
+	case ipb.FindRequest_SUBSTRING:
+		return strings.Contains(base, ident) || strings.Contains(qname, ident)
+	default:
+		return false
+	}
+}
+
+// decodePageToken returns the number of matches to skip for the given
+// FindRequest.page_token.
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	rec, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", token)
+	}
+	var t inpb.PageToken
+	if err := proto.Unmarshal(rec, &t); err != nil || t.Index < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", token)
+	}
+	return int(t.Index), nil
+}
+
+// encodePageToken returns the FindReply.next_page_token for the page starting
+// at the given index into the sequence of matches.
+func encodePageToken(index int) (string, error) {
+	rec, err := proto.Marshal(&inpb.PageToken{Index: int32(index)})
+	if err != nil {
+		return "", fmt.Errorf("error marshalling page token: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(rec), nil
 }
 
 func validCorpusAndLang(corpora, langs []string, node *srvpb.IdentifierMatch_Node) bool {
@@ -18,8 +18,12 @@ package identifiers
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"kythe.io/kythe/go/storage/table"
@@ -87,6 +91,233 @@ func TestFind(t *testing.T) {
 	}
 }
 
+// indexedTable returns a Table of the given matches with a trigram index.
+func indexedTable(matches ...*srvpb.IdentifierMatch) Table {
+	t := testProtoTable{}
+	postings := make(map[string][]string)
+	for _, m := range matches {
+		t[m.GetQualifiedName()] = m
+		for _, tri := range Trigrams(m) {
+			postings[tri] = append(postings[tri], m.GetQualifiedName())
+		}
+	}
+	for tri, qnames := range postings {
+		for i, shard := range Postings(qnames) {
+			t[string(TrigramShardKey(tri, i))] = shard
+		}
+	}
+	return Table{t}
+}
+
+var indexedMatchTable = indexedTable(
+	&srvpb.IdentifierMatch{
+		Node: []*srvpb.IdentifierMatch_Node{
+			node("kythe://corpus?lang=c++", "record", "class"),
+			node("kythe://corpus?lang=rust", "record", "struct"),
+		},
+		BaseName:      "bar",
+		QualifiedName: "foo::bar",
+	},
+	&srvpb.IdentifierMatch{
+		Node: []*srvpb.IdentifierMatch_Node{
+			node("kythe://habeas?lang=java", "record", "interface"),
+		},
+		BaseName:      "Interface",
+		QualifiedName: "com.java.package.Interface",
+	},
+	&srvpb.IdentifierMatch{
+		Node: []*srvpb.IdentifierMatch_Node{
+			node("kythe://habeas?lang=java#impl", "record", "class"),
+		},
+		BaseName:      "InterfaceImpl",
+		QualifiedName: "com.java.package.InterfaceImpl",
+	},
+)
+
+func TestFindIndexed(t *testing.T) {
+	iface := match("kythe://habeas?lang=java", "record", "interface", "Interface", "com.java.package.Interface")
+	impl := match("kythe://habeas?lang=java#impl", "record", "class", "InterfaceImpl", "com.java.package.InterfaceImpl")
+	tests := []struct {
+		req     *ipb.FindRequest
+		matches []*ipb.FindReply_Match
+	}{
+		{
+			&ipb.FindRequest{Identifier: "Inter", MatchKind: ipb.FindRequest_PREFIX},
+			[]*ipb.FindReply_Match{iface, impl},
+		},
+		{
+			&ipb.FindRequest{Identifier: "inter", MatchKind: ipb.FindRequest_PREFIX},
+			nil,
+		},
+		{
+			&ipb.FindRequest{Identifier: "inter", MatchKind: ipb.FindRequest_PREFIX, CaseInsensitive: true},
+			[]*ipb.FindReply_Match{iface, impl},
+		},
+		{
+			&ipb.FindRequest{Identifier: "foo::", MatchKind: ipb.FindRequest_PREFIX, Languages: []string{"rust"}},
+			[]*ipb.FindReply_Match{
+				match("kythe://corpus?lang=rust", "record", "struct", "bar", "foo::bar"),
+			},
+		},
+		{
+			&ipb.FindRequest{Identifier: "package.Interface", MatchKind: ipb.FindRequest_SUBSTRING},
+			[]*ipb.FindReply_Match{iface, impl},
+		},
+		{
+			&ipb.FindRequest{Identifier: "faceImp", MatchKind: ipb.FindRequest_SUBSTRING},
+			[]*ipb.FindReply_Match{impl},
+		},
+		{
+			&ipb.FindRequest{Identifier: "COM.JAVA.PACKAGE.INTERFACE", CaseInsensitive: true},
+			[]*ipb.FindReply_Match{iface},
+		},
+		{
+			&ipb.FindRequest{Identifier: "Interfce", MatchKind: ipb.FindRequest_FUZZY},
+			[]*ipb.FindReply_Match{iface, impl},
+		},
+		{
+			&ipb.FindRequest{Identifier: "interfaceimpl", MatchKind: ipb.FindRequest_FUZZY},
+			[]*ipb.FindReply_Match{impl, iface},
+		},
+	}
+
+	for _, test := range tests {
+		reply, err := indexedMatchTable.Find(context.TODO(), test.req)
+		if err != nil {
+			t.Errorf("unexpected error for request %v: %v", test.req, err)
+			continue
+		}
+
+		if err := testutil.DeepEqual(test.matches, reply.Matches); err != nil {
+			t.Errorf("%v: %v", test.req, err)
+		}
+	}
+
+	if _, err := indexedMatchTable.Find(context.TODO(), &ipb.FindRequest{
+		Identifier: "ba",
+		MatchKind:  ipb.FindRequest_SUBSTRING,
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error for short SUBSTRING identifier; found: %v", err)
+	}
+}
+
+func TestFindPaging(t *testing.T) {
+	req := &ipb.FindRequest{
+		Identifier: "foo::bar",
+		PageSize:   1,
+	}
+	var found []*ipb.FindReply_Match
+	for pages := 1; ; pages++ {
+		reply, err := indexedMatchTable.Find(context.TODO(), req)
+		if err != nil {
+			t.Fatalf("unexpected error for request %v: %v", req, err)
+		}
+		found = append(found, reply.Matches...)
+		if reply.NextPageToken == "" {
+			if pages != 2 {
+				t.Errorf("Expected 2 pages; found %d", pages)
+			}
+			break
+		} else if pages > 2 {
+			t.Fatalf("Too many pages: %d", pages)
+		}
+		req.PageToken = reply.NextPageToken
+	}
+
+	expected := []*ipb.FindReply_Match{
+		match("kythe://corpus?lang=c++", "record", "class", "bar", "foo::bar"),
+		match("kythe://corpus?lang=rust", "record", "struct", "bar", "foo::bar"),
+	}
+	if err := testutil.DeepEqual(expected, found); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPostingsShards(t *testing.T) {
+	var qnames []string
+	for i := 0; i < 2*maxPostingsShard+1; i++ {
+		qnames = append(qnames, fmt.Sprintf("pkg.Name%06d", i))
+	}
+	shards := Postings(append(qnames, qnames[0]))
+	if len(shards) != 3 {
+		t.Fatalf("Postings returned %d shards; want 3", len(shards))
+	}
+	for i, n := range []int{maxPostingsShard, maxPostingsShard, 1} {
+		if got := len(shards[i].QualifiedName); got != n {
+			t.Errorf("Shard %d has %d names; want %d", i, got, n)
+		}
+	}
+
+	// Every shard is read when finding matches.
+	ms := make([]*srvpb.IdentifierMatch, len(qnames))
+	for i, qname := range qnames {
+		ms[i] = &srvpb.IdentifierMatch{
+			Node:          []*srvpb.IdentifierMatch_Node{node("kythe://corpus?lang=go#"+qname, "function", "")},
+			BaseName:      strings.TrimPrefix(qname, "pkg."),
+			QualifiedName: qname,
+		}
+	}
+	tbl := indexedTable(ms...)
+	if _, ok := tbl.Proto.(testProtoTable)[string(TrigramShardKey("  p", 2))]; !ok {
+		t.Fatal("Missing third shard of postings")
+	}
+	reply, err := tbl.Find(context.TODO(), &ipb.FindRequest{
+		Identifier: "pkg.Name",
+		MatchKind:  ipb.FindRequest_PREFIX,
+		PageSize:   maxPageSize,
+		PageToken:  mustPageToken(t, 2*maxPostingsShard),
+	})
+	if err != nil {
+		t.Fatalf("Find error: %v", err)
+	} else if len(reply.Matches) != 1 || reply.Matches[0].QualifiedName != qnames[len(qnames)-1] {
+		t.Errorf("Find: got %v; want %q", reply.Matches, qnames[len(qnames)-1])
+	}
+}
+
+func TestFindFuzzyCandidates(t *testing.T) {
+	// The last match shares too few of the query's trigrams to be looked up.
+	ms := []*srvpb.IdentifierMatch{{BaseName: "zzzabc", QualifiedName: "zzzabc"}}
+	for i := 0; i < maxFuzzyCandidates+10; i++ {
+		name := fmt.Sprintf("abcdef%04d", i)
+		ms = append(ms, &srvpb.IdentifierMatch{
+			Node:          []*srvpb.IdentifierMatch_Node{node("kythe://corpus?lang=go#"+name, "function", "")},
+			BaseName:      name,
+			QualifiedName: name,
+		})
+	}
+	tbl := indexedTable(ms...)
+	lookups := &countingTable{Proto: tbl.Proto}
+	tbl.Proto = lookups
+
+	reply, err := tbl.Find(context.TODO(), &ipb.FindRequest{
+		Identifier: "abcdef",
+		MatchKind:  ipb.FindRequest_FUZZY,
+	})
+	if err != nil {
+		t.Fatalf("Find error: %v", err)
+	}
+	if lookups.matches > maxFuzzyCandidates {
+		t.Errorf("Looked up %d candidates; want at most %d", lookups.matches, maxFuzzyCandidates)
+	}
+	if len(reply.Matches) != defaultPageSize {
+		t.Errorf("Found %d matches; want %d", len(reply.Matches), defaultPageSize)
+	}
+	for _, m := range reply.Matches {
+		if m.QualifiedName == "zzzabc" {
+			t.Errorf("Unexpected dissimilar match: %v", m)
+		}
+	}
+}
+
+func mustPageToken(t *testing.T, index int) string {
+	t.Helper()
+	token, err := encodePageToken(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
 func findRequest(qname string, corpora, langs []string) ipb.FindRequest {
 	return ipb.FindRequest{
 		Identifier: qname,
@@ -137,3 +368,16 @@ func (t testProtoTable) Lookup(_ context.Context, key []byte, msg proto.Message)
 func (t testProtoTable) Buffered() table.BufferedProto { panic("UNIMPLEMENTED") }
 
 func (t testProtoTable) Close(_ context.Context) error { return nil }
+
+// countingTable counts the IdentifierMatches looked up in a table.
+type countingTable struct {
+	table.Proto
+	matches int
+}
+
+func (t *countingTable) Lookup(ctx context.Context, key []byte, msg proto.Message) error {
+	if !strings.HasPrefix(string(key), trigramTablePrefix) {
+		t.matches++
+	}
+	return t.Proto.Lookup(ctx, key, msg)
+}
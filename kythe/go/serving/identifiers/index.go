@@ -0,0 +1,95 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package identifiers
+
+import (
+	"fmt"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+)
+
+// trigramTablePrefix is the key prefix of the trigram secondary index.
+const trigramTablePrefix = "idtri:"
+
+// maxPostingsShard is the maximum number of qualified names stored in each
+// shard of a trigram's IdentifierPostings.
+const maxPostingsShard = 10000
+
+// TrigramKey returns the table key of the first shard of IdentifierPostings
+// for the given trigram.
+func TrigramKey(trigram string) []byte { return TrigramShardKey(trigram, 0) }
+
+// TrigramShardKey returns the table key of the given shard of
+// IdentifierPostings for trigram.  Shards are numbered consecutively from 0.
+func TrigramShardKey(trigram string, shard int) []byte {
+	if shard == 0 {
+		return []byte(trigramTablePrefix + trigram)
+	}
+	return []byte(fmt.Sprintf("%s%s#%d", trigramTablePrefix, trigram, shard))
+}
+
+// Trigrams returns the sorted set of trigrams under which the given match is
+// indexed: those of its lowercased base and qualified names, each padded by
+// two leading spaces and one trailing space to mark the boundaries of the
+// name.
+func Trigrams(match *srvpb.IdentifierMatch) []string {
+	ts := paddedTrigrams(match.GetBaseName())
+	ts.Update(paddedTrigrams(match.GetQualifiedName()))
+	return ts.Elements()
+}
+
+// Postings returns the shards of IdentifierPostings for the given qualified
+// names, sorted and with duplicates removed.  The ith shard for a trigram is
+// stored under TrigramShardKey(trigram, i).
+func Postings(qualifiedNames []string) []*srvpb.IdentifierPostings {
+	qnames := stringset.New(qualifiedNames...).Elements()
+	var shards []*srvpb.IdentifierPostings
+	for len(qnames) > maxPostingsShard {
+		shards = append(shards, &srvpb.IdentifierPostings{QualifiedName: qnames[:maxPostingsShard]})
+		qnames = qnames[maxPostingsShard:]
+	}
+	return append(shards, &srvpb.IdentifierPostings{QualifiedName: qnames})
+}
+
+// paddedTrigrams returns the trigrams of the lowercased name with its
+// boundaries marked.
+func paddedTrigrams(name string) stringset.Set {
+	return trigrams("  " + strings.ToLower(name) + " ")
+}
+
+// trigrams returns the set of 3-rune substrings of s.
+func trigrams(s string) stringset.Set {
+	rs := []rune(s)
+	ts := stringset.New()
+	for i := 0; i+3 <= len(rs); i++ {
+		ts.Add(string(rs[i : i+3]))
+	}
+	return ts
+}
+
+// similarity returns the ratio of the trigrams shared by a and b to all of
+// their trigrams.
+func similarity(a, b stringset.Set) float64 {
+	shared := a.Intersect(b).Len()
+	if shared == 0 {
+		return 0
+	}
+	return float64(shared) / float64(a.Union(b).Len())
+}
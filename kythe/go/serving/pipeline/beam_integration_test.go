@@ -444,6 +444,7 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Filter:          []string{"**"},
 		RelatedNodeKind: []string{"NONE"},
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -466,6 +467,7 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Ticket:        []string{ticket},
 		ReferenceKind: xpb.CrossReferencesRequest_NON_CALL_REFERENCES,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -506,6 +508,9 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Ticket: []string{ticket},
 		Filter: []string{"**"},
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			RelatedNodesByRelation: map[string]int64{edges.Extends: 1},
+		},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -536,6 +541,9 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Filter:          []string{facts.NodeKind},
 		NodeDefinitions: true,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			RelatedNodesByRelation: map[string]int64{edges.Extends: 1},
+		},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -588,6 +596,7 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Ticket:        []string{ticket},
 		ReferenceKind: xpb.CrossReferencesRequest_CALL_REFERENCES,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -628,6 +637,7 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Ticket:     []string{ticket},
 		CallerKind: xpb.CrossReferencesRequest_DIRECT_CALLERS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Callers: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -696,6 +706,7 @@ func TestServingSimpleCrossReferences(t *testing.T) {
 		Ticket:     []string{ticket},
 		CallerKind: xpb.CrossReferencesRequest_OVERRIDE_CALLERS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Callers: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
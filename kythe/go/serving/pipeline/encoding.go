@@ -108,6 +108,7 @@ func encodeDecorFile(file *spb.VName, f *srvpb.File, emit func([]byte, []byte))
 		File: file,
 		Entry: &xspb.FileDecorations_Index_{&xspb.FileDecorations_Index{
 			TextEncoding: f.Encoding,
+			FileInfo:     fileInfos(f),
 		}},
 	})
 	if err != nil {
@@ -132,6 +133,14 @@ func encodeDecorFile(file *spb.VName, f *srvpb.File, emit func([]byte, []byte))
 	return nil
 }
 
+// fileInfos returns the known FileInfos for the given file.
+func fileInfos(f *srvpb.File) []*srvpb.FileInfo {
+	if f.Info == nil {
+		return nil
+	}
+	return []*srvpb.FileInfo{f.Info}
+}
+
 func encodeDecorRef(file *spb.VName, ref *ppb.Reference, emit func([]byte, []byte)) error {
 	target := &xspb.FileDecorations_Target{
 		StartOffset: ref.Anchor.Span.Start.ByteOffset,
@@ -0,0 +1,681 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	esrv "kythe.io/kythe/go/serving/explore"
+	"kythe.io/kythe/go/serving/pipeline/nodes"
+	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/disksort"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema"
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	kinds "kythe.io/kythe/go/util/schema/nodes"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/apache/beam/sdks/go/pkg/beam"
+	"google.golang.org/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	scpb "kythe.io/kythe/proto/schema_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// exploreNode accumulates the explore table data of a single node from its
+// completed edges.
+type exploreNode struct {
+	ticket, kind string
+
+	supertypes, subtypes stringset.Set
+	parents, children    stringset.Set
+
+	// For a call site anchor: the functions it calls and those containing it.
+	callees, callers stringset.Set
+
+	params []*srvpb.FunctionParameters_Parameter
+
+	// For a function type (a tapp of the fn builtin): its return type and the
+	// functions typed by it.
+	fnType     bool
+	returnType *srvpb.FunctionParameters_Node
+	typedFuncs []string
+}
+
+// fnBuiltin is the signature of the type constructor of function types.
+const fnBuiltin = "fn#builtin"
+
+// isTypeHierarchyEdge reports whether kind relates a subtype (or overriding
+// function) to its supertype, in either direction.
+func isTypeHierarchyEdge(kind string) bool {
+	canon := edges.Canonical(kind)
+	return edges.IsVariant(canon, edges.Extends) ||
+		edges.IsVariant(canon, edges.Satisfies) ||
+		edges.IsVariant(canon, edges.Overrides)
+}
+
+// addEdge records the completed edge e, whose source is n.
+func (n *exploreNode) addEdge(e *srvpb.Edge) {
+	switch {
+	case isTypeHierarchyEdge(e.Kind):
+		if e.Target.Ticket == n.ticket {
+			break
+		} else if edges.IsForward(e.Kind) {
+			n.supertypes = addTicket(n.supertypes, e.Target.Ticket)
+		} else {
+			n.subtypes = addTicket(n.subtypes, e.Target.Ticket)
+		}
+	case e.Kind == edges.ChildOf:
+		if n.kind == kinds.Anchor {
+			if nodeFact(e.Target, facts.NodeKind) != kinds.File {
+				n.callers = addTicket(n.callers, e.Target.Ticket)
+			}
+		} else {
+			n.parents = addTicket(n.parents, e.Target.Ticket)
+		}
+	case e.Kind == edges.Mirror(edges.ChildOf):
+		if n.kind != kinds.Anchor && nodeFact(e.Target, facts.NodeKind) != kinds.Anchor {
+			n.children = addTicket(n.children, e.Target.Ticket)
+		}
+	case edges.IsVariant(e.Kind, edges.RefCall):
+		n.callees = addTicket(n.callees, e.Target.Ticket)
+	case e.Kind == edges.Param:
+		switch {
+		case n.kind == kinds.Function:
+			n.params = append(n.params, &srvpb.FunctionParameters_Parameter{
+				Ordinal: e.Ordinal,
+				Node:    parameterNode(e.Target),
+			})
+		case n.kind == kinds.TApp && e.Ordinal == 0:
+			uri, err := kytheuri.Parse(e.Target.Ticket)
+			n.fnType = err == nil && uri.Signature == fnBuiltin
+		case n.kind == kinds.TApp && e.Ordinal == 1:
+			n.returnType = parameterNode(e.Target)
+		}
+	case e.Kind == edges.Mirror(edges.Typed):
+		if nodeFact(e.Target, facts.NodeKind) == kinds.Function {
+			n.typedFuncs = append(n.typedFuncs, e.Target.Ticket)
+		}
+	}
+}
+
+// addCalls adds the calls made at n, if it is a call site, to the given
+// sorter of *exploreCalls.
+func (n *exploreNode) addCalls(sorter disksort.Interface) error {
+	for caller := range n.callers {
+		for callee := range n.callees {
+			if err := sorter.Add(&exploreCall{
+				function: callee,
+				ticket:   caller,
+				typ:      srvpb.Callgraph_CALLER,
+			}); err != nil {
+				return err
+			}
+			if err := sorter.Add(&exploreCall{
+				function: caller,
+				ticket:   callee,
+				typ:      srvpb.Callgraph_CALLEE,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addParameters adds the parameters of n, and the return type of each
+// function typed by n, to the given sorter of *exploreParameters.
+func (n *exploreNode) addParameters(sorter disksort.Interface) error {
+	if len(n.params) > 0 {
+		if err := sorter.Add(&exploreParameters{
+			function: n.ticket,
+			params:   &srvpb.FunctionParameters{Parameter: n.params},
+		}); err != nil {
+			return err
+		}
+	}
+	if n.fnType && n.returnType != nil {
+		for _, fn := range n.typedFuncs {
+			if err := sorter.Add(&exploreParameters{
+				function: fn,
+				params:   &srvpb.FunctionParameters{ReturnValue: n.returnType},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parameterNode returns the FunctionParameters_Node describing the completed
+// edge target n.
+func parameterNode(n *srvpb.Node) *srvpb.FunctionParameters_Node {
+	p := &srvpb.FunctionParameters_Node{
+		Ticket:  n.Ticket,
+		Kind:    nodeFact(n, facts.NodeKind),
+		Subkind: nodeFact(n, facts.Subkind),
+	}
+	if rec := nodeFact(n, facts.Code); rec != "" {
+		var ms cpb.MarkedSource
+		if err := proto.Unmarshal([]byte(rec), &ms); err == nil {
+			p.MarkedSource = &ms
+		}
+	}
+	return p
+}
+
+// nodeFact returns the value of the named fact of n, or "" if it is unset.
+func nodeFact(n *srvpb.Node, name string) string {
+	for _, f := range n.GetFact() {
+		if f.Name == name {
+			return string(f.Value)
+		}
+	}
+	return ""
+}
+
+// write writes the explore table entries of n to out.
+func (n *exploreNode) write(ctx context.Context, out table.BufferedProto) error {
+	if !n.parents.Empty() {
+		if err := out.Put(ctx, esrv.ParentsKey(n.ticket), &srvpb.Relatives{
+			Tickets: n.parents.Elements(),
+			Type:    srvpb.Relatives_PARENTS,
+		}); err != nil {
+			return err
+		}
+	}
+	if !n.children.Empty() {
+		if err := out.Put(ctx, esrv.ChildrenKey(n.ticket), &srvpb.Relatives{
+			Tickets: n.children.Elements(),
+			Type:    srvpb.Relatives_CHILDREN,
+		}); err != nil {
+			return err
+		}
+	}
+	if !n.supertypes.Empty() {
+		if err := out.Put(ctx, esrv.SupertypesKey(n.ticket), &srvpb.TypeRelatives{
+			Tickets: n.supertypes.Elements(),
+			Type:    srvpb.TypeRelatives_SUPERTYPES,
+		}); err != nil {
+			return err
+		}
+	}
+	if !n.subtypes.Empty() {
+		if err := out.Put(ctx, esrv.SubtypesKey(n.ticket), &srvpb.TypeRelatives{
+			Tickets: n.subtypes.Elements(),
+			Type:    srvpb.TypeRelatives_SUBTYPES,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addTicket adds ticket to set, allocating the set if necessary.
+func addTicket(set stringset.Set, ticket string) stringset.Set {
+	if set == nil {
+		set = stringset.New()
+	}
+	set.Add(ticket)
+	return set
+}
+
+// writeExploreTables writes the explore service tables derived from the given
+// completed edges to out.  The edges must be grouped by source, with each
+// group headed by a targetless edge, as produced by combineNodesAndEdges.
+func writeExploreTables(ctx context.Context, opts *Options, edges <-chan *srvpb.Edge, out table.Proto) error {
+	log.Println("Writing explore tables")
+	buffer := out.Buffered()
+
+	// A function's parameters and its return type are found in the edges of
+	// different nodes, as are the calls made by and to a function, so each is
+	// joined by function ticket.
+	params, err := opts.diskSorter(exploreParametersLesser{}, exploreParametersMarshaler{})
+	if err != nil {
+		for range edges { // drain input channel
+		}
+		return err
+	}
+	calls, err := opts.diskSorter(exploreCallLesser{}, exploreCallMarshaler{})
+	if err != nil {
+		for range edges { // drain input channel
+		}
+		return err
+	}
+
+	var n *exploreNode
+	finish := func() error {
+		if n == nil {
+			return nil
+		} else if err := n.write(ctx, buffer); err != nil {
+			return err
+		} else if err := n.addCalls(calls); err != nil {
+			return err
+		}
+		return n.addParameters(params)
+	}
+	for e := range edges {
+		if n == nil || n.ticket != e.Source.Ticket {
+			if err := finish(); err != nil {
+				for range edges { // drain input channel
+				}
+				return err
+			}
+			n = &exploreNode{ticket: e.Source.Ticket}
+		}
+		if e.Target == nil {
+			n.kind = nodeFact(e.Source, facts.NodeKind)
+		} else {
+			n.addEdge(e)
+		}
+	}
+	if err := finish(); err != nil {
+		return err
+	}
+
+	if err := writeFunctionParameters(ctx, params, buffer); err != nil {
+		return fmt.Errorf("error writing function parameters: %v", err)
+	} else if err := writeCallgraphs(ctx, calls, buffer); err != nil {
+		return fmt.Errorf("error writing callgraphs: %v", err)
+	}
+	return buffer.Flush(ctx)
+}
+
+// writeCallgraphs groups the *exploreCalls read from the given sorter into
+// srvpb.Callgraphs and writes them to out.
+func writeCallgraphs(ctx context.Context, sorter disksort.Interface, out table.BufferedProto) error {
+	var (
+		cur     *exploreCall
+		tickets []string
+	)
+	flush := func() error {
+		if cur == nil {
+			return nil
+		}
+		key := esrv.CallersKey(cur.function)
+		if cur.typ == srvpb.Callgraph_CALLEE {
+			key = esrv.CalleesKey(cur.function)
+		}
+		return out.Put(ctx, key, &srvpb.Callgraph{Tickets: tickets, Type: cur.typ})
+	}
+	if err := sorter.Read(func(i interface{}) error {
+		c := i.(*exploreCall)
+		if cur != nil && cur.typ == c.typ && cur.function == c.function {
+			if tickets[len(tickets)-1] != c.ticket {
+				tickets = append(tickets, c.ticket)
+			}
+			return nil
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		cur, tickets = c, []string{c.ticket}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// exploreCall records that ticket is a caller or callee (according to typ)
+// of function.
+type exploreCall struct {
+	function, ticket string
+	typ              srvpb.Callgraph_Type
+}
+
+type exploreCallLesser struct{}
+
+func (exploreCallLesser) Less(a, b interface{}) bool {
+	x, y := a.(*exploreCall), b.(*exploreCall)
+	if x.typ != y.typ {
+		return x.typ < y.typ
+	} else if x.function != y.function {
+		return x.function < y.function
+	}
+	return x.ticket < y.ticket
+}
+
+type exploreCallMarshaler struct{}
+
+func (exploreCallMarshaler) Marshal(x interface{}) ([]byte, error) {
+	c := x.(*exploreCall)
+	return []byte(fmt.Sprintf("%d\000%s\000%s", c.typ, c.function, c.ticket)), nil
+}
+
+func (exploreCallMarshaler) Unmarshal(rec []byte) (interface{}, error) {
+	ss := bytes.SplitN(rec, []byte("\000"), 3)
+	if len(ss) != 3 {
+		return nil, errors.New("invalid exploreCall encoding")
+	}
+	typ, err := strconv.Atoi(string(ss[0]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid exploreCall type: %v", err)
+	}
+	return &exploreCall{
+		function: string(ss[1]),
+		ticket:   string(ss[2]),
+		typ:      srvpb.Callgraph_Type(typ),
+	}, nil
+}
+
+// writeFunctionParameters merges the *exploreParameters read from the given
+// sorter by function and writes them to out.
+func writeFunctionParameters(ctx context.Context, sorter disksort.Interface, out table.BufferedProto) error {
+	var cur *exploreParameters
+	flush := func() error {
+		if cur == nil {
+			return nil
+		}
+		sort.SliceStable(cur.params.Parameter, func(i, j int) bool {
+			return cur.params.Parameter[i].Ordinal < cur.params.Parameter[j].Ordinal
+		})
+		return out.Put(ctx, esrv.ParametersKey(cur.function), cur.params)
+	}
+	if err := sorter.Read(func(i interface{}) error {
+		p := i.(*exploreParameters)
+		if cur != nil && cur.function == p.function {
+			cur.params.Parameter = append(cur.params.Parameter, p.params.Parameter...)
+			if p.params.ReturnValue != nil {
+				cur.params.ReturnValue = p.params.ReturnValue
+			}
+			return nil
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		cur = p
+		return nil
+	}); err != nil {
+		return err
+	}
+	return flush()
+}
+
+// exploreParameters is a partial srvpb.FunctionParameters for a function.
+type exploreParameters struct {
+	function string
+	params   *srvpb.FunctionParameters
+}
+
+type exploreParametersLesser struct{}
+
+func (exploreParametersLesser) Less(a, b interface{}) bool {
+	return a.(*exploreParameters).function < b.(*exploreParameters).function
+}
+
+type exploreParametersMarshaler struct{}
+
+func (exploreParametersMarshaler) Marshal(x interface{}) ([]byte, error) {
+	p := x.(*exploreParameters)
+	rec, err := proto.Marshal(p.params)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Join([][]byte{[]byte(p.function), rec}, []byte("\000")), nil
+}
+
+func (exploreParametersMarshaler) Unmarshal(rec []byte) (interface{}, error) {
+	ss := bytes.SplitN(rec, []byte("\000"), 2)
+	if len(ss) != 2 {
+		return nil, errors.New("invalid exploreParameters encoding")
+	}
+	var params srvpb.FunctionParameters
+	if err := proto.Unmarshal(ss[1], &params); err != nil {
+		return nil, err
+	}
+	return &exploreParameters{function: string(ss[0]), params: &params}, nil
+}
+
+func init() {
+	beam.RegisterFunction(fnTypeToReturnRefs)
+	beam.RegisterFunction(groupExploreRelations)
+	beam.RegisterFunction(mergeFunctionParameters)
+	beam.RegisterFunction(nodeToExploreRelations)
+	beam.RegisterFunction(nodeToParamNode)
+	beam.RegisterFunction(nodeToParamRefs)
+	beam.RegisterFunction(resolveParamRefs)
+
+	beam.RegisterType(reflect.TypeOf((*exploreParamRef)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.Callgraph)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.FunctionParameters)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.FunctionParameters_Node)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.Relatives)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.TypeRelatives)(nil)).Elem())
+}
+
+// Explore returns the explore tables derived from the Kythe input graph: the
+// parents/children, callers/callees, supertypes/subtypes, and function
+// parameters of each node.  The returned beam.PCollections have elements of
+// type KV<string, *srvpb.Relatives>, KV<string, *srvpb.Callgraph>, KV<string,
+// *srvpb.TypeRelatives>, and KV<string, *srvpb.FunctionParameters>,
+// respectively.
+func (k *KytheBeam) Explore() (relatives, callgraphs, typeRelatives, parameters beam.PCollection) {
+	s := k.s.Scope("Explore")
+	related := beam.ParDo(s, nodeToExploreRelations, k.nodes)
+	relatives, callgraphs, typeRelatives = beam.ParDo3(s, groupExploreRelations, beam.GroupByKey(s, related))
+	return relatives, callgraphs, typeRelatives, k.functionParameters(s)
+}
+
+// nodeToExploreRelations emits a (table key, ticket) pair for each explore
+// relation between n and another node.
+func nodeToExploreRelations(n *scpb.Node, emit func(string, string)) {
+	ticket := kytheuri.ToString(n.Source)
+	if schema.GetNodeKind(n) == kinds.Anchor {
+		// An anchor is a call site of each function it ref/calls, made by each
+		// semantic node it is a child of.  The anchor's own file is not a caller.
+		file := fileVName(n.Source)
+		var callers, callees []string
+		for _, e := range n.Edge {
+			kind := schema.GetEdgeKind(e)
+			if kind == edges.ChildOf && !proto.Equal(e.Target, file) {
+				callers = append(callers, kytheuri.ToString(e.Target))
+			} else if edges.IsVariant(kind, edges.RefCall) {
+				callees = append(callees, kytheuri.ToString(e.Target))
+			}
+		}
+		for _, caller := range callers {
+			for _, callee := range callees {
+				emit(string(esrv.CallersKey(callee)), caller)
+				emit(string(esrv.CalleesKey(caller)), callee)
+			}
+		}
+		return
+	}
+
+	for _, e := range n.Edge {
+		kind := schema.GetEdgeKind(e)
+		target := kytheuri.ToString(e.Target)
+		switch {
+		case kind == edges.ChildOf:
+			emit(string(esrv.ParentsKey(ticket)), target)
+			emit(string(esrv.ChildrenKey(target)), ticket)
+		case isTypeHierarchyEdge(kind) && target != ticket:
+			emit(string(esrv.SupertypesKey(ticket)), target)
+			emit(string(esrv.SubtypesKey(target)), ticket)
+		}
+	}
+}
+
+// groupExploreRelations emits the explore table value for the given key,
+// containing the sorted set of its related tickets.
+func groupExploreRelations(key string, tickets func(*string) bool, emitRelatives func(string, *srvpb.Relatives), emitCallgraph func(string, *srvpb.Callgraph), emitTypeRelatives func(string, *srvpb.TypeRelatives)) error {
+	set := stringset.New()
+	var ticket string
+	for tickets(&ticket) {
+		set.Add(ticket)
+	}
+	related := set.Elements()
+
+	hasPrefix := func(keyFunc func(string) []byte) bool {
+		return strings.HasPrefix(key, string(keyFunc("")))
+	}
+	switch {
+	case hasPrefix(esrv.ParentsKey):
+		emitRelatives(key, &srvpb.Relatives{Tickets: related, Type: srvpb.Relatives_PARENTS})
+	case hasPrefix(esrv.ChildrenKey):
+		emitRelatives(key, &srvpb.Relatives{Tickets: related, Type: srvpb.Relatives_CHILDREN})
+	case hasPrefix(esrv.CallersKey):
+		emitCallgraph(key, &srvpb.Callgraph{Tickets: related, Type: srvpb.Callgraph_CALLER})
+	case hasPrefix(esrv.CalleesKey):
+		emitCallgraph(key, &srvpb.Callgraph{Tickets: related, Type: srvpb.Callgraph_CALLEE})
+	case hasPrefix(esrv.SupertypesKey):
+		emitTypeRelatives(key, &srvpb.TypeRelatives{Tickets: related, Type: srvpb.TypeRelatives_SUPERTYPES})
+	case hasPrefix(esrv.SubtypesKey):
+		emitTypeRelatives(key, &srvpb.TypeRelatives{Tickets: related, Type: srvpb.TypeRelatives_SUBTYPES})
+	default:
+		return fmt.Errorf("unknown explore table key: %q", key)
+	}
+	return nil
+}
+
+// exploreParamRef records that the node it is keyed by is a parameter (or the
+// return type) of Function.
+type exploreParamRef struct {
+	Function string
+	Ordinal  int32
+	Return   bool
+}
+
+// functionParameters returns the KV<string, *srvpb.FunctionParameters> table
+// of each function's parameters and return type.
+func (k *KytheBeam) functionParameters(s beam.Scope) beam.PCollection {
+	s = s.Scope("Parameters")
+	params, typedFns, fnTypes := beam.ParDo3(s, nodeToParamRefs, k.nodes)
+	returns := beam.ParDo(s, fnTypeToReturnRefs, beam.CoGroupByKey(s, typedFns, fnTypes))
+	paramNodes := beam.Seq(s, k.nodes, &nodes.Filter{
+		IncludeFacts: []string{facts.Code},
+		IncludeEdges: []string{},
+	}, nodeToParamNode)
+	refs := beam.Flatten(s, params, returns)
+	partial := beam.ParDo(s, resolveParamRefs, beam.CoGroupByKey(s, refs, paramNodes))
+	return beam.ParDo(s, mergeFunctionParameters, beam.GroupByKey(s, partial))
+}
+
+// nodeToParamRefs emits an exploreParamRef for each parameter of a function
+// node, keyed by the parameter; the function keyed by each type it is typed
+// by; and the return type of a function type (a tapp of the fn builtin), keyed
+// by the function type.
+func nodeToParamRefs(n *scpb.Node, emitParam func(*spb.VName, exploreParamRef), emitTyped func(*spb.VName, string), emitReturn func(*spb.VName, *spb.VName)) {
+	switch schema.GetNodeKind(n) {
+	case kinds.Function:
+		ticket := kytheuri.ToString(n.Source)
+		for _, e := range n.Edge {
+			switch schema.GetEdgeKind(e) {
+			case edges.Param:
+				emitParam(e.Target, exploreParamRef{Function: ticket, Ordinal: e.Ordinal})
+			case edges.Typed:
+				emitTyped(e.Target, ticket)
+			}
+		}
+	case kinds.TApp:
+		var ctor, ret *spb.VName
+		for _, e := range n.Edge {
+			if schema.GetEdgeKind(e) != edges.Param {
+				continue
+			} else if e.Ordinal == 0 {
+				ctor = e.Target
+			} else if e.Ordinal == 1 {
+				ret = e.Target
+			}
+		}
+		if ctor.GetSignature() == fnBuiltin && ret != nil {
+			emitReturn(n.Source, ret)
+		}
+	}
+}
+
+// fnTypeToReturnRefs emits the return type of each function typed by a
+// function type, keyed by the return type.
+func fnTypeToReturnRefs(_ *spb.VName, fnStream func(*string) bool, retStream func(**spb.VName) bool, emit func(*spb.VName, exploreParamRef)) {
+	var ret *spb.VName
+	if !retStream(&ret) {
+		return
+	}
+	var fn string
+	for fnStream(&fn) {
+		emit(ret, exploreParamRef{Function: fn, Return: true})
+	}
+}
+
+// nodeToParamNode emits the FunctionParameters_Node describing n.
+func nodeToParamNode(n *scpb.Node) (*spb.VName, *srvpb.FunctionParameters_Node, error) {
+	p := &srvpb.FunctionParameters_Node{
+		Ticket:  kytheuri.ToString(n.Source),
+		Kind:    schema.GetNodeKind(n),
+		Subkind: schema.GetSubkind(n),
+	}
+	for _, f := range n.Fact {
+		if f.GetKytheName() == scpb.FactName_CODE {
+			var ms cpb.MarkedSource
+			if err := proto.Unmarshal(f.Value, &ms); err != nil {
+				return nil, nil, err
+			}
+			p.MarkedSource = &ms
+			break
+		}
+	}
+	return n.Source, p, nil
+}
+
+// resolveParamRefs emits a partial *srvpb.FunctionParameters for each
+// exploreParamRef to the given node, keyed by the referencing function's
+// table key.
+func resolveParamRefs(src *spb.VName, refStream func(*exploreParamRef) bool, nodeStream func(**srvpb.FunctionParameters_Node) bool, emit func(string, *srvpb.FunctionParameters)) {
+	var n *srvpb.FunctionParameters_Node
+	if !nodeStream(&n) {
+		n = &srvpb.FunctionParameters_Node{Ticket: kytheuri.ToString(src)}
+	}
+	var ref exploreParamRef
+	for refStream(&ref) {
+		params := &srvpb.FunctionParameters{}
+		if ref.Return {
+			params.ReturnValue = n
+		} else {
+			params.Parameter = []*srvpb.FunctionParameters_Parameter{{Ordinal: ref.Ordinal, Node: n}}
+		}
+		emit(string(esrv.ParametersKey(ref.Function)), params)
+	}
+}
+
+// mergeFunctionParameters merges the partial *srvpb.FunctionParameters of a
+// function, ordering its parameters by ordinal.
+func mergeFunctionParameters(key string, stream func(**srvpb.FunctionParameters) bool) (string, *srvpb.FunctionParameters) {
+	merged := &srvpb.FunctionParameters{}
+	var p *srvpb.FunctionParameters
+	for stream(&p) {
+		merged.Parameter = append(merged.Parameter, p.Parameter...)
+		if p.ReturnValue != nil {
+			merged.ReturnValue = p.ReturnValue
+		}
+	}
+	sort.SliceStable(merged.Parameter, func(i, j int) bool {
+		return merged.Parameter[i].Ordinal < merged.Parameter[j].Ordinal
+	})
+	return key, merged
+}
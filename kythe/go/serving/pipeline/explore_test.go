@@ -0,0 +1,331 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+
+	esrv "kythe.io/kythe/go/serving/explore"
+	"kythe.io/kythe/go/serving/pipeline/beamtest"
+	"kythe.io/kythe/go/storage/inmemory"
+	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/compare"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	"github.com/apache/beam/sdks/go/pkg/beam"
+	"github.com/apache/beam/sdks/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/go/pkg/beam/testing/ptest"
+	"github.com/apache/beam/sdks/go/pkg/beam/x/debug"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	epb "kythe.io/kythe/proto/explore_go_proto"
+	scpb "kythe.io/kythe/proto/schema_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// runExplore runs the serving pipeline over entries and returns the explore
+// tables it wrote.
+func runExplore(t *testing.T, entries []*spb.Entry) *esrv.Tables {
+	t.Helper()
+	db := inmemory.NewKeyValueDB()
+	if err := Run(context.Background(), entryReader(sortEntries(entries)), db, nil); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	return esrv.NewCombinedTables(&table.KVProto{DB: db})
+}
+
+// graphEdges returns the edges of g as sorted "from -> to" strings.
+func graphEdges(g *epb.Graph) []string {
+	var res []string
+	for ticket, n := range g.Nodes {
+		for _, succ := range n.Successors {
+			res = append(res, ticket+" -> "+succ)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func TestExploreTypeHierarchy(t *testing.T) {
+	vname := func(sig string) *spb.VName { return &spb.VName{Corpus: "corpus", Language: "go", Signature: sig} }
+	iface, impl, sub, other := vname("iface"), vname("impl"), vname("sub"), vname("other")
+	entries := []*spb.Entry{
+		testFact(iface, facts.NodeKind, nodes.Interface),
+		testFact(impl, facts.NodeKind, nodes.Record),
+		testFact(sub, facts.NodeKind, nodes.Record),
+		testFact(other, facts.NodeKind, nodes.Record),
+		testEdge(impl, edges.Satisfies, iface),
+		testEdge(sub, edges.ExtendsPublic, impl),
+		testEdge(sub, edges.Extends, impl),
+		testEdge(other, edges.Satisfies, iface),
+		testEdge(other, edges.ChildOf, iface), // not part of the hierarchy
+	}
+	tbl := runExplore(t, entries)
+	ticket := kytheuri.ToString
+
+	tests := []struct {
+		req  *epb.TypeHierarchyRequest
+		want []string
+	}{{
+		req: &epb.TypeHierarchyRequest{TypeTicket: ticket(iface), Direction: epb.TypeHierarchyRequest_SUBTYPES},
+		want: []string{
+			ticket(impl) + " -> " + ticket(iface),
+			ticket(other) + " -> " + ticket(iface),
+			ticket(sub) + " -> " + ticket(impl),
+		},
+	}, {
+		req: &epb.TypeHierarchyRequest{TypeTicket: ticket(sub), Direction: epb.TypeHierarchyRequest_SUPERTYPES},
+		want: []string{
+			ticket(impl) + " -> " + ticket(iface),
+			ticket(sub) + " -> " + ticket(impl),
+		},
+	}, {
+		req: &epb.TypeHierarchyRequest{TypeTicket: ticket(impl), MaxDepth: 1},
+		want: []string{
+			ticket(impl) + " -> " + ticket(iface),
+			ticket(sub) + " -> " + ticket(impl),
+		},
+	}}
+	for _, test := range tests {
+		reply, err := tbl.TypeHierarchy(context.Background(), test.req)
+		if err != nil {
+			t.Fatalf("TypeHierarchy(%v) error: %v", test.req, err)
+		}
+		if diff := cmp.Diff(test.want, graphEdges(reply.Graph)); diff != "" {
+			t.Errorf("TypeHierarchy(%v) edges: (- expected; + found)\n%s", test.req, diff)
+		}
+	}
+}
+
+func TestExploreParameters(t *testing.T) {
+	vname := func(sig string) *spb.VName { return &spb.VName{Corpus: "corpus", Language: "go", Signature: sig} }
+	fn, noParams, fnType, builtin := vname("fn"), vname("noparams"), vname("fntype"), vname(fnBuiltin)
+	x, y, ret := vname("x"), vname("y"), vname("int#builtin")
+	xCode := &cpb.MarkedSource{Kind: cpb.MarkedSource_IDENTIFIER, PreText: "x"}
+	rec, err := proto.Marshal(xCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	param := func(src *spb.VName, i int, tgt *spb.VName) *spb.Entry {
+		return testEdge(src, edges.ParamIndex(i), tgt)
+	}
+	entries := []*spb.Entry{
+		testFact(fn, facts.NodeKind, nodes.Function),
+		testFact(noParams, facts.NodeKind, nodes.Function),
+		testFact(fnType, facts.NodeKind, nodes.TApp),
+		testFact(builtin, facts.NodeKind, nodes.TBuiltin),
+		testFact(x, facts.NodeKind, nodes.Variable),
+		testFact(x, facts.Code, string(rec)),
+		testFact(y, facts.NodeKind, nodes.Variable),
+		testFact(y, facts.Subkind, "local/parameter"),
+		testFact(ret, facts.NodeKind, nodes.TBuiltin),
+		// Parameters are emitted out of order to check that they are sorted.
+		param(fn, 1, y),
+		param(fn, 0, x),
+		testEdge(fn, edges.Typed, fnType),
+		testEdge(noParams, edges.Typed, fnType),
+		param(fnType, 0, builtin),
+		param(fnType, 1, ret),
+	}
+	tbl := runExplore(t, entries)
+	ticket := kytheuri.ToString
+
+	reply, err := tbl.Parameters(context.Background(), &epb.ParametersRequest{
+		FunctionTickets: []string{ticket(fn), ticket(noParams), ticket(fnType)},
+	})
+	if err != nil {
+		t.Fatalf("Parameters error: %v", err)
+	}
+	expected := &epb.ParametersReply{
+		FunctionToParameters: map[string]*epb.Tickets{
+			ticket(fn):       {Tickets: []string{ticket(x), ticket(y)}},
+			ticket(noParams): {},
+		},
+		FunctionToReturnValue: map[string]string{
+			ticket(fn):       ticket(ret),
+			ticket(noParams): ticket(ret),
+		},
+		NodeData: map[string]*epb.NodeData{
+			ticket(x):   {Kind: nodes.Variable, Code: xCode},
+			ticket(y):   {Kind: nodes.Variable, Subkind: "local/parameter"},
+			ticket(ret): {Kind: nodes.TBuiltin},
+		},
+	}
+	if diff := compare.ProtoDiff(expected, reply); diff != "" {
+		t.Errorf("Unexpected Parameters reply: (- expected; + found)\n%s", diff)
+	}
+}
+
+func TestExploreRelativesAndCallgraph(t *testing.T) {
+	vname := func(sig string) *spb.VName { return &spb.VName{Corpus: "corpus", Language: "go", Signature: sig} }
+	file := &spb.VName{Corpus: "corpus", Path: "a.go"}
+	anchor := func(start int) *spb.VName {
+		return &spb.VName{Corpus: "corpus", Path: "a.go", Language: "go", Signature: "@" + strconv.Itoa(start)}
+	}
+	main, helper, leaf, local := vname("main"), vname("helper"), vname("leaf"), vname("local")
+	call1, call2, call3, def := anchor(10), anchor(20), anchor(30), anchor(40)
+
+	entries := []*spb.Entry{
+		testFact(file, facts.NodeKind, nodes.File),
+		testFact(file, facts.Text, "package a // ..."),
+		testFact(main, facts.NodeKind, nodes.Function),
+		testFact(helper, facts.NodeKind, nodes.Function),
+		testFact(leaf, facts.NodeKind, nodes.Function),
+		testFact(local, facts.NodeKind, nodes.Variable),
+		testEdge(local, edges.ChildOf, main),
+		testEdge(def, edges.DefinesBinding, main),
+		testEdge(def, edges.ChildOf, file), // not a caller
+		testEdge(call1, edges.RefCall, helper),
+		testEdge(call1, edges.ChildOf, main),
+		testEdge(call2, edges.RefCall, leaf),
+		testEdge(call2, edges.ChildOf, main),
+		testEdge(call3, edges.RefCall, leaf),
+		testEdge(call3, edges.ChildOf, helper),
+	}
+	for _, a := range []*spb.VName{call1, call2, call3, def} {
+		entries = append(entries, testAnchor(a, 0, 1)...)
+	}
+	tbl := runExplore(t, entries)
+	ctx := context.Background()
+	ticket := kytheuri.ToString
+
+	parents, err := tbl.Parents(ctx, &epb.ParentsRequest{Tickets: []string{ticket(local), ticket(call1)}})
+	if err != nil {
+		t.Fatalf("Parents error: %v", err)
+	}
+	if diff := compare.ProtoDiff(&epb.ParentsReply{
+		InputToParents: map[string]*epb.Tickets{ticket(local): {Tickets: []string{ticket(main)}}},
+	}, parents); diff != "" {
+		t.Errorf("Unexpected Parents reply: (- expected; + found)\n%s", diff)
+	}
+
+	children, err := tbl.Children(ctx, &epb.ChildrenRequest{Tickets: []string{ticket(main), ticket(file)}})
+	if err != nil {
+		t.Fatalf("Children error: %v", err)
+	}
+	if diff := compare.ProtoDiff(&epb.ChildrenReply{
+		InputToChildren: map[string]*epb.Tickets{ticket(main): {Tickets: []string{ticket(local)}}},
+	}, children); diff != "" {
+		t.Errorf("Unexpected Children reply: (- expected; + found)\n%s", diff)
+	}
+
+	callers, err := tbl.Callers(ctx, &epb.CallersRequest{Tickets: []string{ticket(leaf)}})
+	if err != nil {
+		t.Fatalf("Callers error: %v", err)
+	}
+	if diff := cmp.Diff([]string{
+		ticket(helper) + " -> " + ticket(leaf),
+		ticket(main) + " -> " + ticket(leaf),
+	}, graphEdges(callers.Graph)); diff != "" {
+		t.Errorf("Callers edges: (- expected; + found)\n%s", diff)
+	}
+
+	callees, err := tbl.Callees(ctx, &epb.CalleesRequest{Tickets: []string{ticket(main), ticket(leaf)}})
+	if err != nil {
+		t.Fatalf("Callees error: %v", err)
+	}
+	if diff := cmp.Diff([]string{
+		ticket(main) + " -> " + ticket(helper),
+		ticket(main) + " -> " + ticket(leaf),
+	}, graphEdges(callees.Graph)); diff != "" {
+		t.Errorf("Callees edges: (- expected; + found)\n%s", diff)
+	}
+}
+
+func TestExplore(t *testing.T) {
+	edge := func(kind scpb.EdgeKind, ordinal int32, target string) *scpb.Edge {
+		return &scpb.Edge{Kind: &scpb.Edge_KytheKind{kind}, Ordinal: ordinal, Target: &spb.VName{Signature: target}}
+	}
+	node := func(sig string, kind scpb.NodeKind, edges ...*scpb.Edge) *scpb.Node {
+		return &scpb.Node{Source: &spb.VName{Signature: sig}, Kind: &scpb.Node_KytheKind{kind}, Edge: edges}
+	}
+	file := &spb.VName{Path: "a.go"}
+	testNodes := []*scpb.Node{
+		node("main", scpb.NodeKind_FUNCTION),
+		node("helper", scpb.NodeKind_FUNCTION),
+		node("local", scpb.NodeKind_VARIABLE, edge(scpb.EdgeKind_CHILD_OF, 0, "main")),
+		{
+			Source: &spb.VName{Path: "a.go", Signature: "@call"},
+			Kind:   &scpb.Node_KytheKind{scpb.NodeKind_ANCHOR},
+			Edge: []*scpb.Edge{
+				edge(scpb.EdgeKind_CHILD_OF, 0, "main"),
+				{Kind: &scpb.Edge_KytheKind{scpb.EdgeKind_CHILD_OF}, Target: file}, // not a caller
+				edge(scpb.EdgeKind_REF_CALL, 0, "helper"),
+			},
+		},
+		node("iface", scpb.NodeKind_INTERFACE),
+		node("impl", scpb.NodeKind_RECORD, edge(scpb.EdgeKind_SATISFIES, 0, "iface")),
+		node("fn", scpb.NodeKind_FUNCTION,
+			edge(scpb.EdgeKind_PARAM, 1, "y"),
+			edge(scpb.EdgeKind_PARAM, 0, "x"),
+			edge(scpb.EdgeKind_TYPED, 0, "fntype")),
+		node("x", scpb.NodeKind_VARIABLE),
+		node("fntype", scpb.NodeKind_TAPP,
+			edge(scpb.EdgeKind_PARAM, 0, fnBuiltin),
+			edge(scpb.EdgeKind_PARAM, 1, "int#builtin")),
+		node("int#builtin", scpb.NodeKind_TBUILTIN),
+	}
+
+	p, s, coll := ptest.CreateList(testNodes)
+	relatives, callgraphs, typeRelatives, parameters := FromNodes(s, coll).Explore()
+	debug.Print(s, parameters)
+	passert.Equals(s, beam.DropKey(s, relatives), beam.CreateList(s, []*srvpb.Relatives{
+		{Tickets: []string{"kythe:#main"}, Type: srvpb.Relatives_PARENTS},
+		{Tickets: []string{"kythe:#local"}, Type: srvpb.Relatives_CHILDREN},
+	}))
+	passert.Equals(s, beam.DropKey(s, callgraphs), beam.CreateList(s, []*srvpb.Callgraph{
+		{Tickets: []string{"kythe:#main"}, Type: srvpb.Callgraph_CALLER},
+		{Tickets: []string{"kythe:#helper"}, Type: srvpb.Callgraph_CALLEE},
+	}))
+	passert.Equals(s, beam.DropKey(s, typeRelatives), beam.CreateList(s, []*srvpb.TypeRelatives{
+		{Tickets: []string{"kythe:#iface"}, Type: srvpb.TypeRelatives_SUPERTYPES},
+		{Tickets: []string{"kythe:#impl"}, Type: srvpb.TypeRelatives_SUBTYPES},
+	}))
+	passert.Equals(s, beam.DropKey(s, parameters), beam.CreateList(s, []*srvpb.FunctionParameters{{
+		Parameter: []*srvpb.FunctionParameters_Parameter{{
+			Ordinal: 0,
+			Node:    &srvpb.FunctionParameters_Node{Ticket: "kythe:#x", Kind: nodes.Variable},
+		}, {
+			Ordinal: 1,
+			Node:    &srvpb.FunctionParameters_Node{Ticket: "kythe:#y"},
+		}},
+		ReturnValue: &srvpb.FunctionParameters_Node{
+			Ticket: kytheuri.ToString(&spb.VName{Signature: "int#builtin"}),
+			Kind:   nodes.TBuiltin,
+		},
+	}}))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestExplore_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).Explore()
+	beamtest.CheckRegistrations(t, p)
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"reflect"
+	"sort"
+
+	"kythe.io/kythe/go/serving/identifiers"
+	"kythe.io/kythe/go/serving/pipeline/nodes"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/markedsource"
+	"kythe.io/kythe/go/util/schema"
+	"kythe.io/kythe/go/util/schema/facts"
+
+	"github.com/apache/beam/sdks/go/pkg/beam"
+	"google.golang.org/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	scpb "kythe.io/kythe/proto/schema_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+)
+
+func init() {
+	beam.RegisterFunction(combineIdentifierMatches)
+	beam.RegisterFunction(identifierTrigrams)
+	beam.RegisterFunction(keyIdentifierMatch)
+	beam.RegisterFunction(nodeToIdentifierMatch)
+	beam.RegisterFunction(toIdentifierPostings)
+
+	beam.RegisterType(reflect.TypeOf((*srvpb.IdentifierMatch)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*srvpb.IdentifierPostings)(nil)).Elem())
+}
+
+// Identifiers returns a Kythe identifier table derived from the Kythe input
+// graph, along with its trigram index (see IdentifierIndex).  Each node with a
+// MarkedSource is matched by the qualified and base names rendered from it.
+// The returned beam.PCollections have elements of type KV<string,
+// *srvpb.IdentifierMatch> and KV<string, *srvpb.IdentifierPostings>,
+// respectively.
+func (k *KytheBeam) Identifiers() (matches, index beam.PCollection) {
+	s := k.s.Scope("Identifiers")
+	ms := beam.Seq(s, k.nodes, &nodes.Filter{
+		IncludeFacts: []string{facts.Code},
+		IncludeEdges: []string{},
+	}, nodeToIdentifierMatch)
+	combined := beam.ParDo(s, combineIdentifierMatches, beam.GroupByKey(s, ms))
+	return beam.ParDo(s, keyIdentifierMatch, combined), IdentifierIndex(s, combined)
+}
+
+// nodeToIdentifierMatch emits the single-node IdentifierMatch for the
+// /kythe/code fact of each *scpb.Node, keyed by its qualified name.
+func nodeToIdentifierMatch(n *scpb.Node, emit func(string, *srvpb.IdentifierMatch)) error {
+	for _, f := range n.Fact {
+		if f.GetKytheName() == scpb.FactName_CODE {
+			var ms cpb.MarkedSource
+			if err := proto.Unmarshal(f.Value, &ms); err != nil {
+				return err
+			}
+			if m := identifierMatch(kytheuri.ToString(n.Source), schema.GetNodeKind(n), schema.GetSubkind(n), &ms); m != nil {
+				emit(m.QualifiedName, m)
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func combineIdentifierMatches(qname string, matchStream func(**srvpb.IdentifierMatch) bool) *srvpb.IdentifierMatch {
+	var matches []*srvpb.IdentifierMatch
+	var m *srvpb.IdentifierMatch
+	for matchStream(&m) {
+		matches = append(matches, m)
+	}
+	return mergeIdentifierMatches(matches)
+}
+
+func keyIdentifierMatch(m *srvpb.IdentifierMatch) (string, *srvpb.IdentifierMatch) {
+	return m.QualifiedName, m
+}
+
+// identifierMatch returns the IdentifierMatch of the node with the given
+// ticket, kind, subkind, and MarkedSource.  A name without a qualifier is its
+// own qualified name.  Returns nil if the MarkedSource names no identifier.
+func identifierMatch(ticket, kind, subkind string, ms *cpb.MarkedSource) *srvpb.IdentifierMatch {
+	info := markedsource.RenderQualifiedName(ms)
+	if info.GetBaseName() == "" {
+		return nil
+	}
+	qname := info.GetQualifiedName()
+	if qname == "" {
+		qname = info.GetBaseName()
+	}
+	return &srvpb.IdentifierMatch{
+		QualifiedName: qname,
+		BaseName:      info.GetBaseName(),
+		Node: []*srvpb.IdentifierMatch_Node{{
+			Ticket:      ticket,
+			NodeKind:    kind,
+			NodeSubkind: subkind,
+		}},
+	}
+}
+
+// mergeIdentifierMatches combines the non-empty set of IdentifierMatches
+// sharing a qualified name into a single match with its nodes sorted by
+// ticket.  Nodes whose MarkedSource renders a different base name for the
+// same qualified name are rare; the least such base name is kept.
+func mergeIdentifierMatches(matches []*srvpb.IdentifierMatch) *srvpb.IdentifierMatch {
+	merged := &srvpb.IdentifierMatch{
+		QualifiedName: matches[0].GetQualifiedName(),
+		BaseName:      matches[0].GetBaseName(),
+	}
+	for _, m := range matches {
+		if m.GetBaseName() < merged.BaseName {
+			merged.BaseName = m.GetBaseName()
+		}
+		merged.Node = append(merged.Node, m.GetNode()...)
+	}
+	sort.Slice(merged.Node, func(i, j int) bool {
+		return merged.Node[i].GetTicket() < merged.Node[j].GetTicket()
+	})
+	return merged
+}
+
+// IdentifierIndex returns the trigram secondary index used by the identifiers
+// service for prefix, substring, case-insensitive, and fuzzy matching of the
+// given PCollection<*srvpb.IdentifierMatch>.  The returned PCollection is of
+// type KV<string, *srvpb.IdentifierPostings>.
+func IdentifierIndex(s beam.Scope, matches beam.PCollection) beam.PCollection {
+	s = s.Scope("IdentifierIndex")
+	return beam.ParDo(s, toIdentifierPostings, beam.GroupByKey(s, beam.ParDo(s, identifierTrigrams, matches)))
+}
+
+// identifierTrigrams emits a (trigram, qualified_name) pair for each trigram
+// under which the given match is indexed.
+func identifierTrigrams(m *srvpb.IdentifierMatch, emit func(string, string)) {
+	for _, tri := range identifiers.Trigrams(m) {
+		emit(tri, m.GetQualifiedName())
+	}
+}
+
+func toIdentifierPostings(trigram string, qnameStream func(*string) bool, emit func(string, *srvpb.IdentifierPostings)) {
+	var qnames []string
+	var qname string
+	for qnameStream(&qname) {
+		qnames = append(qnames, qname)
+	}
+	for i, shard := range identifiers.Postings(qnames) {
+		emit(string(identifiers.TrigramShardKey(trigram, i)), shard)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"kythe.io/kythe/go/serving/identifiers"
+	"kythe.io/kythe/go/serving/pipeline/beamtest"
+	"kythe.io/kythe/go/storage/inmemory"
+	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	"github.com/apache/beam/sdks/go/pkg/beam"
+	"github.com/apache/beam/sdks/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/go/pkg/beam/testing/ptest"
+	"github.com/apache/beam/sdks/go/pkg/beam/x/debug"
+	"google.golang.org/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
+	scpb "kythe.io/kythe/proto/schema_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// qualifiedCode returns the encoded MarkedSource of an identifier named by
+// the given qualifier and base name.
+func qualifiedCode(t *testing.T, qualifier, name string) []byte {
+	t.Helper()
+	ms := &cpb.MarkedSource{
+		Kind: cpb.MarkedSource_BOX,
+		Child: []*cpb.MarkedSource{{
+			Kind:          cpb.MarkedSource_CONTEXT,
+			PostChildText: ".",
+			Child: []*cpb.MarkedSource{{
+				Kind:    cpb.MarkedSource_IDENTIFIER,
+				PreText: qualifier,
+			}},
+		}, {
+			Kind:    cpb.MarkedSource_IDENTIFIER,
+			PreText: name,
+		}},
+	}
+	rec, err := proto.Marshal(ms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rec
+}
+
+func TestIdentifiers(t *testing.T) {
+	codeNode := func(sig string, kind scpb.NodeKind, subkind, code []byte) *scpb.Node {
+		n := &scpb.Node{
+			Source: &spb.VName{Signature: sig},
+			Kind:   &scpb.Node_KytheKind{kind},
+			Fact: []*scpb.Fact{{
+				Name:  &scpb.Fact_KytheName{scpb.FactName_CODE},
+				Value: code,
+			}},
+		}
+		if subkind != nil {
+			n.Subkind = &scpb.Node_GenericSubkind{string(subkind)}
+		}
+		return n
+	}
+	testNodes := []*scpb.Node{
+		codeNode("fn", scpb.NodeKind_FUNCTION, nil, qualifiedCode(t, "pkg", "Foo")),
+		codeNode("rec", scpb.NodeKind_RECORD, []byte("struct"), qualifiedCode(t, "pkg", "Foo")),
+		codeNode("bar", scpb.NodeKind_FUNCTION, nil, qualifiedCode(t, "pkg", "Bar")),
+		{Source: &spb.VName{Signature: "nocode"}, Kind: &scpb.Node_KytheKind{scpb.NodeKind_FUNCTION}},
+	}
+	expected := []*srvpb.IdentifierMatch{{
+		QualifiedName: "pkg.Bar",
+		BaseName:      "Bar",
+		Node:          []*srvpb.IdentifierMatch_Node{{Ticket: "kythe:#bar", NodeKind: "function"}},
+	}, {
+		QualifiedName: "pkg.Foo",
+		BaseName:      "Foo",
+		Node: []*srvpb.IdentifierMatch_Node{
+			{Ticket: "kythe:#fn", NodeKind: "function"},
+			{Ticket: "kythe:#rec", NodeKind: "record", NodeSubkind: "struct"},
+		},
+	}}
+
+	p, s, coll := ptest.CreateList(testNodes)
+	matches, index := FromNodes(s, coll).Identifiers()
+	debug.Print(s, matches)
+	passert.Equals(s, beam.DropKey(s, matches), beam.CreateList(s, expected))
+	trigrams := make(map[string]bool)
+	for _, m := range expected {
+		for _, tri := range identifiers.Trigrams(m) {
+			trigrams[tri] = true
+		}
+	}
+	passert.Count(s, index, "trigrams", len(trigrams))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
+
+func TestIdentifiers_registrations(t *testing.T) {
+	testNodes := []*scpb.Node{{}}
+	p, s, nodes := ptest.CreateList(testNodes)
+	FromNodes(s, nodes).Identifiers()
+	beamtest.CheckRegistrations(t, p)
+}
+
+func TestRunIdentifiers(t *testing.T) {
+	fn := &spb.VName{Corpus: "corpus", Language: "go", Signature: "Foo"}
+	entries := []*spb.Entry{
+		testFact(fn, facts.Code, string(qualifiedCode(t, "pkg", "Foo"))),
+		testFact(fn, facts.NodeKind, nodes.Function),
+	}
+
+	ctx := context.Background()
+	db := inmemory.NewKeyValueDB()
+	if err := Run(ctx, entryReader(entries), db, nil); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	tbl := &identifiers.Table{&table.KVProto{DB: db}}
+	expected := &ipb.FindReply_Match{
+		Ticket:        "kythe://corpus?lang=go#Foo",
+		NodeKind:      nodes.Function,
+		BaseName:      "Foo",
+		QualifiedName: "pkg.Foo",
+	}
+	for _, req := range []*ipb.FindRequest{
+		{Identifier: "pkg.Foo"},
+		{Identifier: "pkg.f", MatchKind: ipb.FindRequest_PREFIX, CaseInsensitive: true},
+	} {
+		reply, err := tbl.Find(ctx, req)
+		if err != nil {
+			t.Fatalf("Find(%v) error: %v", req, err)
+		} else if len(reply.Matches) != 1 || !proto.Equal(reply.Matches[0], expected) {
+			t.Errorf("Find(%v): found %v; expected %v", req, reply.Matches, expected)
+		}
+	}
+}
+
+func TestIdentifierIndex(t *testing.T) {
+	matches := []*srvpb.IdentifierMatch{{
+		BaseName:      "Ab",
+		QualifiedName: "x.Ab",
+	}, {
+		BaseName:      "ab",
+		QualifiedName: "ab",
+	}}
+	postings := func(qnames ...string) *srvpb.IdentifierPostings {
+		return &srvpb.IdentifierPostings{QualifiedName: qnames}
+	}
+	expected := []*srvpb.IdentifierPostings{
+		postings("ab", "x.Ab"), // "  a"
+		postings("ab", "x.Ab"), // " ab"
+		postings("ab", "x.Ab"), // "ab "
+		postings("x.Ab"),       // "  x"
+		postings("x.Ab"),       // " x."
+		postings("x.Ab"),       // "x.a"
+		postings("x.Ab"),       // ".ab"
+	}
+
+	p, s, coll := ptest.CreateList(matches)
+	index := IdentifierIndex(s, coll)
+	debug.Print(s, index)
+	passert.Equals(s, beam.DropKey(s, index), beam.CreateList(s, expected))
+
+	if err := ptest.Run(p); err != nil {
+		t.Fatalf("Pipeline error: %+v", err)
+	}
+}
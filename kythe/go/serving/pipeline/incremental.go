@@ -0,0 +1,1097 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"kythe.io/kythe/go/services/filetree"
+	"kythe.io/kythe/go/services/graphstore"
+	esrv "kythe.io/kythe/go/serving/explore"
+	ftsrv "kythe.io/kythe/go/serving/filetree"
+	gsrv "kythe.io/kythe/go/serving/graph"
+	"kythe.io/kythe/go/serving/identifiers"
+	xsrv "kythe.io/kythe/go/serving/xrefs"
+	"kythe.io/kythe/go/serving/xrefs/assemble"
+	"kythe.io/kythe/go/storage/inmemory"
+	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/storage/stream"
+	"kythe.io/kythe/go/storage/table"
+	"kythe.io/kythe/go/util/compare"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+	"kythe.io/kythe/go/util/schema/tickets"
+	"kythe.io/kythe/go/util/span"
+
+	"bitbucket.org/creachadair/stringset"
+	"google.golang.org/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	srvpb "kythe.io/kythe/proto/serving_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// Keys of the per-compilation unit index written alongside the serving tables
+// by Update.  Within each key, tickets and unit identifiers are separated by
+// incrementalKeySep.
+const (
+	incrementalVersionKey = "incr:version"
+	incrementalVersion    = "v1"
+
+	// incr:unit:<unit> <source> -> ""
+	unitSourcesPrefix = "incr:unit:"
+	// incr:src:<source> <unit> -> spb.Entries
+	sourceEntriesPrefix = "incr:src:"
+	// incr:ref:<ticket> <unit> <source> -> ""
+	referencesPrefix = "incr:ref:"
+	// incr:file:<ticket> <unit> -> ""
+	filesPrefix = "incr:file:"
+
+	incrementalKeySep = "\000"
+)
+
+// A UnitUpdate replaces the entries emitted for a single compilation unit.
+type UnitUpdate struct {
+	// Unit is a stable identifier for the compilation unit, such as its digest.
+	Unit string
+
+	// Entries reads the complete set of entries emitted for the unit, in any
+	// order.  If nil, the unit has been removed and its entries are dropped.
+	Entries stream.EntryReader
+}
+
+// Update incrementally updates the serving tables in db to reflect the given
+// re-indexed (or removed) compilation units.  Only the serving data of the
+// nodes touched by the units' changed entries, and of the nodes whose serving
+// data embeds their changed facts, is rebuilt.  The file tree is rewritten to
+// include only the files still emitted by some unit.
+//
+// To determine which data is affected, Update maintains an index of each
+// unit's entries in db, which is written in the same batch as the rebuilt
+// serving data.  The serving tables must therefore be created by Update
+// (starting from an empty db) rather than Run; an error is returned if db
+// contains a table without such an index.
+func Update(ctx context.Context, db keyvalue.DB, updates []*UnitUpdate, opts *Options) error {
+	if opts == nil {
+		opts = new(Options)
+	}
+	plan, err := planUpdate(ctx, db, updates)
+	if err != nil {
+		return err
+	}
+	log.Printf("Rebuilding serving data for %d nodes from %d entries", len(plan.affected), len(plan.entries))
+
+	tmp := inmemory.NewKeyValueDB()
+	if err := Run(ctx, func(f func(*spb.Entry) error) error {
+		for _, e := range plan.entries {
+			if err := f(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, tmp, opts); err != nil {
+		return fmt.Errorf("error rebuilding serving data: %v", err)
+	}
+	if err := replaceServingData(ctx, db, tmp, plan.affected.Elements(), plan.idx.writes); err != nil {
+		return fmt.Errorf("error replacing serving data: %v", err)
+	}
+
+	if err := updateIdentifiers(ctx, plan.idx, plan.factsChanged); err != nil {
+		return fmt.Errorf("error updating identifiers: %v", err)
+	}
+
+	if plan.filesChanged {
+		log.Println("Rewriting file tree")
+		if err := updateFileTree(ctx, db, plan.idx); err != nil {
+			return fmt.Errorf("error updating file tree: %v", err)
+		}
+	}
+	return applyWrites(ctx, db, plan.idx.writes)
+}
+
+// An updatePlan describes the serving data rebuilt by an Update.
+type updatePlan struct {
+	idx          *incrementalIndex // with the updated units' entries staged
+	affected     stringset.Set     // nodes whose serving data is rebuilt
+	entries      []*spb.Entry      // entries from which it is rebuilt
+	factsChanged stringset.Set     // nodes whose node facts changed
+	filesChanged bool              // whether the file tree is rewritten
+}
+
+// planUpdate stages the given updates to the incremental index of db and
+// returns the serving data to rebuild as a result.
+func planUpdate(ctx context.Context, db keyvalue.DB, updates []*UnitUpdate) (*updatePlan, error) {
+	idx, err := openIndex(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	plan := &updatePlan{idx: idx, affected: stringset.New(), factsChanged: stringset.New()}
+
+	changed := stringset.New()
+	for _, u := range updates {
+		if u.Unit == "" || strings.Contains(u.Unit, incrementalKeySep) {
+			return nil, fmt.Errorf("invalid compilation unit identifier: %q", u.Unit)
+		}
+		old, err := idx.readUnitEntries(ctx, u.Unit)
+		if err != nil {
+			return nil, fmt.Errorf("error reading entries for unit %q: %v", u.Unit, err)
+		}
+		updated := make(unitEntries)
+		if u.Entries != nil {
+			if err := u.Entries(func(e *spb.Entry) error {
+				updated.add(e)
+				return nil
+			}); err != nil {
+				return nil, fmt.Errorf("error reading entries for unit %q: %v", u.Unit, err)
+			}
+		}
+		updated.sort()
+
+		// The serving data of a changed source, of its edges' targets, and
+		// of its anchor's file may differ.
+		srcs := old.changed(updated)
+		changed.Update(srcs)
+		for src := range srcs {
+			plan.affected.Add(src)
+			for _, d := range []unitEntries{old, updated} {
+				plan.affected.Update(entryTargets(d[src]))
+				if file, ok := anchorFile(src, d[src]); ok {
+					plan.affected.Add(file)
+				}
+			}
+		}
+		plan.factsChanged.Update(old.factsChanged(updated))
+		if !old.files().Equals(updated.files()) {
+			plan.filesChanged = true
+		}
+
+		if err := idx.writeUnitEntries(u.Unit, old, updated); err != nil {
+			return nil, fmt.Errorf("error writing entries for unit %q: %v", u.Unit, err)
+		}
+	}
+
+	ld := &entryLoader{idx: idx, sources: make(map[string][]*spb.Entry)}
+
+	// Nodes whose embedded facts changed are also embedded in the serving data
+	// of their neighbors and of the files referencing them.
+	for _, ticket := range plan.factsChanged.Elements() {
+		neighbors, err := ld.neighbors(ctx, ticket)
+		if err != nil {
+			return nil, err
+		}
+		plan.affected.Update(neighbors)
+	}
+
+	// The parameters of a function embed the return type of its function type.
+	for _, ticket := range changed.Elements() {
+		funcs, err := ld.typedReferrers(ctx, ticket)
+		if err != nil {
+			return nil, err
+		}
+		plan.affected.Update(funcs)
+	}
+
+	plan.entries, err = ld.entriesFor(ctx, plan.affected)
+	return plan, err
+}
+
+// incrementalIndex is the incremental index of a serving table along with the
+// writes staged to the table by Update.  Reads of the index observe the staged
+// writes.
+type incrementalIndex struct {
+	db     keyvalue.DB
+	writes map[string][]byte // nil values are deletions
+}
+
+// openIndex returns the incremental index of db.  An error is returned if db
+// is a non-empty serving table without an incremental index.  An empty db is
+// marked as incremental.
+func openIndex(ctx context.Context, db keyvalue.DB) (*incrementalIndex, error) {
+	idx := &incrementalIndex{db: db, writes: make(map[string][]byte)}
+	if v, err := db.Get(ctx, []byte(incrementalVersionKey), nil); err == nil {
+		if string(v) != incrementalVersion {
+			return nil, fmt.Errorf("unsupported incremental serving table version: %q", v)
+		}
+		return idx, nil
+	} else if err != io.EOF {
+		return nil, err
+	}
+
+	keys, err := scanKeys(ctx, db, "", 1)
+	if err != nil {
+		return nil, err
+	} else if len(keys) > 0 {
+		return nil, errors.New("serving table was not built incrementally; rebuild it from an empty table with pipeline.Update")
+	}
+	idx.writes[incrementalVersionKey] = []byte(incrementalVersion)
+	return idx, nil
+}
+
+// get returns the value of the given key, or io.EOF if it is not found.
+func (x *incrementalIndex) get(ctx context.Context, key []byte) ([]byte, error) {
+	if v, ok := x.writes[string(key)]; ok {
+		if v == nil {
+			return nil, io.EOF
+		}
+		return v, nil
+	}
+	return x.db.Get(ctx, key, nil)
+}
+
+// scan calls f, in key order, with the suffix and value of each key with the
+// given prefix.
+func (x *incrementalIndex) scan(ctx context.Context, prefix string, f func(key string, val []byte) error) error {
+	vals, err := scanValues(ctx, x.db, prefix)
+	if err != nil {
+		return err
+	}
+	for k, v := range x.writes {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		} else if v == nil {
+			delete(vals, k)
+		} else {
+			vals[k] = v
+		}
+	}
+	keys := make([]string, 0, len(vals))
+	for k := range vals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := f(strings.TrimPrefix(k, prefix), vals[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanKeys returns the suffixes of the keys with the given prefix.
+func (x *incrementalIndex) scanKeys(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	return keys, x.scan(ctx, prefix, func(k string, _ []byte) error {
+		keys = append(keys, k)
+		return nil
+	})
+}
+
+// unitEntries is the set of a compilation unit's node facts and forward edges,
+// keyed by their source ticket.
+type unitEntries map[string][]*spb.Entry
+
+func (u unitEntries) add(e *spb.Entry) {
+	if graphstore.IsNodeFact(e) || edges.IsForward(e.EdgeKind) {
+		src := kytheuri.ToString(e.Source)
+		u[src] = append(u[src], e)
+	}
+}
+
+// sort orders and deduplicates the entries of each source.
+func (u unitEntries) sort() {
+	for src, es := range u {
+		u[src] = sortEntries(es)
+	}
+}
+
+// references returns the sources that refer to each ticket in u, either by an
+// edge to the ticket or, for anchors, by residing in the ticket's file.
+func (u unitEntries) references() map[string]stringset.Set {
+	refs := make(map[string]stringset.Set)
+	add := func(ticket, src string) {
+		srcs, ok := refs[ticket]
+		if !ok {
+			srcs = stringset.New()
+			refs[ticket] = srcs
+		}
+		srcs.Add(src)
+	}
+	for src, es := range u {
+		for _, e := range es {
+			if e.EdgeKind != "" {
+				add(kytheuri.ToString(e.Target), src)
+			}
+		}
+		if file, ok := anchorFile(src, es); ok {
+			add(file, src)
+		}
+	}
+	return refs
+}
+
+// files returns the tickets of the file nodes in u.
+func (u unitEntries) files() stringset.Set {
+	files := stringset.New()
+	for src, es := range u {
+		if nodeKind(es) == nodes.File {
+			files.Add(src)
+		}
+	}
+	return files
+}
+
+// changed returns the sources whose entries differ between u and v.  Anchors
+// in a file whose text differs are also changed if their text, snippet, or
+// position within the file differs, since each is embedded in the serving
+// data of the anchor's targets.
+func (u unitEntries) changed(v unitEntries) stringset.Set {
+	changed := stringset.New()
+	for _, d := range []unitEntries{u, v} {
+		for src := range d {
+			if !changed.Contains(src) && !entriesEqual(u[src], v[src]) {
+				changed.Add(src)
+			}
+		}
+	}
+	for src, es := range u {
+		file, ok := anchorFile(src, es)
+		if !ok || changed.Contains(src) || !changed.Contains(file) {
+			continue
+		}
+		if !proto.Equal(expandAnchor(src, es, u[file]), expandAnchor(src, es, v[file])) {
+			changed.Add(src)
+		}
+	}
+	return changed
+}
+
+// factsChanged returns the sources whose embedded node facts differ between u
+// and v.  File text is not embedded in the serving data of other nodes.
+func (u unitEntries) factsChanged(v unitEntries) stringset.Set {
+	changed := stringset.New()
+	for _, d := range []unitEntries{u, v} {
+		for src := range d {
+			if !changed.Contains(src) && !entriesEqual(embeddedFacts(u[src]), embeddedFacts(v[src])) {
+				changed.Add(src)
+			}
+		}
+	}
+	return changed
+}
+
+// readUnitEntries returns the entries previously written for the given unit.
+func (x *incrementalIndex) readUnitEntries(ctx context.Context, unit string) (unitEntries, error) {
+	sources, err := x.scanKeys(ctx, unitSourcesPrefix+unit+incrementalKeySep)
+	if err != nil {
+		return nil, err
+	}
+	u := make(unitEntries)
+	for _, src := range sources {
+		val, err := x.get(ctx, sourceEntriesKey(src, unit))
+		if err != nil {
+			return nil, fmt.Errorf("error reading entries for %q: %v", src, err)
+		}
+		var es spb.Entries
+		if err := proto.Unmarshal(val, &es); err != nil {
+			return nil, fmt.Errorf("error unmarshaling entries for %q: %v", src, err)
+		}
+		u[src] = es.Entries
+	}
+	return u, nil
+}
+
+// writeUnitEntries stages the replacement of the index of a unit's old entries
+// with its updated entries.
+func (x *incrementalIndex) writeUnitEntries(unit string, old, updated unitEntries) error {
+	writes := x.writes
+	for src := range old {
+		writes[string(unitSourceKey(unit, src))] = nil
+		writes[string(sourceEntriesKey(src, unit))] = nil
+	}
+	for ticket, srcs := range old.references() {
+		for src := range srcs {
+			writes[string(referenceKey(ticket, unit, src))] = nil
+		}
+	}
+	for file := range old.files() {
+		writes[string(fileKey(file, unit))] = nil
+	}
+
+	for src, es := range updated {
+		rec, err := proto.Marshal(&spb.Entries{Entries: es})
+		if err != nil {
+			return err
+		}
+		writes[string(unitSourceKey(unit, src))] = []byte{}
+		writes[string(sourceEntriesKey(src, unit))] = rec
+	}
+	for ticket, srcs := range updated.references() {
+		for src := range srcs {
+			writes[string(referenceKey(ticket, unit, src))] = []byte{}
+		}
+	}
+	for file := range updated.files() {
+		writes[string(fileKey(file, unit))] = []byte{}
+	}
+	return nil
+}
+
+// entryLoader reads the indexed entries of all units by their source.
+type entryLoader struct {
+	idx     *incrementalIndex
+	sources map[string][]*spb.Entry
+}
+
+// source returns the entries with the given source ticket across all units.
+func (l *entryLoader) source(ctx context.Context, ticket string) ([]*spb.Entry, error) {
+	if es, ok := l.sources[ticket]; ok {
+		return es, nil
+	}
+	var all []*spb.Entry
+	if err := l.idx.scan(ctx, sourceEntriesPrefix+ticket+incrementalKeySep, func(_ string, val []byte) error {
+		var es spb.Entries
+		if err := proto.Unmarshal(val, &es); err != nil {
+			return fmt.Errorf("error unmarshaling entries for %q: %v", ticket, err)
+		}
+		all = append(all, es.Entries...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	all = sortEntries(all)
+	l.sources[ticket] = all
+	return all, nil
+}
+
+// references returns the sources referring to the given ticket across all
+// units.
+func (l *entryLoader) references(ctx context.Context, ticket string) (stringset.Set, error) {
+	keys, err := l.idx.scanKeys(ctx, referencesPrefix+ticket+incrementalKeySep)
+	if err != nil {
+		return nil, err
+	}
+	srcs := stringset.New()
+	for _, k := range keys {
+		// k is <unit> <source>
+		if i := strings.Index(k, incrementalKeySep); i >= 0 {
+			srcs.Add(k[i+len(incrementalKeySep):])
+		}
+	}
+	return srcs, nil
+}
+
+// neighbors returns the nodes whose serving data may embed the facts of the
+// given node: the ends of its edges, the sources referring to it, and the
+// files of the anchors referring to it.
+func (l *entryLoader) neighbors(ctx context.Context, ticket string) (stringset.Set, error) {
+	es, err := l.source(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	neighbors := stringset.New()
+	neighbors.Update(entryTargets(es))
+
+	refs, err := l.references(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	for src := range refs {
+		neighbors.Add(src)
+		es, err := l.source(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		if file, ok := anchorFile(src, es); ok {
+			neighbors.Add(file)
+		}
+	}
+	return neighbors, nil
+}
+
+// identifierMatch returns the IdentifierMatch of the given node, or nil if it
+// has none.
+func (l *entryLoader) identifierMatch(ctx context.Context, ticket string) (*srvpb.IdentifierMatch, error) {
+	es, err := l.source(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	var kind, subkind string
+	var code []byte
+	for _, e := range nodeFacts(es) {
+		switch e.FactName {
+		case facts.NodeKind:
+			kind = string(e.FactValue)
+		case facts.Subkind:
+			subkind = string(e.FactValue)
+		case facts.Code:
+			code = e.FactValue
+		}
+	}
+	if code == nil {
+		return nil, nil
+	}
+	var ms cpb.MarkedSource
+	if err := proto.Unmarshal(code, &ms); err != nil {
+		return nil, fmt.Errorf("error unmarshaling code fact for %q: %v", ticket, err)
+	}
+	return identifierMatch(ticket, kind, subkind, &ms), nil
+}
+
+// typedReferrers returns the functions typed by the given node.
+func (l *entryLoader) typedReferrers(ctx context.Context, ticket string) (stringset.Set, error) {
+	refs, err := l.references(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	funcs := stringset.New()
+	for src := range refs {
+		es, err := l.source(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		if nodeKind(es) == nodes.Function && typedTargets(es).Contains(ticket) {
+			funcs.Add(src)
+		}
+	}
+	return funcs, nil
+}
+
+// entriesFor returns, in GraphStore order, the entries needed to rebuild the
+// serving data of the given nodes: the entries of each node, of the sources
+// referring to it, and of the types of those sources, along with the node
+// facts of their edges' targets and anchors' files.
+func (l *entryLoader) entriesFor(ctx context.Context, tickets stringset.Set) ([]*spb.Entry, error) {
+	sources := tickets.Clone()
+	for ticket := range tickets {
+		refs, err := l.references(ctx, ticket)
+		if err != nil {
+			return nil, err
+		}
+		sources.Update(refs)
+	}
+	var entries []*spb.Entry
+	targets := stringset.New()
+	for _, src := range sources.Elements() {
+		es, err := l.source(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, es...)
+		targets.Update(entryTargets(es))
+		if file, ok := anchorFile(src, es); ok {
+			targets.Add(file)
+		}
+	}
+	for _, target := range targets.Diff(sources).Elements() {
+		es, err := l.source(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, nodeFacts(es)...)
+	}
+	return sortEntries(entries), nil
+}
+
+// replaceServingData adds to writes the replacement of the serving data of
+// the given tickets in db with that found in tmp.
+func replaceServingData(ctx context.Context, db, tmp keyvalue.DB, tickets []string, writes map[string][]byte) error {
+	for _, ticket := range tickets {
+		oldKeys, err := servingKeys(ctx, db, ticket)
+		if err != nil {
+			return err
+		}
+		newKeys, err := servingKeys(ctx, tmp, ticket)
+		if err != nil {
+			return err
+		}
+		for _, k := range oldKeys.Union(newKeys).Elements() {
+			val, err := tmp.Get(ctx, []byte(k), nil)
+			if err == io.EOF {
+				if oldKeys.Contains(k) {
+					writes[k] = nil
+				}
+				continue
+			} else if err != nil {
+				return err
+			} else if val == nil {
+				val = []byte{}
+			}
+			if old, err := db.Get(ctx, []byte(k), nil); err == nil && bytes.Equal(old, val) {
+				continue
+			} else if err != nil && err != io.EOF {
+				return err
+			}
+			writes[k] = val
+		}
+	}
+	return nil
+}
+
+// servingKeys returns the keys of the serving data for the given ticket
+// present in db.
+func servingKeys(ctx context.Context, db keyvalue.DB, ticket string) (stringset.Set, error) {
+	keys := stringset.New()
+	for _, key := range [][]byte{
+		xsrv.DecorationsKey(ticket),
+		esrv.ParentsKey(ticket),
+		esrv.ChildrenKey(ticket),
+		esrv.CallersKey(ticket),
+		esrv.CalleesKey(ticket),
+		esrv.SupertypesKey(ticket),
+		esrv.SubtypesKey(ticket),
+		esrv.ParametersKey(ticket),
+	} {
+		if _, err := db.Get(ctx, key, nil); err == nil {
+			keys.Add(string(key))
+		} else if err != io.EOF {
+			return nil, err
+		}
+	}
+
+	var pes srvpb.PagedEdgeSet
+	if ok, err := lookup(ctx, db, gsrv.EdgeSetKey(ticket), &pes); err != nil {
+		return nil, err
+	} else if ok {
+		keys.Add(string(gsrv.EdgeSetKey(ticket)))
+		for _, idx := range pes.PageIndex {
+			keys.Add(string(gsrv.EdgePageKey(idx.PageKey)))
+		}
+	}
+
+	var xrefs srvpb.PagedCrossReferences
+	if ok, err := lookup(ctx, db, xsrv.CrossReferencesKey(ticket), &xrefs); err != nil {
+		return nil, err
+	} else if ok {
+		keys.Add(string(xsrv.CrossReferencesKey(ticket)))
+		for _, idx := range xrefs.PageIndex {
+			keys.Add(string(xsrv.CrossReferencesPageKey(idx.PageKey)))
+		}
+	}
+	return keys, nil
+}
+
+// updateIdentifiers adds to the index's writes the changes to the identifier
+// table for the given nodes.  The match of each qualified name that a node
+// gains or loses is rebuilt from the nodes it still names, and the trigram
+// postings of the name are adjusted to the trigrams of the rebuilt match.
+func updateIdentifiers(ctx context.Context, idx *incrementalIndex, tickets stringset.Set) error {
+	before := &entryLoader{idx: &incrementalIndex{db: idx.db}, sources: make(map[string][]*spb.Entry)}
+	after := &entryLoader{idx: idx, sources: make(map[string][]*spb.Entry)}
+
+	// Each qualified name whose match may change, along with the changed nodes
+	// it now names.
+	qnames := make(map[string]stringset.Set)
+	addName := func(m *srvpb.IdentifierMatch, ticket string) {
+		if m == nil {
+			return
+		}
+		named := qnames[m.QualifiedName]
+		named.Add(ticket)
+		qnames[m.QualifiedName] = named
+	}
+	for _, ticket := range tickets.Elements() {
+		old, err := before.identifierMatch(ctx, ticket)
+		if err != nil {
+			return err
+		}
+		updated, err := after.identifierMatch(ctx, ticket)
+		if err != nil {
+			return err
+		} else if proto.Equal(old, updated) {
+			continue
+		}
+		addName(old, ticket)
+		addName(updated, ticket)
+	}
+
+	postings := make(map[string]*identifierPostings) // :: trigram → postings
+	for _, qname := range stringset.FromKeys(qnames).Elements() {
+		var old srvpb.IdentifierMatch
+		if val, err := idx.get(ctx, []byte(qname)); err == nil {
+			if err := proto.Unmarshal(val, &old); err != nil {
+				return fmt.Errorf("error unmarshaling identifier match for %q: %v", qname, err)
+			}
+		} else if err != io.EOF {
+			return err
+		}
+
+		// Rebuild the match from the nodes it named and the changed nodes.
+		named := qnames[qname]
+		for _, n := range old.Node {
+			named.Add(n.Ticket)
+		}
+		var matches []*srvpb.IdentifierMatch
+		for _, ticket := range named.Elements() {
+			m, err := after.identifierMatch(ctx, ticket)
+			if err != nil {
+				return err
+			} else if m != nil && m.QualifiedName == qname {
+				matches = append(matches, m)
+			}
+		}
+
+		oldTrigrams := stringset.New()
+		if len(old.Node) > 0 {
+			oldTrigrams.Add(identifiers.Trigrams(&old)...)
+		}
+		newTrigrams := stringset.New()
+		if len(matches) == 0 {
+			idx.writes[qname] = nil
+		} else {
+			m := mergeIdentifierMatches(matches)
+			rec, err := proto.Marshal(m)
+			if err != nil {
+				return err
+			}
+			idx.writes[qname] = rec
+			newTrigrams.Add(identifiers.Trigrams(m)...)
+		}
+
+		for _, tri := range oldTrigrams.Union(newTrigrams).Elements() {
+			if oldTrigrams.Contains(tri) && newTrigrams.Contains(tri) {
+				continue
+			}
+			p, ok := postings[tri]
+			if !ok {
+				var err error
+				if p, err = readIdentifierPostings(ctx, idx, tri); err != nil {
+					return err
+				}
+				postings[tri] = p
+			}
+			if newTrigrams.Contains(tri) {
+				p.qnames.Add(qname)
+			} else {
+				p.qnames.Discard(qname)
+			}
+		}
+	}
+
+	for tri, p := range postings {
+		var shards []*srvpb.IdentifierPostings
+		if !p.qnames.Empty() {
+			shards = identifiers.Postings(p.qnames.Elements())
+		}
+		for i, shard := range shards {
+			rec, err := proto.Marshal(shard)
+			if err != nil {
+				return err
+			}
+			idx.writes[string(identifiers.TrigramShardKey(tri, i))] = rec
+		}
+		for i := len(shards); i < p.shards; i++ {
+			idx.writes[string(identifiers.TrigramShardKey(tri, i))] = nil
+		}
+	}
+	return nil
+}
+
+// identifierPostings is the set of qualified names posted for a trigram along
+// with the number of shards in which they are stored.
+type identifierPostings struct {
+	qnames stringset.Set
+	shards int
+}
+
+// readIdentifierPostings returns the postings of the given trigram.
+func readIdentifierPostings(ctx context.Context, idx *incrementalIndex, trigram string) (*identifierPostings, error) {
+	p := &identifierPostings{qnames: stringset.New()}
+	for {
+		val, err := idx.get(ctx, identifiers.TrigramShardKey(trigram, p.shards))
+		if err == io.EOF {
+			return p, nil
+		} else if err != nil {
+			return nil, err
+		}
+		var shard srvpb.IdentifierPostings
+		if err := proto.Unmarshal(val, &shard); err != nil {
+			return nil, fmt.Errorf("error unmarshaling postings for %q: %v", trigram, err)
+		}
+		p.qnames.Add(shard.QualifiedName...)
+		p.shards++
+	}
+}
+
+// updateFileTree adds to the index's writes the rewrite of the file tree in db
+// to contain each indexed file.
+func updateFileTree(ctx context.Context, db keyvalue.DB, idx *incrementalIndex) error {
+	keys, err := idx.scanKeys(ctx, filesPrefix)
+	if err != nil {
+		return err
+	}
+	tree := filetree.NewMap()
+	files := stringset.New()
+	for _, k := range keys {
+		// k is <ticket> <unit>
+		ticket := k[:strings.Index(k, incrementalKeySep)]
+		if !files.Add(ticket) {
+			continue
+		}
+		file, err := kytheuri.ToVName(ticket)
+		if err != nil {
+			return fmt.Errorf("invalid file ticket %q: %v", ticket, err)
+		}
+		tree.AddFile(file)
+	}
+
+	tmp := inmemory.NewKeyValueDB()
+	if err := writeFileTree(ctx, tree, &table.KVProto{DB: tmp}); err != nil {
+		return err
+	}
+
+	old, err := scanValues(ctx, db, ftsrv.DirTablePrefix)
+	if err != nil {
+		return err
+	}
+	updated, err := scanValues(ctx, tmp, ftsrv.DirTablePrefix)
+	if err != nil {
+		return err
+	}
+	for k := range old {
+		if _, ok := updated[k]; !ok {
+			idx.writes[k] = nil
+		}
+	}
+	for k, v := range updated {
+		if !bytes.Equal(old[k], v) {
+			idx.writes[k] = v
+		}
+	}
+	return nil
+}
+
+// applyWrites writes each key-value to db in a single batch, deleting each key
+// with a nil value.
+func applyWrites(ctx context.Context, db keyvalue.DB, writes map[string][]byte) error {
+	keys := make([]string, 0, len(writes))
+	for k := range writes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	wr, err := db.Writer(ctx)
+	if err != nil {
+		return err
+	}
+	d, ok := wr.(keyvalue.Deleter)
+	for _, k := range keys {
+		if writes[k] == nil && !ok {
+			wr.Close()
+			return keyvalue.ErrDeleteUnsupported
+		}
+	}
+	for _, k := range keys {
+		var err error
+		if v := writes[k]; v != nil {
+			err = wr.Write([]byte(k), v)
+		} else {
+			err = d.Delete([]byte(k))
+		}
+		if err != nil {
+			wr.Close()
+			return err
+		}
+	}
+	return wr.Close()
+}
+
+// scanKeys returns the suffixes of the keys in db with the given prefix.  If
+// limit is non-negative, at most limit keys are returned.
+func scanKeys(ctx context.Context, db keyvalue.DB, prefix string, limit int) ([]string, error) {
+	it, err := db.ScanPrefix(ctx, []byte(prefix), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	var keys []string
+	for limit < 0 || len(keys) < limit {
+		k, _, err := it.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		keys = append(keys, strings.TrimPrefix(string(k), prefix))
+	}
+	return keys, nil
+}
+
+// scanValues returns the key-values in db with the given key prefix.
+func scanValues(ctx context.Context, db keyvalue.DB, prefix string) (map[string][]byte, error) {
+	it, err := db.ScanPrefix(ctx, []byte(prefix), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	vals := make(map[string][]byte)
+	for {
+		k, v, err := it.Next()
+		if err == io.EOF {
+			return vals, nil
+		} else if err != nil {
+			return nil, err
+		}
+		vals[string(k)] = append([]byte(nil), v...)
+	}
+}
+
+func lookup(ctx context.Context, db keyvalue.DB, key []byte, msg proto.Message) (bool, error) {
+	val, err := db.Get(ctx, key, nil)
+	if err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, proto.Unmarshal(val, msg)
+}
+
+func unitSourceKey(unit, src string) []byte {
+	return []byte(unitSourcesPrefix + unit + incrementalKeySep + src)
+}
+
+func sourceEntriesKey(src, unit string) []byte {
+	return []byte(sourceEntriesPrefix + src + incrementalKeySep + unit)
+}
+
+func referenceKey(ticket, unit, src string) []byte {
+	return []byte(referencesPrefix + ticket + incrementalKeySep + unit + incrementalKeySep + src)
+}
+
+func fileKey(ticket, unit string) []byte {
+	return []byte(filesPrefix + ticket + incrementalKeySep + unit)
+}
+
+// anchorFile returns the file ticket of the given source if it is an anchor.
+func anchorFile(src string, es []*spb.Entry) (string, bool) {
+	if nodeKind(es) != nodes.Anchor {
+		return "", false
+	}
+	file, err := tickets.AnchorFile(src)
+	return file, err == nil
+}
+
+func nodeKind(es []*spb.Entry) string {
+	for _, e := range es {
+		if e.EdgeKind == "" && e.FactName == facts.NodeKind {
+			return string(e.FactValue)
+		}
+	}
+	return ""
+}
+
+func nodeFacts(es []*spb.Entry) []*spb.Entry {
+	var fs []*spb.Entry
+	for _, e := range es {
+		if e.EdgeKind == "" {
+			fs = append(fs, e)
+		}
+	}
+	return fs
+}
+
+// embeddedFacts returns the node facts of es that are embedded in the serving
+// data of other nodes.
+func embeddedFacts(es []*spb.Entry) []*spb.Entry {
+	var fs []*spb.Entry
+	for _, e := range nodeFacts(es) {
+		switch e.FactName {
+		case facts.Text, facts.TextEncoding:
+		default:
+			fs = append(fs, e)
+		}
+	}
+	return fs
+}
+
+// expandAnchor returns the anchor with the given entries as expanded within
+// the file with the given entries, or nil if it cannot be expanded.
+func expandAnchor(src string, es, file []*spb.Entry) *srvpb.ExpandedAnchor {
+	fact := func(name string) int32 {
+		for _, e := range es {
+			if e.EdgeKind == "" && e.FactName == name {
+				n, _ := strconv.Atoi(string(e.FactValue))
+				return int32(n)
+			}
+		}
+		return 0
+	}
+	f := &srvpb.File{}
+	for _, e := range nodeFacts(file) {
+		switch e.FactName {
+		case facts.Text:
+			f.Text = e.FactValue
+		case facts.TextEncoding:
+			f.Encoding = string(e.FactValue)
+		}
+	}
+	ea, err := assemble.ExpandAnchor(&srvpb.RawAnchor{
+		Ticket:       src,
+		StartOffset:  fact(facts.AnchorStart),
+		EndOffset:    fact(facts.AnchorEnd),
+		SnippetStart: fact(facts.SnippetStart),
+		SnippetEnd:   fact(facts.SnippetEnd),
+	}, f, span.NewNormalizer(f.Text), "")
+	if err != nil {
+		return nil
+	}
+	return ea
+}
+
+// typedTargets returns the targets of the typed edges in es.
+func typedTargets(es []*spb.Entry) stringset.Set {
+	targets := stringset.New()
+	for _, e := range es {
+		if e.EdgeKind == edges.Typed {
+			targets.Add(kytheuri.ToString(e.Target))
+		}
+	}
+	return targets
+}
+
+func entryTargets(es []*spb.Entry) stringset.Set {
+	targets := stringset.New()
+	for _, e := range es {
+		if e.EdgeKind != "" {
+			targets.Add(kytheuri.ToString(e.Target))
+		}
+	}
+	return targets
+}
+
+// sortEntries sorts es into GraphStore order and removes duplicates.
+func sortEntries(es []*spb.Entry) []*spb.Entry {
+	sort.Sort(compare.ByEntries(es))
+	var res []*spb.Entry
+	for _, e := range es {
+		if len(res) == 0 || !compare.EntriesEqual(res[len(res)-1], e) {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func entriesEqual(a, b []*spb.Entry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !compare.EntriesEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
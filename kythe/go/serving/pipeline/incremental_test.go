@@ -0,0 +1,452 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	esrv "kythe.io/kythe/go/serving/explore"
+	ftsrv "kythe.io/kythe/go/serving/filetree"
+	"kythe.io/kythe/go/storage/inmemory"
+	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/schema/edges"
+	"kythe.io/kythe/go/util/schema/facts"
+	"kythe.io/kythe/go/util/schema/nodes"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/proto"
+
+	cpb "kythe.io/kythe/proto/common_go_proto"
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// testUnit returns the entries of a compilation unit for a file defining the
+// function def and calling each of refs from it.
+func testUnit(path, text, def string, refs ...string) []*spb.Entry {
+	file := &spb.VName{Corpus: "corpus", Path: path}
+	anchor := func(sig string, start int) *spb.VName {
+		return &spb.VName{Corpus: "corpus", Path: path, Language: "go", Signature: sig + "@" + strconv.Itoa(start)}
+	}
+	fn := func(sig string) *spb.VName {
+		return &spb.VName{Corpus: "corpus", Language: "go", Signature: sig}
+	}
+
+	es := []*spb.Entry{
+		testFact(file, facts.NodeKind, nodes.File),
+		testFact(file, facts.Text, text),
+		testFact(fn(def), facts.NodeKind, nodes.Function),
+	}
+	decl := anchor(def, strings.Index(text, def))
+	es = append(es, testAnchor(decl, strings.Index(text, def), len(def))...)
+	es = append(es, testEdge(decl, edges.DefinesBinding, fn(def)))
+	for _, ref := range refs {
+		start := strings.LastIndex(text, ref)
+		a := anchor(ref, start)
+		es = append(es, testAnchor(a, start, len(ref))...)
+		es = append(es, testEdge(a, edges.RefCall, fn(ref)), testEdge(a, edges.ChildOf, fn(def)))
+	}
+	return es
+}
+
+func testAnchor(a *spb.VName, start, length int) []*spb.Entry {
+	return []*spb.Entry{
+		testFact(a, facts.NodeKind, nodes.Anchor),
+		testFact(a, facts.AnchorStart, strconv.Itoa(start)),
+		testFact(a, facts.AnchorEnd, strconv.Itoa(start+length)),
+	}
+}
+
+func testFact(src *spb.VName, name, value string) *spb.Entry {
+	return &spb.Entry{Source: src, FactName: name, FactValue: []byte(value)}
+}
+
+func testEdge(src *spb.VName, kind string, tgt *spb.VName) *spb.Entry {
+	return &spb.Entry{Source: src, EdgeKind: kind, Target: tgt, FactName: "/"}
+}
+
+func entryReader(es []*spb.Entry) func(func(*spb.Entry) error) error {
+	return func(f func(*spb.Entry) error) error {
+		for _, e := range es {
+			if err := f(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// servingData returns the key-values of db, excluding the incremental index.
+func servingData(t *testing.T, db keyvalue.DB) map[string]string {
+	t.Helper()
+	it, err := db.ScanPrefix(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	data := make(map[string]string)
+	for {
+		k, v, err := it.Next()
+		if err == io.EOF {
+			return data
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(k), "incr:") {
+			data[string(k)] = string(v)
+		}
+	}
+}
+
+// freshTable builds a serving table for the given units from scratch.
+func freshTable(t *testing.T, units map[string][]*spb.Entry) keyvalue.DB {
+	t.Helper()
+	db := inmemory.NewKeyValueDB()
+	var updates []*UnitUpdate
+	for unit, es := range units {
+		updates = append(updates, &UnitUpdate{Unit: unit, Entries: entryReader(es)})
+	}
+	if err := Update(context.Background(), db, updates, nil); err != nil {
+		t.Fatalf("Update error: %v", err)
+	}
+	return db
+}
+
+func TestUpdateMatchesRun(t *testing.T) {
+	a := testUnit("a.go", "func A() { B() }", "A", "B")
+	b := testUnit("b.go", "func B() {}", "B")
+
+	ctx := context.Background()
+	ran := inmemory.NewKeyValueDB()
+	if err := Run(ctx, entryReader(sortEntries(append(append([]*spb.Entry{}, a...), b...))), ran, nil); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	updated := freshTable(t, map[string][]*spb.Entry{"a": a, "b": b})
+
+	if diff := cmp.Diff(servingData(t, ran), servingData(t, updated)); diff != "" {
+		t.Errorf("Unexpected serving data: (- Run; + Update)\n%s", diff)
+	}
+
+	if err := Update(ctx, ran, nil, nil); err == nil {
+		t.Error("Expected error updating table built by Run")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	a := testUnit("a.go", "func A() { B() }", "A", "B")
+	b := testUnit("b.go", "func B() {}", "B")
+	c := testUnit("c.go", "func C() { B() }", "C", "B")
+
+	ctx := context.Background()
+	db := freshTable(t, map[string][]*spb.Entry{"a": a, "b": b, "c": c})
+
+	tests := []struct {
+		name    string
+		updates []*UnitUpdate
+		final   map[string][]*spb.Entry
+	}{{
+		name: "modify unit",
+		updates: []*UnitUpdate{{
+			Unit:    "a",
+			Entries: entryReader(testUnit("a.go", "func A() { C(); B() }", "A", "C", "B")),
+		}},
+		final: map[string][]*spb.Entry{
+			"a": testUnit("a.go", "func A() { C(); B() }", "A", "C", "B"),
+			"b": b,
+			"c": c,
+		},
+	}, {
+		name:    "remove unit",
+		updates: []*UnitUpdate{{Unit: "c"}},
+		final: map[string][]*spb.Entry{
+			"a": testUnit("a.go", "func A() { C(); B() }", "A", "C", "B"),
+			"b": b,
+		},
+	}, {
+		name: "add and rename",
+		updates: []*UnitUpdate{
+			{Unit: "b"},
+			{Unit: "b2", Entries: entryReader(testUnit("pkg/b.go", "func B() {}", "B"))},
+		},
+		final: map[string][]*spb.Entry{
+			"a":  testUnit("a.go", "func A() { C(); B() }", "A", "C", "B"),
+			"b2": testUnit("pkg/b.go", "func B() {}", "B"),
+		},
+	}}
+
+	for _, test := range tests {
+		if err := Update(ctx, db, test.updates, nil); err != nil {
+			t.Fatalf("%s: Update error: %v", test.name, err)
+		}
+		expected := servingData(t, freshTable(t, test.final))
+		if diff := cmp.Diff(expected, servingData(t, db)); diff != "" {
+			t.Errorf("%s: unexpected serving data: (- expected; + found)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestUpdateInvalidUnit(t *testing.T) {
+	db := inmemory.NewKeyValueDB()
+	for _, unit := range []string{"", "a\000b"} {
+		if err := Update(context.Background(), db, []*UnitUpdate{{Unit: unit}}, nil); err == nil {
+			t.Errorf("Expected error for unit %q", unit)
+		}
+	}
+}
+
+func TestUpdateScope(t *testing.T) {
+	a := testUnit("a.go", "func A() { B() }\n", "A", "B")
+	b := testUnit("b.go", "func B() {}\n", "B")
+	c := testUnit("c.go", "func C() { B() }\n", "C", "B")
+	d := testUnit("d.go", "func D() {}\n", "D")
+	file := func(path string) string {
+		return kytheuri.ToString(&spb.VName{Corpus: "corpus", Path: path})
+	}
+	anchor := func(path, sig string) string {
+		return kytheuri.ToString(&spb.VName{Corpus: "corpus", Path: path, Language: "go", Signature: sig})
+	}
+	fnB := &spb.VName{Corpus: "corpus", Language: "go", Signature: "B"}
+
+	tests := []struct {
+		name     string
+		unit     []*spb.Entry
+		affected []string
+		files    []string // whose text is read to rebuild the serving data
+	}{{
+		name: "unchanged",
+		unit: b,
+	}, {
+		name:     "text after anchors",
+		unit:     testUnit("b.go", "func B() {}\n// B does nothing.\n", "B"),
+		affected: []string{file("b.go")},
+		files:    []string{file("b.go")},
+	}, {
+		name:     "anchor snippet",
+		unit:     testUnit("b.go", "func B() { }\n", "B"),
+		affected: []string{anchor("b.go", "B@5"), file("b.go"), kytheuri.ToString(fnB)},
+		files:    []string{file("a.go"), file("b.go"), file("c.go")},
+	}, {
+		name: "node subkind",
+		unit: append(testUnit("b.go", "func B() {}\n", "B"), testFact(fnB, facts.Subkind, "method")),
+		affected: []string{
+			anchor("a.go", "B@11"), anchor("b.go", "B@5"), anchor("c.go", "B@11"),
+			file("a.go"), file("b.go"), file("c.go"),
+			kytheuri.ToString(fnB),
+		},
+		files: []string{file("a.go"), file("b.go"), file("c.go")},
+	}}
+
+	ctx := context.Background()
+	for _, test := range tests {
+		db := freshTable(t, map[string][]*spb.Entry{"a": a, "b": b, "c": c, "d": d})
+		updates := []*UnitUpdate{{Unit: "b", Entries: entryReader(test.unit)}}
+		plan, err := planUpdate(ctx, db, updates)
+		if err != nil {
+			t.Fatalf("%s: planUpdate error: %v", test.name, err)
+		}
+		if diff := cmp.Diff(stringset.New(test.affected...).Elements(), plan.affected.Elements(), cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("%s: unexpected affected nodes: (- expected; + found)\n%s", test.name, diff)
+		}
+		files := stringset.New()
+		for _, e := range plan.entries {
+			if e.FactName == facts.Text {
+				files.Add(kytheuri.ToString(e.Source))
+			}
+		}
+		if diff := cmp.Diff(stringset.New(test.files...).Elements(), files.Elements(), cmpopts.EquateEmpty()); diff != "" {
+			t.Errorf("%s: unexpected file texts: (- expected; + found)\n%s", test.name, diff)
+		}
+
+		if err := Update(ctx, db, updates, nil); err != nil {
+			t.Fatalf("%s: Update error: %v", test.name, err)
+		}
+		expected := servingData(t, freshTable(t, map[string][]*spb.Entry{"a": a, "b": test.unit, "c": c, "d": d}))
+		if diff := cmp.Diff(expected, servingData(t, db)); diff != "" {
+			t.Errorf("%s: unexpected serving data: (- expected; + found)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestUpdateFunctionType(t *testing.T) {
+	vname := func(sig string) *spb.VName {
+		return &spb.VName{Corpus: "corpus", Language: "go", Signature: sig}
+	}
+	fn, fnType, builtin := vname("F"), vname("fnType"), vname(fnBuiltin)
+	typeUnit := func(ret string) []*spb.Entry {
+		return []*spb.Entry{
+			testFact(fnType, facts.NodeKind, nodes.TApp),
+			testFact(vname(ret), facts.NodeKind, nodes.TBuiltin),
+			testEdge(fnType, edges.ParamIndex(0), builtin),
+			testEdge(fnType, edges.ParamIndex(1), vname(ret)),
+		}
+	}
+
+	fnUnit := func(subkind string) []*spb.Entry {
+		return []*spb.Entry{
+			testFact(fn, facts.NodeKind, nodes.Function),
+			testFact(fn, facts.Subkind, subkind),
+			testEdge(fn, edges.Typed, fnType),
+		}
+	}
+
+	ctx := context.Background()
+	db := freshTable(t, map[string][]*spb.Entry{"fn": fnUnit("a"), "type": typeUnit("int")})
+	tests := []struct {
+		name   string
+		update *UnitUpdate
+		final  map[string][]*spb.Entry
+	}{{
+		name:   "return type",
+		update: &UnitUpdate{Unit: "type", Entries: entryReader(typeUnit("string"))},
+		final:  map[string][]*spb.Entry{"fn": fnUnit("a"), "type": typeUnit("string")},
+	}, {
+		name:   "function",
+		update: &UnitUpdate{Unit: "fn", Entries: entryReader(fnUnit("b"))},
+		final:  map[string][]*spb.Entry{"fn": fnUnit("b"), "type": typeUnit("string")},
+	}}
+	for _, test := range tests {
+		if err := Update(ctx, db, []*UnitUpdate{test.update}, nil); err != nil {
+			t.Fatalf("%s: Update error: %v", test.name, err)
+		}
+		expected := servingData(t, freshTable(t, test.final))
+		if diff := cmp.Diff(expected, servingData(t, db)); diff != "" {
+			t.Errorf("%s: unexpected serving data: (- expected; + found)\n%s", test.name, diff)
+		}
+		if _, ok := expected[string(esrv.ParametersKey(kytheuri.ToString(fn)))]; !ok {
+			t.Errorf("%s: missing parameters of %v", test.name, fn)
+		}
+	}
+}
+
+func TestUpdateIdentifiers(t *testing.T) {
+	node := func(sig, subkind, qualifier, name string) []*spb.Entry {
+		v := &spb.VName{Corpus: "corpus", Language: "go", Signature: sig}
+		code, err := proto.Marshal(&cpb.MarkedSource{
+			Kind: cpb.MarkedSource_BOX,
+			Child: []*cpb.MarkedSource{{
+				Kind:          cpb.MarkedSource_CONTEXT,
+				PostChildText: ".",
+				Child:         []*cpb.MarkedSource{{Kind: cpb.MarkedSource_IDENTIFIER, PreText: qualifier}},
+			}, {
+				Kind:    cpb.MarkedSource_IDENTIFIER,
+				PreText: name,
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []*spb.Entry{
+			testFact(v, facts.NodeKind, nodes.Function),
+			testFact(v, facts.Subkind, subkind),
+			testFact(v, facts.Code, string(code)),
+		}
+	}
+	unit := func(nodes ...[]*spb.Entry) []*spb.Entry {
+		var es []*spb.Entry
+		for _, n := range nodes {
+			es = append(es, n...)
+		}
+		return es
+	}
+
+	ctx := context.Background()
+	units := map[string][]*spb.Entry{
+		"x": unit(node("X1", "a", "pkg", "Foo"), node("X2", "a", "pkg", "Bar")),
+		"y": unit(node("Y1", "a", "pkg", "Foo")),
+	}
+	db := freshTable(t, units)
+	tests := []struct {
+		name   string
+		update *UnitUpdate
+	}{{
+		name:   "renamed node sharing a name",
+		update: &UnitUpdate{Unit: "x", Entries: entryReader(unit(node("X1", "a", "pkg", "Baz"), node("X2", "a", "pkg", "Bar")))},
+	}, {
+		name:   "node subkind",
+		update: &UnitUpdate{Unit: "x", Entries: entryReader(unit(node("X1", "a", "pkg", "Baz"), node("X2", "b", "pkg", "Bar")))},
+	}, {
+		name:   "node moved to a new name",
+		update: &UnitUpdate{Unit: "y", Entries: entryReader(unit(node("Y1", "a", "other", "Foo")))},
+	}, {
+		name:   "removed unit",
+		update: &UnitUpdate{Unit: "x"},
+	}}
+	for _, test := range tests {
+		if err := Update(ctx, db, []*UnitUpdate{test.update}, nil); err != nil {
+			t.Fatalf("%s: Update error: %v", test.name, err)
+		}
+		if test.update.Entries == nil {
+			delete(units, test.update.Unit)
+		} else {
+			var es []*spb.Entry
+			test.update.Entries(func(e *spb.Entry) error {
+				es = append(es, e)
+				return nil
+			})
+			units[test.update.Unit] = es
+		}
+
+		var all []*spb.Entry
+		for _, es := range units {
+			all = append(all, es...)
+		}
+		ran := inmemory.NewKeyValueDB()
+		if err := Run(ctx, entryReader(sortEntries(all)), ran, nil); err != nil {
+			t.Fatalf("%s: Run error: %v", test.name, err)
+		}
+		// These units have no files, for which Run still writes an empty tree.
+		ignoreTree := cmpopts.IgnoreMapEntries(func(k, _ string) bool { return strings.HasPrefix(k, ftsrv.DirTablePrefix) })
+		if diff := cmp.Diff(servingData(t, ran), servingData(t, db), ignoreTree); diff != "" {
+			t.Errorf("%s: unexpected serving data: (- Run; + Update)\n%s", test.name, diff)
+		}
+	}
+}
+
+// countingDB is a keyvalue.DB counting the Writers it creates.
+type countingDB struct {
+	keyvalue.DB
+	writers int
+}
+
+func (db *countingDB) Writer(ctx context.Context) (keyvalue.Writer, error) {
+	db.writers++
+	return db.DB.Writer(ctx)
+}
+
+func TestUpdateSingleBatch(t *testing.T) {
+	a := testUnit("a.go", "func A() { B() }", "A", "B")
+	b := testUnit("b.go", "func B() {}", "B")
+	db := &countingDB{DB: freshTable(t, map[string][]*spb.Entry{"a": a, "b": b})}
+
+	// The index is written along with the serving data and file tree.
+	updates := []*UnitUpdate{
+		{Unit: "b"},
+		{Unit: "b2", Entries: entryReader(testUnit("pkg/b.go", "func B() { A() }", "B", "A"))},
+	}
+	if err := Update(context.Background(), db, updates, nil); err != nil {
+		t.Fatalf("Update error: %v", err)
+	}
+	if db.writers != 1 {
+		t.Errorf("Update wrote %d batches; want 1", db.writers)
+	}
+}
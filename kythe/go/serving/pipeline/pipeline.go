@@ -31,6 +31,7 @@ import (
 	"kythe.io/kythe/go/services/graphstore"
 	ftsrv "kythe.io/kythe/go/serving/filetree"
 	gsrv "kythe.io/kythe/go/serving/graph"
+	"kythe.io/kythe/go/serving/identifiers"
 	xsrv "kythe.io/kythe/go/serving/xrefs"
 	"kythe.io/kythe/go/serving/xrefs/assemble"
 	"kythe.io/kythe/go/storage/keyvalue"
@@ -45,6 +46,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	cpb "kythe.io/kythe/proto/common_go_proto"
 	ftpb "kythe.io/kythe/proto/filetree_go_proto"
 	ipb "kythe.io/kythe/proto/internal_go_proto"
 	srvpb "kythe.io/kythe/proto/serving_go_proto"
@@ -85,8 +87,8 @@ type servingOutput struct {
 	xs table.Proto
 }
 
-// Run writes the xrefs and filetree serving tables to db based on the given
-// entries (in GraphStore-order).
+// Run writes the xrefs, filetree, identifier, and explore serving tables to db
+// based on the given entries (in GraphStore-order).
 func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Options) error {
 	if opts == nil {
 		opts = new(Options)
@@ -116,9 +118,9 @@ func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Optio
 		return cErr
 	}
 
-	pesIn, dIn := make(chan *srvpb.Edge, chBuf), make(chan *srvpb.Edge, chBuf)
-	var pErr, fErr error
-	wg.Add(2)
+	pesIn, dIn, eIn := make(chan *srvpb.Edge, chBuf), make(chan *srvpb.Edge, chBuf), make(chan *srvpb.Edge, chBuf)
+	var pErr, fErr, eErr error
+	wg.Add(3)
 	go func() {
 		defer wg.Done()
 		if err := writePagedEdges(ctx, pesIn, out.xs, opts); err != nil {
@@ -131,15 +133,23 @@ func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Optio
 			fErr = fmt.Errorf("error writing file decorations: %v", err)
 		}
 	}()
+	go func() {
+		defer wg.Done()
+		if err := writeExploreTables(ctx, opts, eIn, out.xs); err != nil {
+			eErr = fmt.Errorf("error writing explore tables: %v", err)
+		}
+	}()
 
 	err := sortedEdges.Read(func(x interface{}) error {
 		e := x.(*srvpb.Edge)
 		pesIn <- e
 		dIn <- e
+		eIn <- e
 		return nil
 	})
 	close(pesIn)
 	close(dIn)
+	close(eIn)
 	if err != nil {
 		return fmt.Errorf("error reading edges table: %v", err)
 	}
@@ -147,8 +157,10 @@ func Run(ctx context.Context, rd stream.EntryReader, db keyvalue.DB, opts *Optio
 	wg.Wait()
 	if pErr != nil {
 		return pErr
+	} else if fErr != nil {
+		return fErr
 	}
-	return fErr
+	return eErr
 }
 
 func combineNodesAndEdges(ctx context.Context, opts *Options, out *servingOutput, rdIn stream.EntryReader) (disksort.Interface, error) {
@@ -169,8 +181,15 @@ func combineNodesAndEdges(ctx context.Context, opts *Options, out *servingOutput
 	if err != nil {
 		return nil, err
 	}
+	idSorter, err := opts.diskSorter(identifierLesser{}, identifierMarshaler{})
+	if err != nil {
+		return nil, err
+	}
 
 	if err := assemble.Sources(rd, func(src *ipb.Source) error {
+		if err := addIdentifierMatch(idSorter, src); err != nil {
+			return err
+		}
 		return writePartialEdges(ctx, partialSorter, src)
 	}); err != nil {
 		return nil, err
@@ -181,6 +200,11 @@ func combineNodesAndEdges(ctx context.Context, opts *Options, out *servingOutput
 	}
 	tree = nil
 
+	log.Println("Writing identifiers")
+	if err := writeIdentifiers(ctx, opts, idSorter, out.xs); err != nil {
+		return nil, fmt.Errorf("error writing identifiers: %v", err)
+	}
+
 	log.Println("Writing complete edges")
 
 	cSorter, err := opts.diskSorter(edgeLesser{}, edgeMarshaler{})
@@ -255,6 +279,97 @@ func writeFileTree(ctx context.Context, tree *filetree.Map, out table.Proto) err
 	return buffer.Flush(ctx)
 }
 
+// addIdentifierMatch adds the IdentifierMatch of src, if it has a MarkedSource
+// naming an identifier, to the given sorter.
+func addIdentifierMatch(sorter disksort.Interface, src *ipb.Source) error {
+	rec, ok := src.Facts[facts.Code]
+	if !ok {
+		return nil
+	}
+	var ms cpb.MarkedSource
+	if err := proto.Unmarshal(rec, &ms); err != nil {
+		return fmt.Errorf("error unmarshaling code fact for %q: %v", src.Ticket, err)
+	}
+	m := identifierMatch(src.Ticket, string(src.Facts[facts.NodeKind]), string(src.Facts[facts.Subkind]), &ms)
+	if m == nil {
+		return nil
+	}
+	return sorter.Add(m)
+}
+
+// writeIdentifiers writes the IdentifierMatches read from the given sorter,
+// merged by qualified name, and their trigram index to out.
+func writeIdentifiers(ctx context.Context, opts *Options, matches disksort.Interface, out table.Proto) error {
+	buffer := out.Buffered()
+	postings, err := opts.diskSorter(postingLesser{}, postingMarshaler{})
+	if err != nil {
+		return err
+	}
+
+	var group []*srvpb.IdentifierMatch
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		m := mergeIdentifierMatches(group)
+		group = nil
+		for _, tri := range identifiers.Trigrams(m) {
+			if err := postings.Add(&identifierPosting{trigram: tri, qualifiedName: m.QualifiedName}); err != nil {
+				return err
+			}
+		}
+		return buffer.Put(ctx, []byte(m.QualifiedName), m)
+	}
+	if err := matches.Read(func(i interface{}) error {
+		m := i.(*srvpb.IdentifierMatch)
+		if len(group) > 0 && group[0].QualifiedName != m.QualifiedName {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		group = append(group, m)
+		return nil
+	}); err != nil {
+		return err
+	} else if err := flush(); err != nil {
+		return err
+	}
+
+	var (
+		trigram string
+		qnames  []string
+	)
+	flushPostings := func() error {
+		if len(qnames) == 0 {
+			return nil
+		}
+		shards := identifiers.Postings(qnames)
+		qnames = nil
+		for i, shard := range shards {
+			if err := buffer.Put(ctx, identifiers.TrigramShardKey(trigram, i), shard); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := postings.Read(func(i interface{}) error {
+		p := i.(*identifierPosting)
+		if p.trigram != trigram {
+			if err := flushPostings(); err != nil {
+				return err
+			}
+			trigram = p.trigram
+		}
+		qnames = append(qnames, p.qualifiedName)
+		return nil
+	}); err != nil {
+		return err
+	} else if err := flushPostings(); err != nil {
+		return err
+	}
+	return buffer.Flush(ctx)
+}
+
 func filterReverses(rd stream.EntryReader) stream.EntryReader {
 	return func(f func(*spb.Entry) error) error {
 		return rd(func(e *spb.Entry) error {
@@ -588,6 +703,58 @@ func (fragmentMarshaler) Unmarshal(rec []byte) (interface{}, error) {
 	}, nil
 }
 
+type identifierLesser struct{}
+
+func (identifierLesser) Less(a, b interface{}) bool {
+	x, y := a.(*srvpb.IdentifierMatch), b.(*srvpb.IdentifierMatch)
+	if x.QualifiedName == y.QualifiedName {
+		return x.Node[0].Ticket < y.Node[0].Ticket
+	}
+	return x.QualifiedName < y.QualifiedName
+}
+
+type identifierMarshaler struct{}
+
+func (identifierMarshaler) Marshal(x interface{}) ([]byte, error) {
+	return proto.Marshal(x.(proto.Message))
+}
+
+func (identifierMarshaler) Unmarshal(rec []byte) (interface{}, error) {
+	var m srvpb.IdentifierMatch
+	return &m, proto.Unmarshal(rec, &m)
+}
+
+// An identifierPosting records that the identifier with the given qualified
+// name is indexed under a trigram.
+type identifierPosting struct {
+	trigram, qualifiedName string
+}
+
+type postingLesser struct{}
+
+func (postingLesser) Less(a, b interface{}) bool {
+	x, y := a.(*identifierPosting), b.(*identifierPosting)
+	if x.trigram == y.trigram {
+		return x.qualifiedName < y.qualifiedName
+	}
+	return x.trigram < y.trigram
+}
+
+type postingMarshaler struct{}
+
+func (postingMarshaler) Marshal(x interface{}) ([]byte, error) {
+	p := x.(*identifierPosting)
+	return []byte(p.trigram + "\000" + p.qualifiedName), nil
+}
+
+func (postingMarshaler) Unmarshal(rec []byte) (interface{}, error) {
+	ss := bytes.SplitN(rec, []byte("\000"), 2)
+	if len(ss) != 2 {
+		return nil, errors.New("invalid identifierPosting encoding")
+	}
+	return &identifierPosting{trigram: string(ss[0]), qualifiedName: string(ss[1])}, nil
+}
+
 type refMarshaler struct{}
 
 func (refMarshaler) Marshal(x interface{}) ([]byte, error) { return proto.Marshal(x.(proto.Message)) }
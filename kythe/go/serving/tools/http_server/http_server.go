@@ -14,21 +14,27 @@
  * limitations under the License.
  */
 
-// Binary http_server exposes HTTP interfaces for the xrefs and filetree
-// services backed by a combined serving table.
+// Binary http_server exposes HTTP interfaces for the xrefs, graph,
+// identifiers, filetree, explore, and link services backed by a combined
+// serving table.  The xrefs, graph, identifiers, filetree, and explore services
+// may also be exposed over gRPC.
 package main
 
 import (
 	"context"
 	"flag"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
 
+	"kythe.io/kythe/go/services/explore"
 	"kythe.io/kythe/go/services/filetree"
 	"kythe.io/kythe/go/services/graph"
+	"kythe.io/kythe/go/services/link"
 	"kythe.io/kythe/go/services/xrefs"
+	esrv "kythe.io/kythe/go/serving/explore"
 	ftsrv "kythe.io/kythe/go/serving/filetree"
 	gsrv "kythe.io/kythe/go/serving/graph"
 	"kythe.io/kythe/go/serving/identifiers"
@@ -38,8 +44,11 @@ import (
 	"kythe.io/kythe/go/util/flagutil"
 
 	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
 
 	_ "kythe.io/kythe/go/services/graphstore/proxy"
+
+	ipb "kythe.io/kythe/proto/identifier_go_proto"
 )
 
 var (
@@ -53,20 +62,22 @@ var (
 	tlsCertFile      = flag.String("tls_cert_file", "", "Path to file with concatenation of TLS certificates")
 	tlsKeyFile       = flag.String("tls_key_file", "", "Path to file with TLS private key")
 
+	grpcListeningAddr = flag.String("grpc_listen", "", "Listening address for gRPC server")
+
 	maxTicketsPerRequest = flag.Int("max_tickets_per_request", 20, "Maximum number of tickets allowed per request")
 )
 
 func init() {
-	flag.Usage = flagutil.SimpleUsage("Exposes HTTP interfaces for the xrefs and filetree services",
-		"(--graphstore spec | --serving_table path) [--listen addr] [--public_resources dir]")
+	flag.Usage = flagutil.SimpleUsage("Exposes HTTP interfaces for the xrefs, graph, identifiers, filetree, explore, and link services",
+		"(--graphstore spec | --serving_table path) [--listen addr] [--grpc_listen addr] [--public_resources dir]")
 }
 
 func main() {
 	flag.Parse()
 	if *servingTable == "" {
 		flagutil.UsageError("missing --serving_table")
-	} else if *httpListeningAddr == "" && *tlsListeningAddr == "" {
-		flagutil.UsageError("missing either --listen or --tls_listen argument")
+	} else if *httpListeningAddr == "" && *tlsListeningAddr == "" && *grpcListeningAddr == "" {
+		flagutil.UsageError("missing either --listen, --tls_listen, or --grpc_listen argument")
 	} else if *tlsListeningAddr != "" && (*tlsCertFile == "" || *tlsKeyFile == "") {
 		flagutil.UsageError("--tls_cert_file and --tls_key_file are required if given --tls_listen")
 	} else if flag.NArg() > 0 {
@@ -78,6 +89,8 @@ func main() {
 		gs graph.Service
 		it identifiers.Service
 		ft filetree.Service
+		es explore.Service
+		ls link.Service
 	)
 
 	ctx := context.Background()
@@ -86,8 +99,10 @@ func main() {
 		log.Fatalf("Error opening db at %q: %v", *servingTable, err)
 	}
 	defer db.Close(ctx)
+	tbl := &table.KVProto{db}
 	xs = xsrv.NewService(ctx, db)
 	gs = gsrv.NewService(ctx, db)
+	es = esrv.NewCombinedTables(tbl)
 	if *maxTicketsPerRequest > 0 {
 		xs = xrefs.BoundedRequests{
 			Service:    xs,
@@ -97,10 +112,14 @@ func main() {
 			Service:    gs,
 			MaxTickets: *maxTicketsPerRequest,
 		}
+		es = explore.BoundedRequests{
+			Service:    es,
+			MaxTickets: *maxTicketsPerRequest,
+		}
 	}
-	tbl := &table.KVProto{db}
 	ft = &ftsrv.Table{Proto: tbl, PrefixedKeys: true}
 	it = &identifiers.Table{tbl}
+	ls = &link.Resolver{Client: linkClient{xs, it}}
 
 	if *httpListeningAddr != "" || *tlsListeningAddr != "" {
 		apiMux := http.NewServeMux()
@@ -115,6 +134,8 @@ func main() {
 		graph.RegisterHTTPHandlers(ctx, gs, apiMux)
 		identifiers.RegisterHTTPHandlers(ctx, it, apiMux)
 		filetree.RegisterHTTPHandlers(ctx, ft, apiMux)
+		explore.RegisterHTTPHandlers(ctx, es, apiMux)
+		link.RegisterHTTPHandlers(ctx, ls, apiMux)
 		if *publicResources != "" {
 			log.Println("Serving public resources at", *publicResources)
 			if s, err := os.Stat(*publicResources); err != nil {
@@ -133,10 +154,31 @@ func main() {
 	if *tlsListeningAddr != "" {
 		go startTLS()
 	}
+	if *grpcListeningAddr != "" {
+		srv := grpc.NewServer()
+		xrefs.RegisterGRPCServer(srv, xs)
+		graph.RegisterGRPCServer(srv, gs)
+		identifiers.RegisterGRPCServer(srv, it)
+		filetree.RegisterGRPCServer(srv, ft)
+		explore.RegisterGRPCServer(srv, es)
+		go startGRPC(srv)
+	}
 
 	select {} // block forever
 }
 
+// linkClient combines the xrefs and identifiers services needed to resolve
+// links.
+type linkClient struct {
+	xrefs.Service
+	it identifiers.Service
+}
+
+// Find implements part of the link.Resolver client interface.
+func (c linkClient) Find(ctx context.Context, req *ipb.FindRequest) (*ipb.FindReply, error) {
+	return c.it.Find(ctx, req)
+}
+
 func startHTTP() {
 	log.Printf("HTTP server listening on %q", *httpListeningAddr)
 	log.Fatal(http.ListenAndServe(*httpListeningAddr, nil))
@@ -149,3 +191,12 @@ func startTLS() {
 	log.Printf("TLS HTTP2 server listening on %q", *tlsListeningAddr)
 	log.Fatal(srv.ListenAndServeTLS(*tlsCertFile, *tlsKeyFile))
 }
+
+func startGRPC(srv *grpc.Server) {
+	l, err := net.Listen("tcp", *grpcListeningAddr)
+	if err != nil {
+		log.Fatalf("Error listening on %q: %v", *grpcListeningAddr, err)
+	}
+	log.Printf("gRPC server listening on %q", *grpcListeningAddr)
+	log.Fatal(srv.Serve(l))
+}
@@ -14,7 +14,7 @@
  * limitations under the License.
  */
 
-// Binary kythe exposes a CLI interface to the xrefs and filetree
+// Binary kythe exposes a CLI interface to the xrefs, filetree, and explore
 // services backed by a combined serving table.
 //
 // Examples:
@@ -39,6 +39,12 @@
 //   # Show reverse /kythe/edge/defines edges for a node
 //   kythe --api /path/to/table edges --kinds '%/kythe/edge/defines' kythe://kythe?lang=java?path=kythe/java/com/google/devtools/kythe/analyzers/base/EntrySet.java#1887f665ee4c77287d1022c151000a489e17147215309818cf4150c601442cc5
 //
+//   # Show the definition locations of a qualified name
+//   kythe --api /path/to/table link --languages java java.util.List
+//
+//   # Show the callers of a function, up to 3 calls away, as a Graphviz graph
+//   kythe --api /path/to/table callers --depth 3 --dot kythe:?lang=go#pkg.Func | dot -Tsvg > callers.svg
+//
 //   # Show all facts (except /kythe/text) for a node
 //   kythe --api /path/to/table node kythe:?lang=c%2B%2B#StripPrefix%3Acommon%3Akythe%23n%23D%40kythe%2Fcxx%2Fcommon%2FCommandLineUtils.cc%3A167%3A1
 package main
@@ -62,6 +68,8 @@ func main() {
 		GraphService:      *apiFlag,
 		FileTreeService:   *apiFlag,
 		IdentifierService: *apiFlag,
+		LinkService:       *apiFlag,
+		ExploreService:    *apiFlag,
 	})
 	(*apiFlag).Close(ctx)
 	os.Exit(int(status))
@@ -22,7 +22,10 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/apache/beam/sdks/go/pkg/beam/transforms/stats"
@@ -69,14 +72,18 @@ var (
 	beamInternalSharding     flagutil.IntList
 	experimentalColumnarData = flag.Bool("experimental_beam_columnar_data", false, "Whether to emit columnar data from the Beam pipeline implementation")
 	compactTable             = flag.Bool("compact_table", false, "Whether to compact the output LevelDB after its creation")
+
+	incrementalUpdate = flag.Bool("incremental", false, "Update the --out table in place from the entries files given as arguments, one per compilation unit, rather than rebuilding it. Each file's base name (without extension) identifies its compilation unit. The table must have been created in this mode.")
+	removeUnits       flagutil.StringList
 )
 
 func init() {
 	flag.Var(&beamInternalSharding, "beam_internal_sharding", "Controls how database keys are sharded in memory during processing. If the beam pipeline is running out of memory, use this to increase parallelism. Can be specified repeatedly for more control over shard computation. For example, if specified with -beam_internal_sharding 16 -beam_internal_sharding 4, the beam pipeline can use up to 16 machines to compute intermediate sharding information, then up to 4, then 1 to produce the final output. If unspecified, all database keys will be combined on a single machine to compute LevelDB shards.")
+	flag.Var(&removeUnits, "remove_units", "In --incremental mode, a comma-separated list of compilation units to remove from the table")
 	gsutil.Flag(&gs, "graphstore", "GraphStore to read (mutually exclusive with --entries)")
 	flag.Usage = flagutil.SimpleUsage(
 		"Creates a combined xrefs/filetree/search serving table based on a given GraphStore or stream of GraphStore-ordered entries",
-		"(--graphstore spec | --entries path) --out path")
+		"(--graphstore spec | --entries path) --out path\n--incremental [--remove_units unit,...] --out path [unit-entries-path ...]")
 }
 
 func main() {
@@ -95,6 +102,18 @@ func main() {
 		return
 	}
 
+	if *incrementalUpdate {
+		if err := runIncrementalUpdate(ctx); err != nil {
+			log.Fatal("FATAL ERROR: ", err)
+		}
+		if *compactTable {
+			if err := compactLevelDB(*tablePath); err != nil {
+				log.Fatalf("Error compacting LevelDB: %v", err)
+			}
+		}
+		return
+	}
+
 	if gs == nil && *entriesFile == "" {
 		flagutil.UsageError("missing --graphstore or --entries")
 	} else if gs != nil && *entriesFile != "" {
@@ -150,6 +169,62 @@ func compactLevelDB(path string) error {
 	return leveldb.CompactRange(*tablePath, nil)
 }
 
+func runIncrementalUpdate(ctx context.Context) error {
+	if gs != nil || *entriesFile != "" {
+		return errors.New("--graphstore and --entries are not supported with --incremental; pass per-unit entries files as arguments")
+	} else if *tablePath == "" {
+		return errors.New("--out table path required")
+	}
+
+	var updates []*pipeline.UnitUpdate
+	units := make(map[string]string)
+	for _, path := range flag.Args() {
+		unit := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if prev, ok := units[unit]; ok {
+			return fmt.Errorf("entries files %q and %q both name unit %q", prev, path, unit)
+		}
+		units[unit] = path
+		updates = append(updates, &pipeline.UnitUpdate{Unit: unit, Entries: unitEntries(ctx, path)})
+	}
+	for _, unit := range removeUnits {
+		if path, ok := units[unit]; ok {
+			return fmt.Errorf("unit %q is both removed and updated by %q", unit, path)
+		}
+		updates = append(updates, &pipeline.UnitUpdate{Unit: unit})
+	}
+
+	db, err := leveldb.Open(*tablePath, nil)
+	if err != nil {
+		return err
+	}
+	defer db.Close(ctx)
+
+	if err := profile.Start(ctx); err != nil {
+		return err
+	}
+	defer profile.Stop()
+
+	return pipeline.Update(ctx, db, updates, &pipeline.Options{
+		Verbose:        *verbose,
+		MaxPageSize:    *maxPageSize,
+		CompressShards: *compressShards,
+		MaxShardSize:   *maxShardSize,
+	})
+}
+
+// unitEntries returns a reader for the entries file at path, opening it only
+// once read.
+func unitEntries(ctx context.Context, path string) stream.EntryReader {
+	return func(f func(*spb.Entry) error) error {
+		file, err := vfs.Open(ctx, path)
+		if err != nil {
+			return fmt.Errorf("error opening %q: %v", path, err)
+		}
+		defer file.Close()
+		return stream.NewReader(file)(f)
+	}
+}
+
 func runExperimentalBeamPipeline(ctx context.Context) error {
 	if runnerFlag := flag.Lookup("runner"); runnerFlag.Value.String() == "direct" {
 		runnerFlag.Value.Set("disksort")
@@ -157,6 +232,8 @@ func runExperimentalBeamPipeline(ctx context.Context) error {
 
 	if gs != nil {
 		return errors.New("--graphstore input not supported with --experimental_beam_pipeline")
+	} else if *incrementalUpdate {
+		return errors.New("--incremental not supported with --experimental_beam_pipeline")
 	} else if *entriesFile == "" {
 		return errors.New("--entries file path required")
 	} else if *tablePath == "" {
@@ -183,6 +260,8 @@ func runExperimentalBeamPipeline(ctx context.Context) error {
 		InternalSharding: beamInternalSharding,
 		NumQuantiles:     shards,
 	}
+	idMatches, idIndex := k.Identifiers()
+	relatives, callgraphs, typeRelatives, params := k.Explore()
 	if *experimentalColumnarData {
 		beamio.WriteLevelDB(s, *tablePath, opts,
 			createColumnarMetadata(s),
@@ -192,6 +271,8 @@ func runExperimentalBeamPipeline(ctx context.Context) error {
 			k.Directories(),
 			k.Documents(),
 			k.SplitEdges(),
+			idMatches, idIndex,
+			relatives, callgraphs, typeRelatives, params,
 		)
 	} else {
 		edgeSets, edgePages := k.Edges()
@@ -203,6 +284,8 @@ func runExperimentalBeamPipeline(ctx context.Context) error {
 			k.Documents(),
 			xrefSets, xrefPages,
 			edgeSets, edgePages,
+			idMatches, idIndex,
+			relatives, callgraphs, typeRelatives, params,
 		)
 	}
 
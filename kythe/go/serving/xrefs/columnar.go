@@ -23,6 +23,7 @@ import (
 	"io"
 	"log"
 	"regexp"
+	"strings"
 
 	"kythe.io/kythe/go/services/xrefs"
 	"kythe.io/kythe/go/serving/xrefs/columnar"
@@ -81,10 +82,6 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 		return nil, status.Errorf(codes.InvalidArgument, "missing requested Location span: %v", req.Location)
 	}
 
-	// TODO(schroederc): handle SPAN requests
-	// TODO(schroederc): handle dirty buffers
-	// TODO(schroederc): file infos
-
 	fileURI, err := kytheuri.Parse(ticket)
 	if err != nil {
 		return nil, err
@@ -111,6 +108,7 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 	if err := proto.Unmarshal(val, &idx); err != nil {
 		return nil, fmt.Errorf("error decoding index: %v", err)
 	}
+	fileInfos := makeFileInfoMap(idx.FileInfo)
 
 	// Setup reply state based on request
 	reply := &xpb.DecorationsReply{Location: req.Location}
@@ -122,7 +120,8 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 	}
 
 	// Setup scanning state for constructing reply
-	var patcher *span.Patcher
+	var text []byte                                                    // file contents; assembled from each Text entry
+	var patcher *span.Patcher                                          // dirty buffer patcher for anchors/diagnostics
 	var norm *span.Normalizer                                          // span normalizer for references
 	refsByTarget := make(map[string][]*xpb.DecorationsReply_Reference) // target -> set<Reference>
 	defs := stringset.New()                                            // set<needed definition tickets>
@@ -134,6 +133,53 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 	var startBoundary, endBoundary int32
 	spanKind := req.SpanKind
 
+	// initText sets up the reply and scanning state dependent on the file's
+	// contents.  It must be called once all Text entries have been read and
+	// before any other entry is handled.
+	initText := func() error {
+		if text == nil {
+			// There were no Text entries for the file; only its diagnostics (if
+			// any) may be returned.
+			return nil
+		}
+		if len(req.DirtyBuffer) > 0 {
+			var err error
+			patcher, err = span.NewPatcher(text, req.DirtyBuffer)
+			if err != nil {
+				return status.Errorf(codes.Internal, "error patching decorations for %s: %v", req.Location.Ticket, err)
+			}
+			text = req.DirtyBuffer
+		}
+		norm = span.NewNormalizer(text)
+
+		loc, err := norm.Location(req.GetLocation())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid Location: %v", err)
+		}
+		reply.Location = loc
+		reply.Revision = fileInfos[loc.GetTicket()].GetRevision()
+
+		if loc.Kind == xpb.Location_FILE {
+			startBoundary = 0
+			endBoundary = int32(len(text))
+			spanKind = xpb.DecorationsRequest_WITHIN_SPAN
+		} else {
+			startBoundary = loc.Span.Start.ByteOffset
+			endBoundary = loc.Span.End.ByteOffset
+		}
+
+		if req.SourceText {
+			reply.Encoding = idx.TextEncoding
+			if loc.Kind == xpb.Location_FILE {
+				reply.SourceText = text
+			} else {
+				reply.SourceText = text[loc.Span.Start.ByteOffset:loc.Span.End.ByteOffset]
+			}
+		}
+		return nil
+	}
+	textDone := false
+
 	// Main loop to scan over each columnar kv entry.
 	for {
 		k, val, err := it.Next()
@@ -150,45 +196,24 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 			return nil, err
 		}
 
-		switch e := e.Entry.(type) {
-		case *xspb.FileDecorations_Text_:
-			// TODO(danielnorberg): Move the handling of this entry type up out of the loop to
-			//                      ensure that variables used in other cases have been assigned
-			text := e.Text.Text
-			if len(req.DirtyBuffer) > 0 {
-				patcher, err = span.NewPatcher(text, req.DirtyBuffer)
-				if err != nil {
-					return nil, status.Errorf(codes.Internal, "error patching decorations for %s: %v", req.Location.Ticket, err)
-				}
-				text = req.DirtyBuffer
-			}
-			norm = span.NewNormalizer(text)
-
-			loc, err := norm.Location(req.GetLocation())
-			if err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid Location: %v", err)
+		if t, ok := e.Entry.(*xspb.FileDecorations_Text_); ok {
+			// Text entries are ordered first by their columnar key (see
+			// kythe/proto/xref_serving.proto) and by their starting offset.
+			if textDone {
+				return nil, fmt.Errorf("out-of-order FileDecorations text entry: [%d, %d)", t.Text.StartOffset, t.Text.EndOffset)
 			}
-			reply.Location = loc
-
-			if loc.Kind == xpb.Location_FILE {
-				startBoundary = 0
-				endBoundary = int32(len(text))
-				spanKind = xpb.DecorationsRequest_WITHIN_SPAN
-			} else {
-				startBoundary = loc.Span.Start.ByteOffset
-				endBoundary = loc.Span.End.ByteOffset
+			text = append(text, t.Text.Text...)
+			continue
+		} else if !textDone {
+			textDone = true
+			if err := initText(); err != nil {
+				return nil, err
 			}
+		}
 
-			if req.SourceText {
-				reply.Encoding = idx.TextEncoding
-				if loc.Kind == xpb.Location_FILE {
-					reply.SourceText = text
-				} else {
-					reply.SourceText = text[loc.Span.Start.ByteOffset:loc.Span.End.ByteOffset]
-				}
-			}
+		switch e := e.Entry.(type) {
 		case *xspb.FileDecorations_Target_:
-			if !req.References {
+			if !req.References || norm == nil {
 				// TODO(schroederc): seek to next group
 				continue
 			}
@@ -213,12 +238,14 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 				Kind:         kind,
 				Span:         norm.SpanOffsets(start, end),
 			}
+			// Populate any target revision, if known
+			ref.TargetRevision = fileInfos[ref.TargetTicket].GetRevision()
 			refsByTarget[ref.TargetTicket] = append(refsByTarget[ref.TargetTicket], ref)
 			reply.Reference = append(reply.Reference, ref)
 		case *xspb.FileDecorations_TargetOverride_:
 			// TODO(schroederc): handle
 		case *xspb.FileDecorations_TargetNode_:
-			if len(patterns) == 0 {
+			if len(patterns) == 0 || norm == nil {
 				// TODO(schroederc): seek to next group
 				continue
 			}
@@ -251,7 +278,7 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 			if !defs.Contains(def.Location.Ticket) {
 				continue
 			}
-			reply.DefinitionLocations[def.Location.Ticket] = a2a(def.Location, nil, emitSnippets).Anchor
+			reply.DefinitionLocations[def.Location.Ticket] = a2a(def.Location, fileInfos, emitSnippets).Anchor
 		case *xspb.FileDecorations_Override_:
 			// TODO(schroederc): handle
 		case *xspb.FileDecorations_Diagnostic_:
@@ -259,7 +286,7 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 				continue
 			}
 			diag := e.Diagnostic.Diagnostic
-			if diag.Span == nil {
+			if diag.Span == nil || norm == nil {
 				reply.Diagnostic = append(reply.Diagnostic, diag)
 			} else {
 				start, end, exists := patcher.PatchSpan(diag.Span)
@@ -277,10 +304,29 @@ func (c *ColumnarTable) Decorations(ctx context.Context, req *xpb.DecorationsReq
 			return nil, fmt.Errorf("unknown FileDecorations entry: %T", e)
 		}
 	}
+	if !textDone {
+		if err := initText(); err != nil {
+			return nil, err
+		}
+	}
 	if err := it.Close(); err != nil {
 		return nil, err
 	}
 
+	if norm == nil {
+		// FileDecorations may be saved without the file's text if the file does
+		// not exist in the index but related diagnostics do exist.  If
+		// diagnostics were requested, we may return them successfully, but
+		// otherwise, an error indicating a missing file is returned.
+		if len(reply.Diagnostic) == 0 {
+			return nil, xrefs.ErrDecorationsNotFound
+		}
+		return &xpb.DecorationsReply{
+			Location:   req.Location,
+			Diagnostic: reply.Diagnostic,
+		}, nil
+	}
+
 	return reply, nil
 }
 
@@ -299,8 +345,16 @@ func addXRefNode(reply *xpb.CrossReferencesReply, patterns []*regexp.Regexp, n *
 
 // CrossReferences implements part of the xrefs.Service interface.
 func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest) (*xpb.CrossReferencesReply, error) {
+	stats, err := newRefStats(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	initialSkip := stats.skip
+
 	reply := &xpb.CrossReferencesReply{
 		CrossReferences: make(map[string]*xpb.CrossReferencesReply_CrossReferenceSet),
+
+		Total: &xpb.CrossReferencesReply_Total{},
 	}
 
 	relatedNodes := stringset.New()
@@ -308,16 +362,32 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 	relatedKinds := stringset.New(req.RelatedNodeKind...)
 	if len(patterns) > 0 {
 		reply.Nodes = make(map[string]*cpb.NodeInfo)
+		reply.Total.RelatedNodesByRelation = make(map[string]int64)
 	}
 	if req.NodeDefinitions {
 		reply.DefinitionLocations = make(map[string]*xpb.Anchor)
 	}
 	emitSnippets := req.Snippets != xpb.SnippetsKind_NONE
 
+	totalsQuality := req.TotalsQuality
+	if totalsQuality == xpb.CrossReferencesRequest_UNSPECIFIED_TOTALS {
+		totalsQuality = xpb.CrossReferencesRequest_TotalsQuality(xpb.CrossReferencesRequest_TotalsQuality_value[strings.ToUpper(*defaultTotalsQuality)])
+	}
+
 	// TODO(schroederc): file infos
-	// TODO(schroederc): implement paging xrefs in large CrossReferencesReply messages
 
-	for _, ticket := range req.Ticket {
+	tickets := req.Ticket
+	mergeInto := make(map[string]string)
+	for _, ticket := range tickets {
+		mergeInto[ticket] = ticket
+	}
+
+	for i := 0; i < len(tickets); i++ {
+		if totalsQuality == xpb.CrossReferencesRequest_APPROXIMATE_TOTALS && stats.done() {
+			break
+		}
+
+		ticket := tickets[i]
 		uri, err := kytheuri.Parse(ticket)
 		if err != nil {
 			return nil, err
@@ -345,18 +415,33 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 			return nil, fmt.Errorf("error decoding index: %v", err)
 		}
 		idx.Node.Source = uri.VName()
-		addXRefNode(reply, patterns, idx.Node)
 
-		// TODO(schroederc): handle merge_with
+		// If this node is to be merged into another, we will use that node's ticket
+		// for all further book-keeping purposes.
+		root := mergeInto[ticket]
+
+		// We may have partially completed the xrefs set due merge nodes.
+		set := reply.CrossReferences[root]
+		if set == nil {
+			set = &xpb.CrossReferencesReply_CrossReferenceSet{Ticket: root}
+			reply.CrossReferences[root] = set
+		}
+		if set.MarkedSource == nil {
+			set.MarkedSource = idx.MarkedSource
+		}
+		if ticket == root {
+			addXRefNode(reply, patterns, idx.Node)
+		}
 
-		set := &xpb.CrossReferencesReply_CrossReferenceSet{
-			Ticket:       ticket,
-			MarkedSource: idx.MarkedSource,
+		if *mergeCrossReferences {
+			// Add any additional merge nodes to the set of table lookups
+			for _, mergeNode := range idx.MergeWith {
+				tickets = addMergeNode(mergeInto, tickets, root, kytheuri.ToString(mergeNode))
+			}
 		}
-		reply.CrossReferences[ticket] = set
 
 		// TODO remove callers without callsites
-		callers := make(map[string]*xpb.CrossReferencesReply_RelatedAnchor)
+		callers := make(map[string]*xpb.CrossReferencesReply_RelatedAnchor) // nil for callers outside of the page
 
 		// Main loop to scan over each columnar kv entry.
 		for {
@@ -381,13 +466,16 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 				var anchors *[]*xpb.CrossReferencesReply_RelatedAnchor
 				switch {
 				case xrefs.IsDefKind(req.DefinitionKind, kind, false):
+					reply.Total.Definitions++
 					anchors = &set.Definition
 				case xrefs.IsDeclKind(req.DeclarationKind, kind, false):
+					reply.Total.Declarations++
 					anchors = &set.Declaration
 				case xrefs.IsRefKind(req.ReferenceKind, kind):
+					reply.Total.References++
 					anchors = &set.Reference
 				}
-				if anchors != nil {
+				if anchors != nil && stats.next() {
 					a := a2a(ref.Location, nil, emitSnippets).Anchor
 					a.Ticket = ""
 					ra := &xpb.CrossReferencesReply_RelatedAnchor{Anchor: a}
@@ -406,6 +494,10 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 					kind = "%" + kind
 				}
 				if xrefs.IsRelatedNodeKind(relatedKinds, kind) {
+					reply.Total.RelatedNodesByRelation[kind]++
+					if !stats.next() {
+						continue
+					}
 					relatedNode := kytheuri.ToString(rel.Node)
 					relatedNodes.Add(relatedNode)
 					set.RelatedNode = append(set.RelatedNode, &xpb.CrossReferencesReply_RelatedNode{
@@ -436,9 +528,14 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 					continue
 				}
 				c := e.Caller
+				callerTicket := kytheuri.ToString(c.Caller)
+				reply.Total.Callers++
+				if !stats.next() {
+					callers[callerTicket] = nil
+					continue
+				}
 				a := a2a(c.Location, nil, emitSnippets).Anchor
 				a.Ticket = ""
-				callerTicket := kytheuri.ToString(c.Caller)
 				caller := &xpb.CrossReferencesReply_RelatedAnchor{
 					Anchor:       a,
 					MarkedSource: c.MarkedSource,
@@ -452,10 +549,12 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 					(req.CallerKind == xpb.CrossReferencesRequest_DIRECT_CALLERS && c.Kind == xspb.CrossReferences_Callsite_OVERRIDE) {
 					continue
 				}
-				caller := callers[kytheuri.ToString(c.Caller)]
-				if caller == nil {
+				caller, ok := callers[kytheuri.ToString(c.Caller)]
+				if !ok {
 					log.Printf("WARNING: missing Caller for callsite: %+v", c)
 					continue
+				} else if caller == nil {
+					continue // caller is not within the requested page
 				}
 				a := a2a(c.Location, nil, emitSnippets).Anchor
 				a.Ticket = ""
@@ -467,9 +566,19 @@ func (c *ColumnarTable) CrossReferences(ctx context.Context, req *xpb.CrossRefer
 		}
 	}
 
+	if reply.NextPageToken, err = stats.nextPageToken(initialSkip, reply.Total); err != nil {
+		return nil, err
+	}
+
 	return reply, nil
 }
 
+// StreamCrossReferences implements part of the xrefs.StreamingService
+// interface.  The columnar table is read a page at a time.
+func (c *ColumnarTable) StreamCrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	return xrefs.StreamPages(ctx, c, req, f)
+}
+
 func getRefKind(ref *xspb.CrossReferences_Reference) string {
 	if k := ref.GetGenericKind(); k != "" {
 		return k
@@ -256,6 +256,12 @@ func decodeDecorText(file *spb.VName, key string, val []byte) (*xspb.FileDecorat
 	if err := proto.Unmarshal(val, &text); err != nil {
 		return nil, err
 	}
+	key, err := keys.Parse(key, &text.StartOffset, &text.EndOffset)
+	if err != nil {
+		return nil, err
+	} else if key != "" {
+		return nil, fmt.Errorf("unexpected Text key suffix: %q", key)
+	}
 	return &xspb.FileDecorations{
 		File:  file,
 		Entry: &xspb.FileDecorations_Text_{&text},
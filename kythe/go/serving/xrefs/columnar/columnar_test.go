@@ -36,11 +36,17 @@ func TestDecorationsEncodingRoundtrip(t *testing.T) {
 		File: file,
 		Entry: &xspb.FileDecorations_Index_{&xspb.FileDecorations_Index{
 			TextEncoding: "some_encoding",
+			FileInfo: []*srvpb.FileInfo{{
+				CorpusPath: &cpb.CorpusPath{Corpus: "corpus", Root: "root", Path: "path"},
+				Revision:   "rev",
+			}},
 		}},
 	}, {
 		File: file,
 		Entry: &xspb.FileDecorations_Text_{&xspb.FileDecorations_Text{
-			Text: []byte("some file text\n"),
+			StartOffset: 0,
+			EndOffset:   15,
+			Text:        []byte("some file text\n"),
 		}},
 	}, {
 		File: file,
@@ -330,9 +330,183 @@ func TestServingDecorations(t *testing.T) {
 		},
 	}))
 
-	// TODO(schroederc): test split file contents
 	// TODO(schroederc): test overrides
-	// TODO(schroederc): test diagnostics (w/ or w/o span)
+}
+
+func TestServingDecorationsSpans(t *testing.T) {
+	ctx := context.Background()
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mark table as columnar
+	mustWrite(t, w, []byte(ColumnarTableKeyMarker), []byte{})
+
+	file := &spb.VName{Corpus: "c", Path: "split"}
+	missing := &spb.VName{Corpus: "c", Path: "missing"}
+	other := &spb.VName{Corpus: "c", Path: "other"}
+	noSpanDiag := &cpb.Diagnostic{Message: "file diagnostic"}
+	spanDiag := &cpb.Diagnostic{
+		Message: "span diagnostic",
+		Span: &cpb.Span{
+			Start: &cpb.Point{ByteOffset: 5},
+			End:   &cpb.Point{ByteOffset: 9},
+		},
+	}
+	decor := []*xspb.FileDecorations{{
+		File: file,
+		Entry: &xspb.FileDecorations_Index_{&xspb.FileDecorations_Index{
+			FileInfo: []*srvpb.FileInfo{{
+				CorpusPath: &cpb.CorpusPath{Corpus: "c", Path: "split"},
+				Revision:   "r1",
+			}, {
+				CorpusPath: &cpb.CorpusPath{Corpus: "c", Path: "other"},
+				Revision:   "r2",
+			}},
+		}},
+	}, {
+		File: file,
+		Entry: &xspb.FileDecorations_Text_{&xspb.FileDecorations_Text{
+			StartOffset: 0,
+			EndOffset:   5,
+			Text:        []byte("some "),
+		}},
+	}, {
+		File: file,
+		Entry: &xspb.FileDecorations_Text_{&xspb.FileDecorations_Text{
+			StartOffset: 5,
+			EndOffset:   10,
+			Text:        []byte("text\n"),
+		}},
+	}, {
+		File: file,
+		Entry: &xspb.FileDecorations_Target_{&xspb.FileDecorations_Target{
+			StartOffset: 0,
+			EndOffset:   4,
+			Kind:        &xspb.FileDecorations_Target_KytheKind{scpb.EdgeKind_REF},
+			Target:      other,
+		}},
+	}, {
+		File: file,
+		Entry: &xspb.FileDecorations_Target_{&xspb.FileDecorations_Target{
+			StartOffset: 5,
+			EndOffset:   9,
+			Kind:        &xspb.FileDecorations_Target_KytheKind{scpb.EdgeKind_REF},
+			Target:      &spb.VName{Signature: "sig"},
+		}},
+	}, {
+		File:  file,
+		Entry: &xspb.FileDecorations_Diagnostic_{&xspb.FileDecorations_Diagnostic{Diagnostic: noSpanDiag}},
+	}, {
+		File:  file,
+		Entry: &xspb.FileDecorations_Diagnostic_{&xspb.FileDecorations_Diagnostic{Diagnostic: spanDiag}},
+	}, {
+		File:  missing,
+		Entry: &xspb.FileDecorations_Index_{&xspb.FileDecorations_Index{}},
+	}, {
+		File:  missing,
+		Entry: &xspb.FileDecorations_Diagnostic_{&xspb.FileDecorations_Diagnostic{Diagnostic: noSpanDiag}},
+	}}
+	for _, fd := range decor {
+		mustWriteDecor(t, w, fd)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	xs := NewService(ctx, db)
+
+	fileTicket := kytheuri.ToString(file)
+	point := func(offset int32) *cpb.Point {
+		return &cpb.Point{ByteOffset: offset, ColumnOffset: offset, LineNumber: 1}
+	}
+	textRef := &xpb.DecorationsReply_Reference{
+		Span:         &cpb.Span{Start: point(5), End: point(9)},
+		Kind:         "/kythe/edge/ref",
+		TargetTicket: "kythe:#sig",
+	}
+
+	t.Run("split_text", makeDecorTestCase(ctx, xs, &xpb.DecorationsRequest{
+		Location:   &xpb.Location{Ticket: fileTicket},
+		SourceText: true,
+		References: true,
+	}, &xpb.DecorationsReply{
+		Location:   &xpb.Location{Ticket: fileTicket},
+		Revision:   "r1",
+		SourceText: []byte("some text\n"),
+		Reference: []*xpb.DecorationsReply_Reference{{
+			Span:           &cpb.Span{Start: point(0), End: point(4)},
+			Kind:           "/kythe/edge/ref",
+			TargetTicket:   kytheuri.ToString(other),
+			TargetRevision: "r2",
+		}, textRef},
+	}))
+
+	spanLoc := &xpb.Location{
+		Ticket: fileTicket,
+		Kind:   xpb.Location_SPAN,
+		Span:   &cpb.Span{Start: point(5), End: point(9)},
+	}
+	t.Run("within_span", makeDecorTestCase(ctx, xs, &xpb.DecorationsRequest{
+		Location: &xpb.Location{
+			Ticket: fileTicket,
+			Kind:   xpb.Location_SPAN,
+			Span:   &cpb.Span{Start: &cpb.Point{ByteOffset: 5}, End: &cpb.Point{ByteOffset: 9}},
+		},
+		SourceText:  true,
+		References:  true,
+		Diagnostics: true,
+	}, &xpb.DecorationsReply{
+		Location:   spanLoc,
+		Revision:   "r1",
+		SourceText: []byte("text"),
+		Reference:  []*xpb.DecorationsReply_Reference{textRef},
+		Diagnostic: []*cpb.Diagnostic{noSpanDiag, {
+			Message: "span diagnostic",
+			Span:    &cpb.Span{Start: point(5), End: point(9)},
+		}},
+	}))
+
+	t.Run("around_span", makeDecorTestCase(ctx, xs, &xpb.DecorationsRequest{
+		Location: &xpb.Location{
+			Ticket: fileTicket,
+			Kind:   xpb.Location_SPAN,
+			Span:   &cpb.Span{Start: &cpb.Point{ByteOffset: 6}, End: &cpb.Point{ByteOffset: 7}},
+		},
+		SpanKind:   xpb.DecorationsRequest_AROUND_SPAN,
+		References: true,
+	}, &xpb.DecorationsReply{
+		Location: &xpb.Location{
+			Ticket: fileTicket,
+			Kind:   xpb.Location_SPAN,
+			Span:   &cpb.Span{Start: point(6), End: point(7)},
+		},
+		Revision:  "r1",
+		Reference: []*xpb.DecorationsReply_Reference{textRef},
+	}))
+
+	missingTicket := kytheuri.ToString(missing)
+	t.Run("missing_text_diagnostics", makeDecorTestCase(ctx, xs, &xpb.DecorationsRequest{
+		Location:    &xpb.Location{Ticket: missingTicket},
+		References:  true,
+		Diagnostics: true,
+	}, &xpb.DecorationsReply{
+		Location:   &xpb.Location{Ticket: missingTicket},
+		Diagnostic: []*cpb.Diagnostic{noSpanDiag},
+	}))
+
+	t.Run("missing_text", func(t *testing.T) {
+		_, err := xs.Decorations(ctx, &xpb.DecorationsRequest{
+			Location:   &xpb.Location{Ticket: missingTicket},
+			References: true,
+		})
+		if err != xrefs.ErrDecorationsNotFound {
+			t.Fatalf("Expected ErrDecorationsNotFound; found: %v", err)
+		}
+	})
 }
 
 func makeDecorTestCase(ctx context.Context, xs xrefs.Service, req *xpb.DecorationsRequest, expected *xpb.DecorationsReply) func(*testing.T) {
@@ -543,6 +717,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Filter:          []string{"**"},
 		RelatedNodeKind: []string{"NONE"},
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -562,6 +737,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:        []string{ticket},
 		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 2},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -575,6 +751,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:          []string{ticket},
 		DeclarationKind: xpb.CrossReferencesRequest_ALL_DECLARATIONS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Declarations: 2},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -588,6 +765,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:         []string{ticket},
 		DefinitionKind: xpb.CrossReferencesRequest_ALL_DEFINITIONS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Definitions: 2},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -601,6 +779,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:         []string{ticket},
 		DefinitionKind: xpb.CrossReferencesRequest_FULL_DEFINITIONS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Definitions: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -614,6 +793,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:         []string{ticket},
 		DefinitionKind: xpb.CrossReferencesRequest_BINDING_DEFINITIONS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Definitions: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -628,6 +808,10 @@ func TestServingCrossReferences(t *testing.T) {
 		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
 		Filter:        []string{"**"},
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			References:             2,
+			RelatedNodesByRelation: map[string]int64{"%/kythe/edge/childof": 1},
+		},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -658,6 +842,9 @@ func TestServingCrossReferences(t *testing.T) {
 		Filter:          []string{facts.NodeKind},
 		NodeDefinitions: true,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{
+			RelatedNodesByRelation: map[string]int64{"%/kythe/edge/childof": 1},
+		},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -688,6 +875,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:        []string{ticket},
 		ReferenceKind: xpb.CrossReferencesRequest_NON_CALL_REFERENCES,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{References: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -701,6 +889,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:     []string{ticket},
 		CallerKind: xpb.CrossReferencesRequest_OVERRIDE_CALLERS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Callers: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -728,6 +917,7 @@ func TestServingCrossReferences(t *testing.T) {
 		Ticket:     []string{ticket},
 		CallerKind: xpb.CrossReferencesRequest_DIRECT_CALLERS,
 	}, &xpb.CrossReferencesReply{
+		Total: &xpb.CrossReferencesReply_Total{Callers: 1},
 		CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
 			ticket: {
 				Ticket:       ticket,
@@ -749,6 +939,127 @@ func TestServingCrossReferences(t *testing.T) {
 	}))
 }
 
+func TestServingCrossReferencesPaging(t *testing.T) {
+	ctx := context.Background()
+	db := inmemory.NewKeyValueDB()
+	w, err := db.Writer(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mark table as columnar
+	mustWrite(t, w, []byte(ColumnarTableKeyMarker), []byte{})
+
+	root := &spb.VName{Signature: "root"}
+	merged := &spb.VName{Signature: "merged"}
+	ms := &cpb.MarkedSource{
+		Kind:    cpb.MarkedSource_IDENTIFIER,
+		PreText: "root",
+	}
+	span := &cpb.Span{
+		Start: &cpb.Point{ByteOffset: 5, ColumnOffset: 5, LineNumber: 1},
+		End:   &cpb.Point{ByteOffset: 9, ColumnOffset: 9, LineNumber: 1},
+	}
+	ref := func(src *spb.VName, path string) *xspb.CrossReferences {
+		return &xspb.CrossReferences{
+			Source: src,
+			Entry: &xspb.CrossReferences_Reference_{&xspb.CrossReferences_Reference{
+				Kind: &xspb.CrossReferences_Reference_KytheKind{scpb.EdgeKind_REF},
+				Location: &srvpb.ExpandedAnchor{
+					Ticket: "kythe:?path=" + path + "#ref",
+					Span:   span,
+				},
+			}},
+		}
+	}
+	xrefs := []*xspb.CrossReferences{{
+		Source: root,
+		Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+			Node:         &scpb.Node{},
+			MarkedSource: ms,
+			MergeWith:    []*spb.VName{merged},
+		}},
+	},
+		ref(root, "path1"),
+		ref(root, "path2"),
+		ref(root, "path3"),
+		{
+			Source: merged,
+			Entry: &xspb.CrossReferences_Index_{&xspb.CrossReferences_Index{
+				Node: &scpb.Node{},
+				MarkedSource: &cpb.MarkedSource{
+					Kind:    cpb.MarkedSource_IDENTIFIER,
+					PreText: "merged",
+				},
+			}},
+		},
+		ref(merged, "path4"),
+		ref(merged, "path5"),
+	}
+	for _, xr := range xrefs {
+		mustWriteXRef(t, w, xr)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	xs := NewService(ctx, db)
+
+	var expected []*xpb.CrossReferencesReply_RelatedAnchor
+	for _, path := range []string{"path1", "path2", "path3", "path4", "path5"} {
+		expected = append(expected, &xpb.CrossReferencesReply_RelatedAnchor{
+			Anchor: &xpb.Anchor{
+				Parent: "kythe:?path=" + path,
+				Span:   span,
+			},
+		})
+	}
+
+	ticket := kytheuri.ToString(root)
+	req := &xpb.CrossReferencesRequest{
+		Ticket:        []string{ticket},
+		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
+		TotalsQuality: xpb.CrossReferencesRequest_PRECISE_TOTALS,
+		PageSize:      2,
+	}
+	var (
+		pages int
+		found []*xpb.CrossReferencesReply_RelatedAnchor
+	)
+	for {
+		reply, err := xs.CrossReferences(ctx, req)
+		if err != nil {
+			t.Fatalf("CrossReferences error: %v", err)
+		}
+		pages++
+
+		if diff := compare.ProtoDiff(&xpb.CrossReferencesReply_Total{References: 5}, reply.Total); diff != "" {
+			t.Errorf("Page %d: CrossReferencesReply_Total differences: (- expected; + found)\n%s", pages, diff)
+		}
+		if len(reply.CrossReferences) != 1 {
+			t.Fatalf("Page %d: expected a single merged CrossReferenceSet; found %v", pages, reply.CrossReferences)
+		}
+		set := reply.CrossReferences[ticket]
+		if diff := compare.ProtoDiff(ms, set.GetMarkedSource()); diff != "" {
+			t.Errorf("Page %d: MarkedSource differences: (- expected; + found)\n%s", pages, diff)
+		}
+		found = append(found, set.GetReference()...)
+
+		if reply.NextPageToken == "" {
+			break
+		} else if pages > 3 {
+			t.Fatalf("Too many pages: %d", pages)
+		}
+		req.PageToken = reply.NextPageToken
+	}
+
+	if pages != 3 {
+		t.Errorf("Expected 3 pages; found %d", pages)
+	}
+	if diff := compare.ProtoDiff(expected, found); diff != "" {
+		t.Errorf("Paged references differences: (- expected; + found)\n%s", diff)
+	}
+}
+
 func makeXRefTestCase(ctx context.Context, xs xrefs.Service, req *xpb.CrossReferencesRequest, expected *xpb.CrossReferencesReply) func(*testing.T) {
 	return func(t *testing.T) {
 		reply, err := xs.CrossReferences(ctx, req)
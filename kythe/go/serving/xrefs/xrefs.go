@@ -30,6 +30,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"math"
 	"regexp"
 	"strings"
 
@@ -461,43 +462,35 @@ func decorationToReference(norm *span.Normalizer, d *srvpb.FileDecorations_Decor
 
 // CrossReferences implements part of the xrefs.Service interface.
 func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest) (*xpb.CrossReferencesReply, error) {
+	return t.crossReferencesReply(ctx, req, nil)
+}
+
+// StreamCrossReferences implements part of the xrefs.StreamingService
+// interface.  A reply is sent for each group of anchors, callers, or related
+// nodes as it is read from the table, followed by a final reply holding only
+// the totals.
+func (t *Table) StreamCrossReferences(ctx context.Context, req *xpb.CrossReferencesRequest, f func(*xpb.CrossReferencesReply) error) error {
+	_, err := t.crossReferencesReply(ctx, req, f)
+	return err
+}
+
+// crossReferencesReply returns the requested page of cross-references.  If
+// stream != nil, the request's paging fields are ignored and the
+// cross-references are instead passed to stream as they are read.
+func (t *Table) crossReferencesReply(ctx context.Context, req *xpb.CrossReferencesRequest, stream func(*xpb.CrossReferencesReply) error) (*xpb.CrossReferencesReply, error) {
 	tickets, err := xrefs.FixTickets(req.Ticket)
 	if err != nil {
 		return nil, err
 	}
 
-	stats := refStats{
-		max: int(req.PageSize),
-	}
-	if stats.max < 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size: %d", req.PageSize)
-	} else if stats.max == 0 {
-		stats.max = defaultPageSize
-	} else if stats.max > maxPageSize {
-		stats.max = maxPageSize
-	}
-
-	var pageToken ipb.PageToken
-	if req.PageToken != "" {
-		rec, err := base64.StdEncoding.DecodeString(req.PageToken)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.PageToken)
-		}
-		rec, err = snappy.Decode(nil, rec)
+	stats := &refStats{max: math.MaxInt32}
+	if stream == nil {
+		stats, err = newRefStats(req.PageSize, req.PageToken)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.PageToken)
-		}
-		if err := proto.Unmarshal(rec, &pageToken); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.PageToken)
-		}
-		for _, index := range pageToken.Indices {
-			if index < 0 {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", req.PageToken)
-			}
+			return nil, err
 		}
 	}
-	initialSkip := int(pageToken.Indices["skip"])
-	stats.skip = initialSkip
+	initialSkip := stats.skip
 
 	reply := &xpb.CrossReferencesReply{
 		CrossReferences: make(map[string]*xpb.CrossReferencesReply_CrossReferenceSet, len(req.Ticket)),
@@ -515,11 +508,6 @@ func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesReq
 	buildConfigs := stringset.New(req.BuildConfig...)
 	patterns := xrefs.ConvertFilters(req.Filter)
 
-	nextPageToken := &ipb.PageToken{
-		SubTokens: make(map[string]string),
-		Indices:   make(map[string]int32),
-	}
-
 	mergeInto := make(map[string]string)
 	for _, ticket := range tickets {
 		mergeInto[ticket] = ticket
@@ -538,12 +526,61 @@ func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesReq
 		totalsQuality = xpb.CrossReferencesRequest_TotalsQuality(xpb.CrossReferencesRequest_TotalsQuality_value[strings.ToUpper(*defaultTotalsQuality)])
 	}
 
+	// In streaming mode, flush sends the cross-references accumulated in crs
+	// along with any new Nodes and DefinitionLocations.
+	var sentNodes, sentDefs stringset.Set
+	flush := func(crs *xpb.CrossReferencesReply_CrossReferenceSet) error {
+		if stream == nil || !hasCrossReferences(crs) {
+			return nil
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+		chunk := &xpb.CrossReferencesReply{
+			CrossReferences: map[string]*xpb.CrossReferencesReply_CrossReferenceSet{
+				crs.Ticket: {
+					Ticket:       crs.Ticket,
+					MarkedSource: crs.MarkedSource,
+					Definition:   crs.Definition,
+					Declaration:  crs.Declaration,
+					Reference:    crs.Reference,
+					Caller:       crs.Caller,
+					RelatedNode:  crs.RelatedNode,
+				},
+			},
+		}
+		crs.Definition, crs.Declaration, crs.Reference, crs.Caller, crs.RelatedNode = nil, nil, nil, nil, nil
+		for ticket, n := range reply.Nodes {
+			if sentNodes.Add(ticket) {
+				if chunk.Nodes == nil {
+					chunk.Nodes = make(map[string]*cpb.NodeInfo)
+				}
+				chunk.Nodes[ticket] = n
+			}
+		}
+		for ticket, a := range reply.DefinitionLocations {
+			if sentDefs.Add(ticket) {
+				if chunk.DefinitionLocations == nil {
+					chunk.DefinitionLocations = make(map[string]*xpb.Anchor)
+				}
+				chunk.DefinitionLocations[ticket] = a
+			}
+		}
+		if req.Snippets == xpb.SnippetsKind_NONE {
+			clearReplySnippets(chunk)
+		}
+		return stream(chunk)
+	}
+
 	var foundCrossRefs bool
 	for i := 0; i < len(tickets); i++ {
 		if totalsQuality == xpb.CrossReferencesRequest_APPROXIMATE_TOTALS && stats.done() {
 			break
 		}
 
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		ticket := tickets[i]
 		cr, err := t.crossReferences(ctx, ticket)
 		if err == table.ErrNoSuchKey {
@@ -631,6 +668,9 @@ func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesReq
 					stats.addCallers(crs, grp)
 				}
 			}
+			if err := flush(crs); err != nil {
+				return nil, err
+			}
 		}
 
 		for _, idx := range cr.PageIndex {
@@ -704,9 +744,13 @@ func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesReq
 					stats.addCallers(crs, p.Group)
 				}
 			}
+			if err := flush(crs); err != nil {
+				return nil, err
+			}
 		}
 
-		if len(crs.Declaration) > 0 || len(crs.Definition) > 0 || len(crs.Reference) > 0 || len(crs.Caller) > 0 || len(crs.RelatedNode) > 0 {
+		// Streamed sets are kept so that merge nodes share their ticket's set.
+		if stream != nil || hasCrossReferences(crs) {
 			reply.CrossReferences[crs.Ticket] = crs
 			tracePrintf(ctx, "CrossReferenceSet: %s", crs.Ticket)
 		}
@@ -714,41 +758,43 @@ func (t *Table) CrossReferences(ctx context.Context, req *xpb.CrossReferencesReq
 	if !foundCrossRefs {
 		// Short-circuit return; skip any slow requests.
 		return &xpb.CrossReferencesReply{}, nil
+	} else if stream != nil {
+		return nil, stream(&xpb.CrossReferencesReply{Total: reply.Total})
 	}
 
-	if initialSkip+stats.total != sumTotalCrossRefs(reply.Total) && stats.total != 0 {
-		nextPageToken.Indices["skip"] = int32(initialSkip + stats.total)
+	if reply.NextPageToken, err = stats.nextPageToken(initialSkip, reply.Total); err != nil {
+		return nil, err
 	}
 
-	if _, skip := nextPageToken.Indices["skip"]; skip {
-		rec, err := proto.Marshal(nextPageToken)
-		if err != nil {
-			return nil, fmt.Errorf("internal error: error marshalling page token: %v", err)
-		}
-		reply.NextPageToken = base64.StdEncoding.EncodeToString(snappy.Encode(nil, rec))
+	if req.Snippets == xpb.SnippetsKind_NONE {
+		clearReplySnippets(reply)
 	}
 
-	if req.Snippets == xpb.SnippetsKind_NONE {
-		for _, crs := range reply.CrossReferences {
-			for _, def := range crs.Definition {
-				clearRelatedSnippets(def)
-			}
-			for _, dec := range crs.Declaration {
-				clearRelatedSnippets(dec)
-			}
-			for _, ref := range crs.Reference {
-				clearRelatedSnippets(ref)
-			}
-			for _, ca := range crs.Caller {
-				clearRelatedSnippets(ca)
-			}
+	return reply, nil
+}
+
+func hasCrossReferences(crs *xpb.CrossReferencesReply_CrossReferenceSet) bool {
+	return len(crs.Declaration) > 0 || len(crs.Definition) > 0 || len(crs.Reference) > 0 || len(crs.Caller) > 0 || len(crs.RelatedNode) > 0
+}
+
+func clearReplySnippets(reply *xpb.CrossReferencesReply) {
+	for _, crs := range reply.CrossReferences {
+		for _, def := range crs.Definition {
+			clearRelatedSnippets(def)
+		}
+		for _, dec := range crs.Declaration {
+			clearRelatedSnippets(dec)
 		}
-		for _, def := range reply.DefinitionLocations {
-			clearSnippet(def)
+		for _, ref := range crs.Reference {
+			clearRelatedSnippets(ref)
+		}
+		for _, ca := range crs.Caller {
+			clearRelatedSnippets(ca)
 		}
 	}
-
-	return reply, nil
+	for _, def := range reply.DefinitionLocations {
+		clearSnippet(def)
+	}
 }
 
 func addMergeNode(mergeMap map[string]string, allTickets []string, rootNode, mergeNode string) []string {
@@ -788,8 +834,72 @@ type refStats struct {
 	skip, total, max int
 }
 
+// newRefStats returns the refStats for the page of cross-references described
+// by the given page size and token.
+func newRefStats(pageSize int32, pageToken string) (*refStats, error) {
+	s := &refStats{max: int(pageSize)}
+	if s.max < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size: %d", pageSize)
+	} else if s.max == 0 {
+		s.max = defaultPageSize
+	} else if s.max > maxPageSize {
+		s.max = maxPageSize
+	}
+
+	if pageToken != "" {
+		var token ipb.PageToken
+		rec, err := base64.StdEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", pageToken)
+		}
+		rec, err = snappy.Decode(nil, rec)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", pageToken)
+		}
+		if err := proto.Unmarshal(rec, &token); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", pageToken)
+		}
+		for _, index := range token.Indices {
+			if index < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %q", pageToken)
+			}
+		}
+		s.skip = int(token.Indices["skip"])
+	}
+	return s, nil
+}
+
+// nextPageToken returns the token for the page following the current one, or
+// "" if there are no further cross-references.  initialSkip is the number of
+// cross-references skipped to reach the current page.
+func (s *refStats) nextPageToken(initialSkip int, total *xpb.CrossReferencesReply_Total) (string, error) {
+	if s.total == 0 || initialSkip+s.total == sumTotalCrossRefs(total) {
+		return "", nil
+	}
+	rec, err := proto.Marshal(&ipb.PageToken{
+		Indices: map[string]int32{"skip": int32(initialSkip + s.total)},
+	})
+	if err != nil {
+		return "", fmt.Errorf("internal error: error marshalling page token: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(snappy.Encode(nil, rec)), nil
+}
+
 func (s *refStats) done() bool { return s.total == s.max }
 
+// next reports whether a single cross-reference belongs in the current page,
+// accounting for it in the skip count or page total.
+func (s *refStats) next() bool {
+	if s.skip > 0 {
+		s.skip--
+		return false
+	} else if s.done() {
+		return false
+	}
+	s.total++
+	return true
+}
+
 func (s *refStats) skipPage(idx *srvpb.PagedCrossReferences_PageIndex) bool {
 	if s.skip > int(idx.Count) {
 		s.skip -= int(idx.Count)
@@ -2005,6 +2005,95 @@ func nodeInfos(nss ...[]*srvpb.Node) map[string]*cpb.NodeInfo {
 	return m
 }
 
+func TestStreamCrossReferences(t *testing.T) {
+	st := tbl.Construct(t)
+	for _, req := range []*xpb.CrossReferencesRequest{{
+		Ticket:         []string{"kythe://someCorpus?lang=otpl#signature"},
+		DefinitionKind: xpb.CrossReferencesRequest_BINDING_DEFINITIONS,
+		ReferenceKind:  xpb.CrossReferencesRequest_ALL_REFERENCES,
+		Snippets:       xpb.SnippetsKind_DEFAULT,
+	}, {
+		Ticket:     []string{"kythe://someCorpus?lang=otpl#withMerge"},
+		CallerKind: xpb.CrossReferencesRequest_DIRECT_CALLERS,
+		Filter:     []string{"**"},
+	}, {
+		Ticket:        []string{"kythe://someCorpus?lang=otpl#signature", "kythe://someCorpus?lang=otpl#withRelated"},
+		ReferenceKind: xpb.CrossReferencesRequest_ALL_REFERENCES,
+		Filter:        []string{"**"},
+		PageSize:      1, // ignored by StreamCrossReferences
+	}} {
+		var chunks int
+		streamed := &xpb.CrossReferencesReply{
+			CrossReferences: make(map[string]*xpb.CrossReferencesReply_CrossReferenceSet),
+			Nodes:           make(map[string]*cpb.NodeInfo),
+		}
+		if err := st.StreamCrossReferences(ctx, req, func(reply *xpb.CrossReferencesReply) error {
+			chunks++
+			if streamed.Total != nil {
+				t.Errorf("Received reply after totals: %v", reply)
+			}
+			streamed.Total = reply.Total
+			for ticket, crs := range reply.CrossReferences {
+				set := streamed.CrossReferences[ticket]
+				if set == nil {
+					set = &xpb.CrossReferencesReply_CrossReferenceSet{Ticket: ticket}
+					streamed.CrossReferences[ticket] = set
+				}
+				if set.MarkedSource == nil {
+					set.MarkedSource = crs.MarkedSource
+				}
+				set.Definition = append(set.Definition, crs.Definition...)
+				set.Declaration = append(set.Declaration, crs.Declaration...)
+				set.Reference = append(set.Reference, crs.Reference...)
+				set.Caller = append(set.Caller, crs.Caller...)
+				set.RelatedNode = append(set.RelatedNode, crs.RelatedNode...)
+			}
+			for ticket, n := range reply.Nodes {
+				if _, ok := streamed.Nodes[ticket]; ok {
+					t.Errorf("Node %q streamed more than once", ticket)
+				}
+				streamed.Nodes[ticket] = n
+			}
+			return nil
+		}); err != nil {
+			t.Fatalf("StreamCrossReferences error: %v", err)
+		}
+		if chunks < 2 {
+			t.Errorf("Expected multiple streamed replies; found %d", chunks)
+		}
+
+		paged := proto.Clone(req).(*xpb.CrossReferencesRequest)
+		paged.PageSize = maxPageSize
+		expected, err := st.CrossReferences(ctx, paged)
+		testutil.FatalOnErrT(t, "CrossReferencesRequest error: %v", err)
+		if !proto.Equal(expected, streamed) {
+			t.Errorf("Streamed cross-references for %v: found %v; expected %v", req.Ticket, streamed, expected)
+		}
+	}
+}
+
+func TestStreamCrossReferencesCanceled(t *testing.T) {
+	st := tbl.Construct(t)
+	req := &xpb.CrossReferencesRequest{
+		Ticket:         []string{"kythe://someCorpus?lang=otpl#signature"},
+		DefinitionKind: xpb.CrossReferencesRequest_BINDING_DEFINITIONS,
+		ReferenceKind:  xpb.CrossReferencesRequest_ALL_REFERENCES,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var chunks int
+	err := st.StreamCrossReferences(ctx, req, func(*xpb.CrossReferencesReply) error {
+		chunks++
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Expected %v; found %v", context.Canceled, err)
+	} else if chunks != 1 {
+		t.Errorf("Expected streaming to stop after cancellation; found %d replies", chunks)
+	}
+}
+
 func TestDocumentationEmpty(t *testing.T) {
 	st := tbl.Construct(t)
 	reply, err := st.Documentation(ctx, &xpb.DocumentationRequest{
@@ -115,6 +115,33 @@ func (s *GraphStore) Scan(ctx context.Context, req *spb.ScanRequest, f graphstor
 	return nil
 }
 
+// Delete implements part of the graphstore.Deleter interface.
+func (s *GraphStore) Delete(ctx context.Context, source *spb.VName) error {
+	s.deleteMatching(func(e *spb.Entry) bool { return compare.VNamesEqual(e.Source, source) })
+	return nil
+}
+
+// DeleteRange implements part of the graphstore.Deleter interface.
+func (s *GraphStore) DeleteRange(ctx context.Context, corpus, root, pathPrefix string) error {
+	s.deleteMatching(func(e *spb.Entry) bool { return graphstore.InPathRange(e.Source, corpus, root, pathPrefix) })
+	return nil
+}
+
+func (s *GraphStore) deleteMatching(match func(*spb.Entry) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kept := s.entries[:0]
+	for _, e := range s.entries {
+		if !match(e) {
+			kept = append(kept, e)
+		}
+	}
+	for i := len(kept); i < len(s.entries); i++ {
+		s.entries[i] = nil
+	}
+	s.entries = kept
+}
+
 // NewKeyValueDB returns a keyvalue.DB backed by an in-memory data structure.
 func NewKeyValueDB() *KeyValueDB {
 	return &KeyValueDB{
@@ -251,6 +278,17 @@ func (w kvWriter) Write(key, val []byte) error {
 	return nil
 }
 
+// Delete implements part of the keyvalue.Deleter interface.
+func (w kvWriter) Delete(key []byte) error {
+	k := string(key)
+	i := sort.Search(len(w.db.keys), func(i int) bool { return strings.Compare(w.db.keys[i], k) >= 0 })
+	if i < len(w.db.keys) && w.db.keys[i] == k {
+		w.db.keys = append(w.db.keys[:i], w.db.keys[i+1:]...)
+		delete(w.db.db, k)
+	}
+	return nil
+}
+
 // Close implements part of the keyvalue.Writer interface.
 func (w kvWriter) Close() error {
 	w.db.mu.Unlock()
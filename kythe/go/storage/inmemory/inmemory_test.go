@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"kythe.io/kythe/go/storage/keyvalue"
+	"kythe.io/kythe/go/test/services/graphstore"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -75,6 +76,56 @@ func TestKeyValueDB_overwrite(t *testing.T) {
 	}
 }
 
+func TestKeyValueDB_delete(t *testing.T) {
+	db := NewKeyValueDB()
+	writeEntries(t, db, []entry{{"a", "1"}, {"b", "2"}, {"c", "3"}})
+
+	pool := keyvalue.NewPool(db, nil)
+	for _, k := range []string{"b", "nonExistent"} {
+		if err := pool.Delete(ctx, []byte(k)); err != nil {
+			t.Fatalf("Delete error: %v", err)
+		}
+	}
+	if err := pool.Flush(); err != nil {
+		t.Fatalf("Flush error: %v", err)
+	}
+
+	if val, err := db.Get(ctx, []byte("b"), nil); err != io.EOF {
+		t.Errorf("Found deleted value: %q (err: %v)", val, err)
+	}
+
+	it, err := db.ScanPrefix(ctx, nil, nil)
+	if err != nil {
+		t.Fatalf("ScanPrefix error: %v", err)
+	}
+	defer it.Close()
+	var found []entry
+	for {
+		k, v, err := it.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Iterator error: %v", err)
+		}
+		found = append(found, entry{string(k), string(v)})
+	}
+	if diff := cmp.Diff([]entry{{"a", "1"}, {"c", "3"}}, found); diff != "" {
+		t.Errorf("Unexpected entries after delete: (- expected; + found)\n%s", diff)
+	}
+}
+
+func TestGraphStoreDelete(t *testing.T) {
+	graphstore.DeleteTest(t, func() (graphstore.Service, graphstore.DestroyFunc, error) {
+		return new(GraphStore), graphstore.NullDestroy, nil
+	})
+}
+
+func TestKeyValueGraphStoreDelete(t *testing.T) {
+	graphstore.DeleteTest(t, func() (graphstore.Service, graphstore.DestroyFunc, error) {
+		return keyvalue.NewGraphStore(NewKeyValueDB()), graphstore.NullDestroy, nil
+	})
+}
+
 type entry struct{ Key, Value string }
 
 func TestKeyValueDB_scanPrefix(t *testing.T) {
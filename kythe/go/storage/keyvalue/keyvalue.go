@@ -142,6 +142,18 @@ type Writer interface {
 	Write(key, val []byte) error
 }
 
+// Deleter is an optional interface for Writers that can also remove key-value
+// entries from a DB.
+type Deleter interface {
+	// Delete removes the entry for the given key, if it exists.  Deletes may be
+	// batched with writes until the Writer is Closed.
+	Delete(key []byte) error
+}
+
+// ErrDeleteUnsupported is returned when deleting through a Writer that does
+// not implement the Deleter interface.
+var ErrDeleteUnsupported = errors.New("keyvalue: Writer does not support deletion")
+
 // WritePool is a wrapper around a DB that automatically creates and flushes
 // Writers as data size is written, creating a simple buffered interface for
 // writing to a DB.  This interface is not thread-safe.
@@ -206,6 +218,32 @@ func (p *WritePool) Write(ctx context.Context, key, val []byte) error {
 	return nil
 }
 
+// Delete buffers the deletion of the given key until the pool becomes too large
+// or Flush is called.  ErrDeleteUnsupported is returned if the DB's Writers do
+// not implement the Deleter interface.
+func (p *WritePool) Delete(ctx context.Context, key []byte) error {
+	if p.wr == nil {
+		wr, err := p.db.Writer(ctx)
+		if err != nil {
+			return err
+		}
+		p.wr = wr
+	}
+	d, ok := p.wr.(Deleter)
+	if !ok {
+		return ErrDeleteUnsupported
+	}
+	if err := d.Delete(key); err != nil {
+		return err
+	}
+	p.size += uint64(len(key))
+	p.writes++
+	if p.opts.maxWrites() <= p.writes || p.opts.maxSize() <= p.size {
+		return p.Flush()
+	}
+	return nil
+}
+
 // Flush ensures that all buffered writes are applied to the underlying DB.
 func (p *WritePool) Flush() error {
 	if p.wr == nil {
@@ -285,6 +323,93 @@ func (s *Store) Write(ctx context.Context, req *spb.WriteRequest) (err error) {
 	return nil
 }
 
+// Delete implements part of the graphstore.Deleter interface.
+func (s *Store) Delete(ctx context.Context, source *spb.VName) error {
+	prefix, err := KeyPrefix(source, "*")
+	if err != nil {
+		return fmt.Errorf("invalid source: %v", err)
+	}
+	return s.deleteKeys(ctx, prefix, func([]byte) (bool, error) { return true, nil })
+}
+
+// DeleteRange implements part of the graphstore.Deleter interface.  Since
+// entries are keyed first by their source's signature, every entry in the
+// store is scanned.
+func (s *Store) DeleteRange(ctx context.Context, corpus, root, pathPrefix string) error {
+	return s.deleteKeys(ctx, entryKeyPrefixBytes, func(key []byte) (bool, error) {
+		entry, err := Entry(key, nil)
+		if err != nil {
+			return false, fmt.Errorf("encoding error: %v", err)
+		}
+		return graphstore.InPathRange(entry.Source, corpus, root, pathPrefix), nil
+	})
+}
+
+// deleteBatchSize is the maximum number of keys collected from an iterator
+// before they are deleted.
+const deleteBatchSize = 4096
+
+// deleteKeys deletes each key with the given prefix accepted by match.  Keys
+// are collected in batches so that no iterator is open during deletion.
+func (s *Store) deleteKeys(ctx context.Context, prefix []byte, match func(key []byte) (bool, error)) error {
+	start := prefix
+	for {
+		iter, err := s.db.ScanRange(ctx, &Range{Start: start, End: prefixEnd(prefix)}, &Options{LargeRead: true})
+		if err != nil {
+			return fmt.Errorf("db seek error: %v", err)
+		}
+		var keys [][]byte
+		var done bool
+		for len(keys) < deleteBatchSize {
+			key, _, err := iter.Next()
+			if err == io.EOF {
+				done = true
+				break
+			} else if err != nil {
+				iter.Close()
+				return fmt.Errorf("db iteration error: %v", err)
+			}
+			key = append([]byte(nil), key...)
+			start = append(append([]byte(nil), key...), 0) // the next possible key
+			if ok, err := match(key); err != nil {
+				iter.Close()
+				return err
+			} else if ok {
+				keys = append(keys, key)
+			}
+		}
+		if err := iter.Close(); err != nil {
+			return fmt.Errorf("db iterator close error: %v", err)
+		}
+
+		pool := NewPool(s.db, nil)
+		for _, key := range keys {
+			if err := pool.Delete(ctx, key); err != nil {
+				return fmt.Errorf("db delete error: %v", err)
+			}
+		}
+		if err := pool.Flush(); err != nil {
+			return fmt.Errorf("db delete error: %v", err)
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// prefixEnd returns the smallest key greater than every key with the given
+// prefix, or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
 // Scan implements part of the graphstore.Service interface.
 func (s *Store) Scan(ctx context.Context, req *spb.ScanRequest, f graphstore.EntryFunc) error {
 	iter, err := s.db.ScanPrefix(ctx, entryKeyPrefixBytes, &Options{LargeRead: true})
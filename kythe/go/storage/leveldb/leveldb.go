@@ -238,6 +238,12 @@ func (w *writer) Write(key, val []byte) error {
 	return nil
 }
 
+// Delete implements part of the keyvalue.Deleter interface.
+func (w *writer) Delete(key []byte) error {
+	w.WriteBatch.Delete(key)
+	return nil
+}
+
 // Close implements part of the keyvalue.Writer interface.
 func (w *writer) Close() error {
 	if err := w.s.db.Write(w.s.writeOpts, w.WriteBatch); err != nil {
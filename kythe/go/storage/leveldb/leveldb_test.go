@@ -80,3 +80,7 @@ func BenchmarkGSWriteBatchLrg(b *testing.B) {
 func TestOrder(t *testing.T) {
 	graphstore.OrderTest(t, tempGS, largeBatchSize)
 }
+
+func TestDelete(t *testing.T) {
+	graphstore.DeleteTest(t, tempGS)
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"kythe.io/kythe/go/platform/delimited"
+	"kythe.io/kythe/go/util/riegeli"
+
+	"google.golang.org/protobuf/proto"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+// Format is an encoding of an Entry stream.
+type Format int
+
+// Supported Entry stream formats.
+const (
+	// AutoFormat detects the format of an input stream from its content.  It
+	// is not a valid output format.
+	AutoFormat Format = iota
+
+	// DelimitedFormat is a stream of varint-delimited wire-format Entry
+	// protobufs.
+	DelimitedFormat
+
+	// JSONFormat is a stream of JSON-encoded Entry messages.
+	JSONFormat
+
+	// RiegeliFormat is a Riegeli file of wire-format Entry protobufs.
+	RiegeliFormat
+)
+
+var formatNames = []string{"auto", "delimited", "json", "riegeli"}
+
+// String returns the name of the Format, as accepted by ParseFormat.
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+	return formatNames[f]
+}
+
+// ParseFormat returns the Format with the given case-insensitive name.
+func ParseFormat(s string) (Format, error) {
+	for i, name := range formatNames {
+		if strings.EqualFold(s, name) {
+			return Format(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown entry stream format %q (accepted formats: {%s})", s, strings.Join(formatNames, ","))
+}
+
+type formatFlag struct{ Format }
+
+// FormatFlag defines a Format flag with specified name, default value, and
+// usage string.
+func FormatFlag(name, value, description string) *Format {
+	f, err := ParseFormat(value)
+	if err != nil {
+		log.Panicf("Invalid default Format value for flag --%s: %q", name, value)
+	}
+	ff := &formatFlag{f}
+	flag.Var(ff, name, fmt.Sprintf("%s (accepted formats: {%s})", description, strings.Join(formatNames, ",")))
+	return &ff.Format
+}
+
+// Get implements part of the flag.Getter interface.
+func (f *formatFlag) Get() interface{} { return f.Format }
+
+// Set implements part of the flag.Value interface.
+func (f *formatFlag) Set(s string) error {
+	format, err := ParseFormat(s)
+	if err != nil {
+		return err
+	}
+	f.Format = format
+	return nil
+}
+
+// riegeliSignature is the fixed prefix of every Riegeli file.
+var riegeliSignature = func() []byte {
+	var buf bytes.Buffer
+	if err := riegeli.NewWriter(&buf, nil).Flush(); err != nil {
+		log.Panicf("Error encoding Riegeli file signature: %v", err)
+	}
+	return buf.Bytes()
+}()
+
+// DetectFormat reports the Format of the Entry stream buffered by r without
+// consuming any of its input.  A stream that is neither a Riegeli file nor
+// plausibly JSON is assumed to be delimited; this includes the empty stream.
+func DetectFormat(r *bufio.Reader) (Format, error) {
+	if p, err := r.Peek(len(riegeliSignature)); err == nil {
+		if bytes.Equal(p, riegeliSignature) {
+			return RiegeliFormat, nil
+		}
+	} else if err != io.EOF {
+		return 0, err
+	}
+
+	// A JSON object begins with '{', which is also a valid varint length
+	// prefix, so check whether the stream opens with a valid delimited Entry
+	// before looking for JSON.
+	if isDelimitedEntry(r) {
+		return DelimitedFormat, nil
+	}
+	for i := 1; ; i++ {
+		p, err := r.Peek(i)
+		if len(p) < i {
+			if err != nil && err != io.EOF {
+				return 0, err
+			}
+			return DelimitedFormat, nil
+		}
+		switch p[i-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '{':
+			return JSONFormat, nil
+		default:
+			return DelimitedFormat, nil
+		}
+	}
+}
+
+// isDelimitedEntry reports whether r begins with a varint-delimited Entry.
+func isDelimitedEntry(r *bufio.Reader) bool {
+	p, _ := r.Peek(binary.MaxVarintLen64)
+	size, n := binary.Uvarint(p)
+	if n <= 0 {
+		return false
+	} else if size > uint64(r.Size()-n) {
+		// Too large to check, but also far longer than a JSON prefix.
+		return true
+	}
+	p, err := r.Peek(n + int(size))
+	if err != nil {
+		return false
+	}
+	return proto.Unmarshal(p[n:], new(spb.Entry)) == nil
+}
+
+// NewFormatReader returns an EntryReader for the stream of entries in r
+// encoded in the given Format.  If f is AutoFormat, the format is detected
+// from the content of r.
+func NewFormatReader(r io.Reader, f Format) EntryReader {
+	return func(emit func(*spb.Entry) error) error {
+		format := f
+		if format == AutoFormat {
+			br, ok := r.(*bufio.Reader)
+			if !ok {
+				br = bufio.NewReader(r)
+				r = br
+			}
+			var err error
+			format, err = DetectFormat(br)
+			if err != nil {
+				return fmt.Errorf("error detecting entry stream format: %v", err)
+			}
+		}
+		switch format {
+		case DelimitedFormat:
+			return NewReader(r)(emit)
+		case JSONFormat:
+			return NewJSONReader(r)(emit)
+		case RiegeliFormat:
+			return NewRiegeliReader(r)(emit)
+		default:
+			return fmt.Errorf("unsupported entry stream format: %v", format)
+		}
+	}
+}
+
+// NewRiegeliReader reads a Riegeli file of Entry protobufs from r.
+func NewRiegeliReader(r io.Reader) EntryReader {
+	return func(f func(*spb.Entry) error) error {
+		rd := riegeli.NewReader(r)
+		for {
+			var entry spb.Entry
+			if err := rd.NextProto(&entry); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("error decoding Entry: %v", err)
+			}
+			if err := f(&entry); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Marshal encodes e as a single record of the Format, suitable for
+// Writer.PutRecord.
+func (f Format) Marshal(e *spb.Entry) ([]byte, error) {
+	switch f {
+	case DelimitedFormat, RiegeliFormat:
+		return proto.Marshal(e)
+	case JSONFormat:
+		rec, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		return append(rec, '\n'), nil
+	default:
+		return nil, fmt.Errorf("unsupported entry stream format: %v", f)
+	}
+}
+
+// A Writer encodes a stream of entries in a particular Format.
+type Writer struct {
+	format Format
+	w      io.Writer
+	delim  *delimited.Writer
+	rw     *riegeli.Writer
+}
+
+// NewFormatWriter returns a Writer that encodes entries to w in the given
+// Format.  The Riegeli options are used only for RiegeliFormat and may be nil.
+// The caller must call Flush once all entries have been written.
+func NewFormatWriter(w io.Writer, f Format, opts *riegeli.WriterOptions) (*Writer, error) {
+	wr := &Writer{format: f, w: w}
+	switch f {
+	case DelimitedFormat:
+		wr.delim = delimited.NewWriter(w)
+	case JSONFormat:
+	case RiegeliFormat:
+		wr.rw = riegeli.NewWriter(w, opts)
+	case AutoFormat:
+		return nil, errors.New("entry stream output format must be given explicitly")
+	default:
+		return nil, fmt.Errorf("unsupported entry stream format: %v", f)
+	}
+	return wr, nil
+}
+
+// Format returns the Format written by w.
+func (w *Writer) Format() Format { return w.format }
+
+// Put writes e to the stream.
+func (w *Writer) Put(e *spb.Entry) error {
+	rec, err := w.format.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return w.PutRecord(rec)
+}
+
+// PutRecord writes an entry already encoded by the Writer's Format.Marshal.
+func (w *Writer) PutRecord(rec []byte) error {
+	switch {
+	case w.delim != nil:
+		return w.delim.Put(rec)
+	case w.rw != nil:
+		return w.rw.Put(rec)
+	default:
+		_, err := w.w.Write(rec)
+		return err
+	}
+}
+
+// Flush writes any entries buffered by w to its underlying io.Writer.  It
+// does not flush the underlying io.Writer itself.
+func (w *Writer) Flush() error {
+	if w.rw != nil {
+		return w.rw.Flush()
+	}
+	return nil
+}
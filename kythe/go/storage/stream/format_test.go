@@ -0,0 +1,112 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"kythe.io/kythe/go/util/compare"
+	"kythe.io/kythe/go/util/riegeli"
+
+	"google.golang.org/protobuf/proto"
+
+	spb "kythe.io/kythe/proto/storage_go_proto"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, f := range []Format{AutoFormat, DelimitedFormat, JSONFormat, RiegeliFormat} {
+		if found, err := ParseFormat(strings.ToUpper(f.String())); err != nil {
+			t.Errorf("ParseFormat(%q): %v", f, err)
+		} else if found != f {
+			t.Errorf("ParseFormat(%q): found %v", f, found)
+		}
+	}
+	if f, err := ParseFormat("kzip"); err == nil {
+		t.Errorf(`ParseFormat("kzip"): found %v; expected error`, f)
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	// An Entry whose encoding is 123 bytes has a delimited length prefix of
+	// '{', which must not be mistaken for JSON.
+	brace := fact("brace", "kind", "")
+	brace.FactValue = make([]byte, '{'-proto.Size(brace)-2)
+	if size := proto.Size(brace); size != '{' {
+		t.Fatalf("Entry size: found %d; expected %d", size, '{')
+	}
+
+	tests := []struct {
+		format  Format
+		entries []*spb.Entry
+	}{
+		{DelimitedFormat, testEntries},
+		{DelimitedFormat, []*spb.Entry{brace}},
+		{DelimitedFormat, nil},
+		{JSONFormat, testEntries},
+		{JSONFormat, []*spb.Entry{brace}},
+		{RiegeliFormat, testEntries},
+		{RiegeliFormat, nil},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		wr, err := NewFormatWriter(&buf, test.format, &riegeli.WriterOptions{})
+		if err != nil {
+			t.Fatalf("NewFormatWriter(%v): %v", test.format, err)
+		}
+		for _, e := range test.entries {
+			if err := wr.Put(e); err != nil {
+				t.Fatalf("Put(%v): %v", test.format, err)
+			}
+		}
+		if err := wr.Flush(); err != nil {
+			t.Fatalf("Flush(%v): %v", test.format, err)
+		}
+
+		data := buf.Bytes()
+		if f, err := DetectFormat(bufio.NewReader(bytes.NewReader(data))); err != nil {
+			t.Errorf("DetectFormat(%v): %v", test.format, err)
+		} else if len(test.entries) != 0 && f != test.format {
+			t.Errorf("DetectFormat(%v): found %v", test.format, f)
+		}
+
+		for _, f := range []Format{test.format, AutoFormat} {
+			var i int
+			if err := NewFormatReader(bytes.NewReader(data), f)(func(e *spb.Entry) error {
+				if i >= len(test.entries) {
+					t.Errorf("%v: unexpected entry %v", f, e)
+				} else if diff := compare.ProtoDiff(test.entries[i], e); diff != "" {
+					t.Errorf("%v: entries[%d]: %s", f, i, diff)
+				}
+				i++
+				return nil
+			}); err != nil {
+				t.Errorf("NewFormatReader(%v): %v", f, err)
+			} else if i != len(test.entries) {
+				t.Errorf("NewFormatReader(%v): found %d entries; expected %d", f, i, len(test.entries))
+			}
+		}
+	}
+}
+
+func TestFormatWriterAuto(t *testing.T) {
+	if _, err := NewFormatWriter(new(bytes.Buffer), AutoFormat, nil); err == nil {
+		t.Error("NewFormatWriter(AutoFormat): expected error")
+	}
+}
@@ -14,7 +14,8 @@
  * limitations under the License.
  */
 
-// Package stream provides utility functions to consume Entry streams.
+// Package stream provides utility functions to consume and produce Entry
+// streams.
 package stream // import "kythe.io/kythe/go/storage/stream"
 
 import (
@@ -0,0 +1,89 @@
+/*
+ * Copyright 2026 The Kythe Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Binary delete_entries removes entries from a GraphStore.
+//
+// Usage:
+//   delete_entries --graphstore spec ticket...
+//   delete_entries --graphstore spec --paths ticket...
+//
+// Example:
+//   # Remove the stale entries for a re-indexed file before writing its new
+//   # entries.
+//   delete_entries --graphstore gs/leveldb --paths 'kythe://corpus?path=src/main.go'
+//   zcat main.entries.gz | write_entries --graphstore gs/leveldb
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"kythe.io/kythe/go/services/graphstore"
+	"kythe.io/kythe/go/storage/gsutil"
+	"kythe.io/kythe/go/util/flagutil"
+	"kythe.io/kythe/go/util/kytheuri"
+
+	_ "kythe.io/kythe/go/services/graphstore/proxy"
+	_ "kythe.io/kythe/go/storage/leveldb"
+)
+
+var (
+	gs graphstore.Service
+
+	paths = flag.Bool("paths", false, "Treat each ticket as a corpus/root/path and remove the entries of every source within it (e.g. a file node and its anchors); a path ending in \"/\" removes a whole directory")
+)
+
+func init() {
+	gsutil.Flag(&gs, "graphstore", "GraphStore from which to delete entries")
+	flag.Usage = flagutil.SimpleUsage("Removes the entries of the given source tickets (or file path prefixes) from a GraphStore",
+		"--graphstore spec [--paths] ticket...")
+}
+
+func main() {
+	log.SetPrefix("delete_entries: ")
+
+	flag.Parse()
+	if gs == nil {
+		flagutil.UsageError("missing --graphstore")
+	} else if len(flag.Args()) == 0 {
+		flagutil.UsageError("missing tickets to delete")
+	}
+
+	ctx := context.Background()
+	defer gsutil.LogClose(ctx, gs)
+
+	d, ok := gs.(graphstore.Deleter)
+	if !ok {
+		log.Fatalf("Deletion unsupported for given GraphStore type: %T", gs)
+	}
+
+	for _, ticket := range flag.Args() {
+		v, err := kytheuri.ToVName(ticket)
+		if err != nil {
+			log.Fatalf("Error parsing ticket %q: %v", ticket, err)
+		}
+		if *paths {
+			err = d.DeleteRange(ctx, v.Corpus, v.Root, v.Path)
+		} else {
+			err = d.Delete(ctx, v)
+		}
+		if err != nil {
+			log.Fatalf("Error deleting entries for %q: %v", ticket, err)
+		}
+	}
+	log.Printf("Deleted entries for %d tickets", len(flag.Args()))
+}
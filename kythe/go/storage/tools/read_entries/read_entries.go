@@ -15,23 +15,26 @@
  */
 
 // Binary read_entries scans the entries from a specified GraphStore and emits
-// them to stdout as a delimited stream.
+// them to stdout as a delimited, JSON, or Riegeli stream (see --write_format).
 package main
 
 import (
+	"bufio"
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"sync"
 
-	"kythe.io/kythe/go/platform/delimited"
 	"kythe.io/kythe/go/platform/vfs"
 	"kythe.io/kythe/go/services/graphstore"
 	"kythe.io/kythe/go/storage/gsutil"
+	"kythe.io/kythe/go/storage/stream"
 	"kythe.io/kythe/go/util/flagutil"
 	"kythe.io/kythe/go/util/kytheuri"
+	"kythe.io/kythe/go/util/riegeli"
 
 	spb "kythe.io/kythe/proto/storage_go_proto"
 
@@ -51,12 +54,15 @@ var (
 	edgeKind     = flag.String("edge_kind", "", "Edge kind by which to filter a read/scan")
 	targetTicket = flag.String("target", "", "Ticket of target by which to filter a scan")
 	factPrefix   = flag.String("fact_prefix", "", "Fact prefix by which to filter a scan")
+
+	writeFormat    = stream.FormatFlag("write_format", "delimited", "Format of the output entry stream(s)")
+	riegeliOptions = flag.String("riegeli_writer_options", "", "Riegeli writer options (with --write_format=riegeli)")
 )
 
 func init() {
 	gsutil.Flag(&gs, "graphstore", "GraphStore to read")
-	flag.Usage = flagutil.SimpleUsage("Scans/reads the entries from a GraphStore, emitting a delimited entry stream to stdout",
-		"--graphstore spec [--count] [--shards N [--shard_index I] --sharded_file path] [--edge_kind] ([--fact_prefix str] [--target ticket] | [ticket...])")
+	flag.Usage = flagutil.SimpleUsage("Scans/reads the entries from a GraphStore, emitting an entry stream to stdout",
+		"--graphstore spec [--count] [--write_format format] [--shards N [--shard_index I] --sharded_file path] [--edge_kind] ([--fact_prefix str] [--target ticket] | [ticket...])")
 }
 
 func main() {
@@ -71,15 +77,24 @@ func main() {
 
 	ctx := context.Background()
 
-	wr := delimited.NewWriter(os.Stdout)
+	opts, err := riegeli.ParseOptions(*riegeliOptions)
+	if err != nil {
+		flagutil.UsageErrorf("invalid --riegeli_writer_options: %v", err)
+	} else if *writeFormat == stream.AutoFormat {
+		flagutil.UsageError("--write_format must be one of {delimited,json,riegeli}")
+	}
+
 	var total int64
 	if *shards <= 0 {
-		entryFunc := func(entry *spb.Entry) error {
-			if *count {
-				total++
-				return nil
-			}
-			return wr.PutProto(entry)
+		entryFunc := func(*spb.Entry) error {
+			total++
+			return nil
+		}
+		if !*count {
+			out := bufio.NewWriter(os.Stdout)
+			wr := newEntryWriter(out, opts)
+			defer closeEntryWriter(wr, out)
+			entryFunc = wr.Put
 		}
 		if len(flag.Args()) > 0 {
 			if *targetTicket != "" || *factPrefix != "" {
@@ -125,29 +140,48 @@ func main() {
 					log.Fatalf("Failed to create file %q: %v", path, err)
 				}
 				defer f.Close()
-				wr := delimited.NewWriter(f)
+				out := bufio.NewWriter(f)
+				wr := newEntryWriter(out, opts)
 				if err := sgs.Shard(ctx, &spb.ShardRequest{
 					Index:  i,
 					Shards: *shards,
-				}, func(entry *spb.Entry) error {
-					return wr.PutProto(entry)
-				}); err != nil {
+				}, wr.Put); err != nil {
 					log.Fatalf("GraphStore shard scan error: %v", err)
 				}
+				closeEntryWriter(wr, out)
 			}(i)
 		}
 		wg.Wait()
 		return
 	}
 
+	out := bufio.NewWriter(os.Stdout)
+	wr := newEntryWriter(out, opts)
 	if err := sgs.Shard(ctx, &spb.ShardRequest{
 		Index:  *shardIndex,
 		Shards: *shards,
-	}, func(entry *spb.Entry) error {
-		return wr.PutProto(entry)
-	}); err != nil {
+	}, wr.Put); err != nil {
 		log.Fatalf("GraphStore shard scan error: %v", err)
 	}
+	closeEntryWriter(wr, out)
+}
+
+// newEntryWriter returns a Writer of the --write_format to w.
+func newEntryWriter(w io.Writer, opts *riegeli.WriterOptions) *stream.Writer {
+	wr, err := stream.NewFormatWriter(w, *writeFormat, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return wr
+}
+
+// closeEntryWriter flushes all entries written to wr through to out.
+func closeEntryWriter(wr *stream.Writer, out *bufio.Writer) {
+	if err := wr.Flush(); err != nil {
+		log.Fatalf("Error writing entries: %v", err)
+	} else if err := out.Flush(); err != nil {
+		log.Fatalf("Error writing entries: %v", err)
+	}
 }
 
 func readEntries(ctx context.Context, gs graphstore.Service, entryFunc graphstore.EntryFunc, edgeKind string, tickets []string) error {
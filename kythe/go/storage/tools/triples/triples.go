@@ -15,6 +15,8 @@
  */
 
 // Binary triples implements a converter from an Entry stream to a stream of triples.
+// The Entry stream may be delimited, JSON, or Riegeli; its format is detected
+// unless given by --read_format.
 //
 // Examples:
 //   triples < entries > triples.nq
@@ -53,6 +55,7 @@ import (
 var (
 	keepReverseEdges = flag.Bool("keep_reverse_edges", false, "Do not filter reverse edges from triples output")
 	quiet            = flag.Bool("quiet", false, "Do not emit logging messages")
+	readFormat       = stream.FormatFlag("read_format", "auto", "Format of the input entry stream")
 
 	gs graphstore.Service
 )
@@ -60,7 +63,7 @@ var (
 func init() {
 	gsutil.Flag(&gs, "graphstore", "Path to GraphStore to convert to triples (instead of an entry stream)")
 	flag.Usage = flagutil.SimpleUsage("Converts an Entry stream to a stream of triples",
-		"[(--graphstore path | entries_file) [triples_out]]")
+		"[--read_format format] [(--graphstore path | entries_file) [triples_out]]")
 }
 
 func main() {
@@ -102,27 +105,33 @@ func main() {
 	}
 
 	var (
-		entries      <-chan *spb.Entry
+		rd           stream.EntryReader
 		reverseEdges int
 		triples      int
 	)
 
 	if gs == nil {
-		entries = stream.ReadEntries(in)
+		rd = stream.NewFormatReader(in, *readFormat)
 	} else {
-		ch := make(chan *spb.Entry)
-		entries = ch
-		go func() {
-			defer close(ch)
-			if err := gs.Scan(context.Background(), &spb.ScanRequest{}, func(e *spb.Entry) error {
-				ch <- e
-				return nil
-			}); err != nil {
-				log.Fatalf("Error scanning graphstore: %v", err)
+		rd = func(f func(*spb.Entry) error) error {
+			if err := gs.Scan(context.Background(), &spb.ScanRequest{}, f); err != nil {
+				return fmt.Errorf("error scanning graphstore: %v", err)
 			}
-		}()
+			return nil
+		}
 	}
 
+	entries := make(chan *spb.Entry)
+	go func() {
+		defer close(entries)
+		if err := rd(func(e *spb.Entry) error {
+			entries <- e
+			return nil
+		}); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
 	for entry := range entries {
 		if edges.IsReverse(entry.EdgeKind) && !*keepReverseEdges {
 			reverseEdges++
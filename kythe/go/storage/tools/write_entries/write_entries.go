@@ -14,8 +14,9 @@
  * limitations under the License.
  */
 
-// Binary write_entries reads a stream of protobuf entries on os.Stdin and
-// writes each to a graphstore server.
+// Binary write_entries reads a stream of entries on os.Stdin and writes each
+// to a graphstore server.  The stream may be delimited, JSON, or Riegeli; its
+// format is detected unless given by --read_format.
 //
 // Usage:
 //   entry_emitter ... | write_entries --graphstore addr
@@ -53,13 +54,14 @@ import (
 var (
 	batchSize  = flag.Int("batch_size", 1024, "Maximum entries per write for consecutive entries with the same source")
 	numWorkers = flag.Int("workers", 1, "Number of concurrent workers writing to the GraphStore")
+	readFormat = stream.FormatFlag("read_format", "auto", "Format of the input entry stream")
 
 	gs graphstore.Service
 )
 
 func init() {
-	flag.Usage = flagutil.SimpleUsage("Write a delimited stream of entries from stdin to a GraphStore",
-		"[--batch_size entries] [--workers n] --graphstore spec")
+	flag.Usage = flagutil.SimpleUsage("Write a stream of entries from stdin to a GraphStore",
+		"[--batch_size entries] [--workers n] [--read_format format] --graphstore spec")
 	gsutil.Flag(&gs, "graphstore", "GraphStore to which to write the entry stream")
 }
 
@@ -85,7 +87,17 @@ func main() {
 	}
 	defer profile.Stop()
 
-	writes := graphstore.BatchWrites(stream.ReadEntries(os.Stdin), *batchSize)
+	entries := make(chan *spb.Entry)
+	go func() {
+		defer close(entries)
+		if err := stream.NewFormatReader(os.Stdin, *readFormat)(func(e *spb.Entry) error {
+			entries <- e
+			return nil
+		}); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	writes := graphstore.BatchWrites(entries, *batchSize)
 
 	var (
 		wg         sync.WaitGroup
@@ -113,6 +113,87 @@ func OrderTest(t *testing.T, create CreateFunc, batchSize int) {
 		}))
 }
 
+// DeleteTest tests the graphstore.Deleter implementation of the CreateFunc
+// created graphstore.Service.
+func DeleteTest(t *testing.T, create CreateFunc) {
+	gs, destroy, err := create()
+	testutil.FatalOnErrT(t, "CreateFunc error: %v", err)
+	defer func() {
+		testutil.FatalOnErrT(t, "gs close error: %v", gs.Close(ctx))
+		testutil.FatalOnErrT(t, "DestroyFunc error: %v", destroy())
+	}()
+	d, ok := gs.(graphstore.Deleter)
+	if !ok {
+		t.Fatalf("%T does not implement graphstore.Deleter", gs)
+	}
+
+	vname := func(path, sig string) *spb.VName {
+		return &spb.VName{Corpus: "corpus", Root: "root", Path: path, Signature: sig, Language: "lang"}
+	}
+	sources := []*spb.VName{
+		vname("dir/a.go", ""),
+		vname("dir/a.go", "anchor"),
+		vname("dir/a.go2", ""),
+		vname("dir/b.go", ""),
+		vname("dir/sub/c.go", ""),
+		vname("dir2/e.go", ""),
+		vname("other/d.go", ""),
+		vname("", "node"),
+	}
+	for _, src := range sources {
+		testutil.FatalOnErrT(t, "write error: %v", gs.Write(ctx, &spb.WriteRequest{
+			Source: src,
+			Update: []*spb.WriteRequest_Update{
+				{FactName: "/fact", FactValue: factValue},
+				{EdgeKind: "/edge", Target: vname("", "node"), FactName: "/"},
+			},
+		}))
+	}
+
+	remaining := func() map[string]int {
+		counts := make(map[string]int)
+		testutil.FatalOnErrT(t, "scan error: %v",
+			gs.Scan(ctx, new(spb.ScanRequest), func(entry *spb.Entry) error {
+				counts[entry.Source.Path+"#"+entry.Source.Signature]++
+				return nil
+			}))
+		return counts
+	}
+	check := func(op string, expected map[string]int) {
+		t.Helper()
+		found := remaining()
+		if len(found) != len(expected) {
+			t.Errorf("after %s: found entries for %v; expected %v", op, found, expected)
+			return
+		}
+		for k, n := range expected {
+			if found[k] != n {
+				t.Errorf("after %s: found entries for %v; expected %v", op, found, expected)
+				return
+			}
+		}
+	}
+
+	testutil.FatalOnErrT(t, "Delete error: %v", d.Delete(ctx, vname("dir/a.go", "anchor")))
+	check("Delete", map[string]int{"dir/a.go#": 2, "dir/a.go2#": 2, "dir/b.go#": 2, "dir/sub/c.go#": 2, "dir2/e.go#": 2, "other/d.go#": 2, "#node": 2})
+
+	// A file path does not cover sibling paths sharing its prefix.
+	testutil.FatalOnErrT(t, "DeleteRange error: %v", d.DeleteRange(ctx, "corpus", "root", "dir/a.go"))
+	check("DeleteRange of a file", map[string]int{"dir/a.go2#": 2, "dir/b.go#": 2, "dir/sub/c.go#": 2, "dir2/e.go#": 2, "other/d.go#": 2, "#node": 2})
+
+	testutil.FatalOnErrT(t, "DeleteRange error: %v", d.DeleteRange(ctx, "corpus", "root", "dir/"))
+	check("DeleteRange of a directory", map[string]int{"dir2/e.go#": 2, "other/d.go#": 2, "#node": 2})
+
+	testutil.FatalOnErrT(t, "DeleteRange error: %v", d.DeleteRange(ctx, "corpus", "root", "dir2"))
+	check("DeleteRange without a trailing slash", map[string]int{"dir2/e.go#": 2, "other/d.go#": 2, "#node": 2})
+
+	testutil.FatalOnErrT(t, "DeleteRange error: %v", d.DeleteRange(ctx, "corpus", "otherRoot", ""))
+	check("DeleteRange of another root", map[string]int{"dir2/e.go#": 2, "other/d.go#": 2, "#node": 2})
+
+	testutil.FatalOnErrT(t, "DeleteRange error: %v", d.DeleteRange(ctx, "corpus", "root", ""))
+	check("DeleteRange of corpus root", map[string]int{})
+}
+
 var factValue = []byte("factValue")
 
 func randUpdate(u *spb.WriteRequest_Update, size int) {
@@ -30,7 +30,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"sigs.k8s.io/yaml"
 )
 
@@ -194,6 +196,51 @@ func JSONEqual(expected, got []byte) error {
 	return DeepEqual(e, g)
 }
 
+// ServiceDescEqual checks that a hand-written gRPC service description names
+// the service and methods of expected, and that each method of its
+// HandlerType interface takes and returns the proto messages declared for it.
+func ServiceDescEqual(expected protoreflect.ServiceDescriptor, got *grpc.ServiceDesc) error {
+	if name := string(expected.FullName()); got.ServiceName != name {
+		return fmt.Errorf("service name: expected %q; got %q", name, got.ServiceName)
+	}
+	methods := expected.Methods()
+	if len(got.Methods) != methods.Len() || len(got.Streams) != 0 {
+		return fmt.Errorf("%s: expected %d unary methods; got %d methods and %d streams",
+			got.ServiceName, methods.Len(), len(got.Methods), len(got.Streams))
+	}
+	handler := reflect.TypeOf(got.HandlerType).Elem()
+	for _, m := range got.Methods {
+		md := methods.ByName(protoreflect.Name(m.MethodName))
+		if md == nil {
+			return fmt.Errorf("%s: unknown method %q", got.ServiceName, m.MethodName)
+		}
+		fn, ok := handler.MethodByName(m.MethodName)
+		if !ok || fn.Type.NumIn() != 2 || fn.Type.NumOut() != 2 {
+			return fmt.Errorf("%s: handler has no method %s(context.Context, request) (reply, error)", md.FullName(), m.MethodName)
+		}
+		if name := messageName(fn.Type.In(1)); name != md.Input().FullName() {
+			return fmt.Errorf("%s request: expected %s; got %q", md.FullName(), md.Input().FullName(), name)
+		}
+		if name := messageName(fn.Type.Out(0)); name != md.Output().FullName() {
+			return fmt.Errorf("%s reply: expected %s; got %q", md.FullName(), md.Output().FullName(), name)
+		}
+	}
+	return nil
+}
+
+// messageName returns the full name of the proto message whose pointer type is
+// t, or "" if t is not one.
+func messageName(t reflect.Type) protoreflect.FullName {
+	if t.Kind() != reflect.Ptr {
+		return ""
+	}
+	msg, ok := reflect.New(t.Elem()).Interface().(proto.Message)
+	if !ok {
+		return ""
+	}
+	return msg.ProtoReflect().Descriptor().FullName()
+}
+
 func caller(up int) (file string, line int) {
 	_, file, line, ok := runtime.Caller(up + 2)
 	if !ok {
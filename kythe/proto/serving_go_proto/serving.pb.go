@@ -167,7 +167,7 @@ func (x Relatives_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Relatives_Type.Descriptor instead.
 func (Relatives_Type) EnumDescriptor() ([]byte, []int) {
-	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{16, 0}
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type Callgraph_Type int32
@@ -216,7 +216,56 @@ func (x Callgraph_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Callgraph_Type.Descriptor instead.
 func (Callgraph_Type) EnumDescriptor() ([]byte, []int) {
-	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{17, 0}
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{18, 0}
+}
+
+type TypeRelatives_Type int32
+
+const (
+	TypeRelatives_UNKNOWN    TypeRelatives_Type = 0
+	TypeRelatives_SUPERTYPES TypeRelatives_Type = 1
+	TypeRelatives_SUBTYPES   TypeRelatives_Type = 2
+)
+
+// Enum value maps for TypeRelatives_Type.
+var (
+	TypeRelatives_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "SUPERTYPES",
+		2: "SUBTYPES",
+	}
+	TypeRelatives_Type_value = map[string]int32{
+		"UNKNOWN":    0,
+		"SUPERTYPES": 1,
+		"SUBTYPES":   2,
+	}
+)
+
+func (x TypeRelatives_Type) Enum() *TypeRelatives_Type {
+	p := new(TypeRelatives_Type)
+	*p = x
+	return p
+}
+
+func (x TypeRelatives_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TypeRelatives_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_kythe_proto_serving_proto_enumTypes[4].Descriptor()
+}
+
+func (TypeRelatives_Type) Type() protoreflect.EnumType {
+	return &file_kythe_proto_serving_proto_enumTypes[4]
+}
+
+func (x TypeRelatives_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TypeRelatives_Type.Descriptor instead.
+func (TypeRelatives_Type) EnumDescriptor() ([]byte, []int) {
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type Node struct {
@@ -1427,6 +1476,53 @@ func (x *IdentifierMatch) GetNode() []*IdentifierMatch_Node {
 	return nil
 }
 
+type IdentifierPostings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QualifiedName []string `protobuf:"bytes,1,rep,name=qualified_name,json=qualifiedName,proto3" json:"qualified_name,omitempty"`
+}
+
+func (x *IdentifierPostings) Reset() {
+	*x = IdentifierPostings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kythe_proto_serving_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentifierPostings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentifierPostings) ProtoMessage() {}
+
+func (x *IdentifierPostings) ProtoReflect() protoreflect.Message {
+	mi := &file_kythe_proto_serving_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentifierPostings.ProtoReflect.Descriptor instead.
+func (*IdentifierPostings) Descriptor() ([]byte, []int) {
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IdentifierPostings) GetQualifiedName() []string {
+	if x != nil {
+		return x.QualifiedName
+	}
+	return nil
+}
+
 type Relatives struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1439,7 +1535,7 @@ type Relatives struct {
 func (x *Relatives) Reset() {
 	*x = Relatives{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[16]
+		mi := &file_kythe_proto_serving_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1452,7 +1548,7 @@ func (x *Relatives) String() string {
 func (*Relatives) ProtoMessage() {}
 
 func (x *Relatives) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[16]
+	mi := &file_kythe_proto_serving_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1465,7 +1561,7 @@ func (x *Relatives) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Relatives.ProtoReflect.Descriptor instead.
 func (*Relatives) Descriptor() ([]byte, []int) {
-	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{16}
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Relatives) GetTickets() []string {
@@ -1494,7 +1590,7 @@ type Callgraph struct {
 func (x *Callgraph) Reset() {
 	*x = Callgraph{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[17]
+		mi := &file_kythe_proto_serving_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1507,7 +1603,7 @@ func (x *Callgraph) String() string {
 func (*Callgraph) ProtoMessage() {}
 
 func (x *Callgraph) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[17]
+	mi := &file_kythe_proto_serving_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,7 +1616,7 @@ func (x *Callgraph) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Callgraph.ProtoReflect.Descriptor instead.
 func (*Callgraph) Descriptor() ([]byte, []int) {
-	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{17}
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Callgraph) GetTickets() []string {
@@ -1537,6 +1633,116 @@ func (x *Callgraph) GetType() Callgraph_Type {
 	return Callgraph_UNKNOWN
 }
 
+type TypeRelatives struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []string           `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Type    TypeRelatives_Type `protobuf:"varint,2,opt,name=type,proto3,enum=kythe.proto.serving.TypeRelatives_Type" json:"type,omitempty"`
+}
+
+func (x *TypeRelatives) Reset() {
+	*x = TypeRelatives{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kythe_proto_serving_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeRelatives) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeRelatives) ProtoMessage() {}
+
+func (x *TypeRelatives) ProtoReflect() protoreflect.Message {
+	mi := &file_kythe_proto_serving_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeRelatives.ProtoReflect.Descriptor instead.
+func (*TypeRelatives) Descriptor() ([]byte, []int) {
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TypeRelatives) GetTickets() []string {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *TypeRelatives) GetType() TypeRelatives_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TypeRelatives_UNKNOWN
+}
+
+type FunctionParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parameter   []*FunctionParameters_Parameter `protobuf:"bytes,1,rep,name=parameter,proto3" json:"parameter,omitempty"`
+	ReturnValue *FunctionParameters_Node        `protobuf:"bytes,2,opt,name=return_value,json=returnValue,proto3" json:"return_value,omitempty"`
+}
+
+func (x *FunctionParameters) Reset() {
+	*x = FunctionParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kythe_proto_serving_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionParameters) ProtoMessage() {}
+
+func (x *FunctionParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_kythe_proto_serving_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionParameters.ProtoReflect.Descriptor instead.
+func (*FunctionParameters) Descriptor() ([]byte, []int) {
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FunctionParameters) GetParameter() []*FunctionParameters_Parameter {
+	if x != nil {
+		return x.Parameter
+	}
+	return nil
+}
+
+func (x *FunctionParameters) GetReturnValue() *FunctionParameters_Node {
+	if x != nil {
+		return x.ReturnValue
+	}
+	return nil
+}
+
 type EdgeGroup_Edge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1549,7 +1755,7 @@ type EdgeGroup_Edge struct {
 func (x *EdgeGroup_Edge) Reset() {
 	*x = EdgeGroup_Edge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[18]
+		mi := &file_kythe_proto_serving_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1562,7 +1768,7 @@ func (x *EdgeGroup_Edge) String() string {
 func (*EdgeGroup_Edge) ProtoMessage() {}
 
 func (x *EdgeGroup_Edge) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[18]
+	mi := &file_kythe_proto_serving_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1605,7 +1811,7 @@ type FileDirectory_Entry struct {
 func (x *FileDirectory_Entry) Reset() {
 	*x = FileDirectory_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[19]
+		mi := &file_kythe_proto_serving_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1618,7 +1824,7 @@ func (x *FileDirectory_Entry) String() string {
 func (*FileDirectory_Entry) ProtoMessage() {}
 
 func (x *FileDirectory_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[19]
+	mi := &file_kythe_proto_serving_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,7 +1874,7 @@ type CorpusRoots_Corpus struct {
 func (x *CorpusRoots_Corpus) Reset() {
 	*x = CorpusRoots_Corpus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[20]
+		mi := &file_kythe_proto_serving_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1681,7 +1887,7 @@ func (x *CorpusRoots_Corpus) String() string {
 func (*CorpusRoots_Corpus) ProtoMessage() {}
 
 func (x *CorpusRoots_Corpus) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[20]
+	mi := &file_kythe_proto_serving_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1733,7 +1939,7 @@ type FileDecorations_Decoration struct {
 func (x *FileDecorations_Decoration) Reset() {
 	*x = FileDecorations_Decoration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[21]
+		mi := &file_kythe_proto_serving_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +1952,7 @@ func (x *FileDecorations_Decoration) String() string {
 func (*FileDecorations_Decoration) ProtoMessage() {}
 
 func (x *FileDecorations_Decoration) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[21]
+	mi := &file_kythe_proto_serving_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,7 +2018,7 @@ type FileDecorations_Override struct {
 func (x *FileDecorations_Override) Reset() {
 	*x = FileDecorations_Override{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[22]
+		mi := &file_kythe_proto_serving_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1825,7 +2031,7 @@ func (x *FileDecorations_Override) String() string {
 func (*FileDecorations_Override) ProtoMessage() {}
 
 func (x *FileDecorations_Override) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[22]
+	mi := &file_kythe_proto_serving_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1888,7 +2094,7 @@ type PagedCrossReferences_RelatedNode struct {
 func (x *PagedCrossReferences_RelatedNode) Reset() {
 	*x = PagedCrossReferences_RelatedNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[23]
+		mi := &file_kythe_proto_serving_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1901,7 +2107,7 @@ func (x *PagedCrossReferences_RelatedNode) String() string {
 func (*PagedCrossReferences_RelatedNode) ProtoMessage() {}
 
 func (x *PagedCrossReferences_RelatedNode) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[23]
+	mi := &file_kythe_proto_serving_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,7 +2151,7 @@ type PagedCrossReferences_Caller struct {
 func (x *PagedCrossReferences_Caller) Reset() {
 	*x = PagedCrossReferences_Caller{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[24]
+		mi := &file_kythe_proto_serving_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1958,7 +2164,7 @@ func (x *PagedCrossReferences_Caller) String() string {
 func (*PagedCrossReferences_Caller) ProtoMessage() {}
 
 func (x *PagedCrossReferences_Caller) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[24]
+	mi := &file_kythe_proto_serving_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,7 +2224,7 @@ type PagedCrossReferences_Group struct {
 func (x *PagedCrossReferences_Group) Reset() {
 	*x = PagedCrossReferences_Group{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[25]
+		mi := &file_kythe_proto_serving_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2031,7 +2237,7 @@ func (x *PagedCrossReferences_Group) String() string {
 func (*PagedCrossReferences_Group) ProtoMessage() {}
 
 func (x *PagedCrossReferences_Group) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[25]
+	mi := &file_kythe_proto_serving_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2102,7 +2308,7 @@ type PagedCrossReferences_Page struct {
 func (x *PagedCrossReferences_Page) Reset() {
 	*x = PagedCrossReferences_Page{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[26]
+		mi := &file_kythe_proto_serving_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2115,7 +2321,7 @@ func (x *PagedCrossReferences_Page) String() string {
 func (*PagedCrossReferences_Page) ProtoMessage() {}
 
 func (x *PagedCrossReferences_Page) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[26]
+	mi := &file_kythe_proto_serving_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2166,7 +2372,7 @@ type PagedCrossReferences_PageIndex struct {
 func (x *PagedCrossReferences_PageIndex) Reset() {
 	*x = PagedCrossReferences_PageIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[27]
+		mi := &file_kythe_proto_serving_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2179,7 +2385,7 @@ func (x *PagedCrossReferences_PageIndex) String() string {
 func (*PagedCrossReferences_PageIndex) ProtoMessage() {}
 
 func (x *PagedCrossReferences_PageIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[27]
+	mi := &file_kythe_proto_serving_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +2442,7 @@ type IdentifierMatch_Node struct {
 func (x *IdentifierMatch_Node) Reset() {
 	*x = IdentifierMatch_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kythe_proto_serving_proto_msgTypes[28]
+		mi := &file_kythe_proto_serving_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2249,7 +2455,7 @@ func (x *IdentifierMatch_Node) String() string {
 func (*IdentifierMatch_Node) ProtoMessage() {}
 
 func (x *IdentifierMatch_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_kythe_proto_serving_proto_msgTypes[28]
+	mi := &file_kythe_proto_serving_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,6 +2492,132 @@ func (x *IdentifierMatch_Node) GetNodeSubkind() string {
 	return ""
 }
 
+type FunctionParameters_Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket       string                        `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Kind         string                        `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Subkind      string                        `protobuf:"bytes,3,opt,name=subkind,proto3" json:"subkind,omitempty"`
+	MarkedSource *common_go_proto.MarkedSource `protobuf:"bytes,4,opt,name=marked_source,json=markedSource,proto3" json:"marked_source,omitempty"`
+}
+
+func (x *FunctionParameters_Node) Reset() {
+	*x = FunctionParameters_Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kythe_proto_serving_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionParameters_Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionParameters_Node) ProtoMessage() {}
+
+func (x *FunctionParameters_Node) ProtoReflect() protoreflect.Message {
+	mi := &file_kythe_proto_serving_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionParameters_Node.ProtoReflect.Descriptor instead.
+func (*FunctionParameters_Node) Descriptor() ([]byte, []int) {
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *FunctionParameters_Node) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *FunctionParameters_Node) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FunctionParameters_Node) GetSubkind() string {
+	if x != nil {
+		return x.Subkind
+	}
+	return ""
+}
+
+func (x *FunctionParameters_Node) GetMarkedSource() *common_go_proto.MarkedSource {
+	if x != nil {
+		return x.MarkedSource
+	}
+	return nil
+}
+
+type FunctionParameters_Parameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ordinal int32                    `protobuf:"varint,1,opt,name=ordinal,proto3" json:"ordinal,omitempty"`
+	Node    *FunctionParameters_Node `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *FunctionParameters_Parameter) Reset() {
+	*x = FunctionParameters_Parameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kythe_proto_serving_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FunctionParameters_Parameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FunctionParameters_Parameter) ProtoMessage() {}
+
+func (x *FunctionParameters_Parameter) ProtoReflect() protoreflect.Message {
+	mi := &file_kythe_proto_serving_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FunctionParameters_Parameter.ProtoReflect.Descriptor instead.
+func (*FunctionParameters_Parameter) Descriptor() ([]byte, []int) {
+	return file_kythe_proto_serving_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *FunctionParameters_Parameter) GetOrdinal() int32 {
+	if x != nil {
+		return x.Ordinal
+	}
+	return 0
+}
+
+func (x *FunctionParameters_Parameter) GetNode() *FunctionParameters_Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
 var File_kythe_proto_serving_proto protoreflect.FileDescriptor
 
 var file_kythe_proto_serving_proto_rawDesc = []byte{
@@ -2627,28 +2959,69 @@ var file_kythe_proto_serving_proto_rawDesc = []byte{
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12,
 	0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x6b, 0x69, 0x6e, 0x64, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x75, 0x62, 0x6b, 0x69,
-	0x6e, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x6e, 0x64, 0x22, 0x3b, 0x0a, 0x12, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x50, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x8e, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x45, 0x4e, 0x54, 0x53,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x52, 0x45, 0x4e, 0x10, 0x02,
+	0x22, 0x8b, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x2b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x45, 0x10, 0x02, 0x22, 0x99,
+	0x01, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x52,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x52, 0x45,
-	0x4e, 0x54, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x52, 0x45,
-	0x4e, 0x10, 0x02, 0x22, 0x8b, 0x01, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6b, 0x79, 0x74, 0x68,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e,
-	0x43, 0x61, 0x6c, 0x6c, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4c,
-	0x4c, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4c, 0x4c, 0x45, 0x45, 0x10,
-	0x02, 0x42, 0x33, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x6f,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x53, 0x55, 0x50, 0x45, 0x52, 0x54, 0x59, 0x50, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x55, 0x42, 0x54, 0x59, 0x50, 0x45, 0x53, 0x10, 0x02, 0x22, 0xb5, 0x03, 0x0a, 0x12, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x4f, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x93, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x79, 0x74, 0x68,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x1a, 0x67, 0x0a, 0x09, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x12, 0x40, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x42, 0x33, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x64, 0x65, 0x76, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x2e, 0x6b, 0x79, 0x74, 0x68, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x67,
+	0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2663,103 +3036,114 @@ func file_kythe_proto_serving_proto_rawDescGZIP() []byte {
 	return file_kythe_proto_serving_proto_rawDescData
 }
 
-var file_kythe_proto_serving_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_kythe_proto_serving_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_kythe_proto_serving_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_kythe_proto_serving_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_kythe_proto_serving_proto_goTypes = []interface{}{
 	(FileDirectory_Kind)(0),                  // 0: kythe.proto.serving.FileDirectory.Kind
 	(FileDecorations_Override_Kind)(0),       // 1: kythe.proto.serving.FileDecorations.Override.Kind
 	(Relatives_Type)(0),                      // 2: kythe.proto.serving.Relatives.Type
 	(Callgraph_Type)(0),                      // 3: kythe.proto.serving.Callgraph.Type
-	(*Node)(nil),                             // 4: kythe.proto.serving.Node
-	(*Edge)(nil),                             // 5: kythe.proto.serving.Edge
-	(*EdgeGroup)(nil),                        // 6: kythe.proto.serving.EdgeGroup
-	(*PagedEdgeSet)(nil),                     // 7: kythe.proto.serving.PagedEdgeSet
-	(*PageIndex)(nil),                        // 8: kythe.proto.serving.PageIndex
-	(*EdgePage)(nil),                         // 9: kythe.proto.serving.EdgePage
-	(*FileDirectory)(nil),                    // 10: kythe.proto.serving.FileDirectory
-	(*CorpusRoots)(nil),                      // 11: kythe.proto.serving.CorpusRoots
-	(*File)(nil),                             // 12: kythe.proto.serving.File
-	(*RawAnchor)(nil),                        // 13: kythe.proto.serving.RawAnchor
-	(*ExpandedAnchor)(nil),                   // 14: kythe.proto.serving.ExpandedAnchor
-	(*FileInfo)(nil),                         // 15: kythe.proto.serving.FileInfo
-	(*FileDecorations)(nil),                  // 16: kythe.proto.serving.FileDecorations
-	(*PagedCrossReferences)(nil),             // 17: kythe.proto.serving.PagedCrossReferences
-	(*Document)(nil),                         // 18: kythe.proto.serving.Document
-	(*IdentifierMatch)(nil),                  // 19: kythe.proto.serving.IdentifierMatch
-	(*Relatives)(nil),                        // 20: kythe.proto.serving.Relatives
-	(*Callgraph)(nil),                        // 21: kythe.proto.serving.Callgraph
-	(*EdgeGroup_Edge)(nil),                   // 22: kythe.proto.serving.EdgeGroup.Edge
-	(*FileDirectory_Entry)(nil),              // 23: kythe.proto.serving.FileDirectory.Entry
-	(*CorpusRoots_Corpus)(nil),               // 24: kythe.proto.serving.CorpusRoots.Corpus
-	(*FileDecorations_Decoration)(nil),       // 25: kythe.proto.serving.FileDecorations.Decoration
-	(*FileDecorations_Override)(nil),         // 26: kythe.proto.serving.FileDecorations.Override
-	(*PagedCrossReferences_RelatedNode)(nil), // 27: kythe.proto.serving.PagedCrossReferences.RelatedNode
-	(*PagedCrossReferences_Caller)(nil),      // 28: kythe.proto.serving.PagedCrossReferences.Caller
-	(*PagedCrossReferences_Group)(nil),       // 29: kythe.proto.serving.PagedCrossReferences.Group
-	(*PagedCrossReferences_Page)(nil),        // 30: kythe.proto.serving.PagedCrossReferences.Page
-	(*PagedCrossReferences_PageIndex)(nil),   // 31: kythe.proto.serving.PagedCrossReferences.PageIndex
-	(*IdentifierMatch_Node)(nil),             // 32: kythe.proto.serving.IdentifierMatch.Node
-	(*common_go_proto.Fact)(nil),             // 33: kythe.proto.common.Fact
-	(*common_go_proto.Span)(nil),             // 34: kythe.proto.common.Span
-	(*common_go_proto.CorpusPath)(nil),       // 35: kythe.proto.common.CorpusPath
-	(*common_go_proto.Diagnostic)(nil),       // 36: kythe.proto.common.Diagnostic
-	(*common_go_proto.MarkedSource)(nil),     // 37: kythe.proto.common.MarkedSource
-	(*common_go_proto.Link)(nil),             // 38: kythe.proto.common.Link
+	(TypeRelatives_Type)(0),                  // 4: kythe.proto.serving.TypeRelatives.Type
+	(*Node)(nil),                             // 5: kythe.proto.serving.Node
+	(*Edge)(nil),                             // 6: kythe.proto.serving.Edge
+	(*EdgeGroup)(nil),                        // 7: kythe.proto.serving.EdgeGroup
+	(*PagedEdgeSet)(nil),                     // 8: kythe.proto.serving.PagedEdgeSet
+	(*PageIndex)(nil),                        // 9: kythe.proto.serving.PageIndex
+	(*EdgePage)(nil),                         // 10: kythe.proto.serving.EdgePage
+	(*FileDirectory)(nil),                    // 11: kythe.proto.serving.FileDirectory
+	(*CorpusRoots)(nil),                      // 12: kythe.proto.serving.CorpusRoots
+	(*File)(nil),                             // 13: kythe.proto.serving.File
+	(*RawAnchor)(nil),                        // 14: kythe.proto.serving.RawAnchor
+	(*ExpandedAnchor)(nil),                   // 15: kythe.proto.serving.ExpandedAnchor
+	(*FileInfo)(nil),                         // 16: kythe.proto.serving.FileInfo
+	(*FileDecorations)(nil),                  // 17: kythe.proto.serving.FileDecorations
+	(*PagedCrossReferences)(nil),             // 18: kythe.proto.serving.PagedCrossReferences
+	(*Document)(nil),                         // 19: kythe.proto.serving.Document
+	(*IdentifierMatch)(nil),                  // 20: kythe.proto.serving.IdentifierMatch
+	(*IdentifierPostings)(nil),               // 21: kythe.proto.serving.IdentifierPostings
+	(*Relatives)(nil),                        // 22: kythe.proto.serving.Relatives
+	(*Callgraph)(nil),                        // 23: kythe.proto.serving.Callgraph
+	(*TypeRelatives)(nil),                    // 24: kythe.proto.serving.TypeRelatives
+	(*FunctionParameters)(nil),               // 25: kythe.proto.serving.FunctionParameters
+	(*EdgeGroup_Edge)(nil),                   // 26: kythe.proto.serving.EdgeGroup.Edge
+	(*FileDirectory_Entry)(nil),              // 27: kythe.proto.serving.FileDirectory.Entry
+	(*CorpusRoots_Corpus)(nil),               // 28: kythe.proto.serving.CorpusRoots.Corpus
+	(*FileDecorations_Decoration)(nil),       // 29: kythe.proto.serving.FileDecorations.Decoration
+	(*FileDecorations_Override)(nil),         // 30: kythe.proto.serving.FileDecorations.Override
+	(*PagedCrossReferences_RelatedNode)(nil), // 31: kythe.proto.serving.PagedCrossReferences.RelatedNode
+	(*PagedCrossReferences_Caller)(nil),      // 32: kythe.proto.serving.PagedCrossReferences.Caller
+	(*PagedCrossReferences_Group)(nil),       // 33: kythe.proto.serving.PagedCrossReferences.Group
+	(*PagedCrossReferences_Page)(nil),        // 34: kythe.proto.serving.PagedCrossReferences.Page
+	(*PagedCrossReferences_PageIndex)(nil),   // 35: kythe.proto.serving.PagedCrossReferences.PageIndex
+	(*IdentifierMatch_Node)(nil),             // 36: kythe.proto.serving.IdentifierMatch.Node
+	(*FunctionParameters_Node)(nil),          // 37: kythe.proto.serving.FunctionParameters.Node
+	(*FunctionParameters_Parameter)(nil),     // 38: kythe.proto.serving.FunctionParameters.Parameter
+	(*common_go_proto.Fact)(nil),             // 39: kythe.proto.common.Fact
+	(*common_go_proto.Span)(nil),             // 40: kythe.proto.common.Span
+	(*common_go_proto.CorpusPath)(nil),       // 41: kythe.proto.common.CorpusPath
+	(*common_go_proto.Diagnostic)(nil),       // 42: kythe.proto.common.Diagnostic
+	(*common_go_proto.MarkedSource)(nil),     // 43: kythe.proto.common.MarkedSource
+	(*common_go_proto.Link)(nil),             // 44: kythe.proto.common.Link
 }
 var file_kythe_proto_serving_proto_depIdxs = []int32{
-	33, // 0: kythe.proto.serving.Node.fact:type_name -> kythe.proto.common.Fact
-	14, // 1: kythe.proto.serving.Node.definition_location:type_name -> kythe.proto.serving.ExpandedAnchor
-	4,  // 2: kythe.proto.serving.Edge.source:type_name -> kythe.proto.serving.Node
-	4,  // 3: kythe.proto.serving.Edge.target:type_name -> kythe.proto.serving.Node
-	33, // 4: kythe.proto.serving.Edge.fact:type_name -> kythe.proto.common.Fact
-	22, // 5: kythe.proto.serving.EdgeGroup.edge:type_name -> kythe.proto.serving.EdgeGroup.Edge
-	4,  // 6: kythe.proto.serving.PagedEdgeSet.source:type_name -> kythe.proto.serving.Node
-	6,  // 7: kythe.proto.serving.PagedEdgeSet.group:type_name -> kythe.proto.serving.EdgeGroup
-	8,  // 8: kythe.proto.serving.PagedEdgeSet.page_index:type_name -> kythe.proto.serving.PageIndex
-	6,  // 9: kythe.proto.serving.EdgePage.edges_group:type_name -> kythe.proto.serving.EdgeGroup
-	23, // 10: kythe.proto.serving.FileDirectory.entry:type_name -> kythe.proto.serving.FileDirectory.Entry
-	24, // 11: kythe.proto.serving.CorpusRoots.corpus:type_name -> kythe.proto.serving.CorpusRoots.Corpus
-	15, // 12: kythe.proto.serving.File.info:type_name -> kythe.proto.serving.FileInfo
-	34, // 13: kythe.proto.serving.ExpandedAnchor.span:type_name -> kythe.proto.common.Span
-	34, // 14: kythe.proto.serving.ExpandedAnchor.snippet_span:type_name -> kythe.proto.common.Span
-	15, // 15: kythe.proto.serving.ExpandedAnchor.file_info:type_name -> kythe.proto.serving.FileInfo
-	35, // 16: kythe.proto.serving.FileInfo.corpus_path:type_name -> kythe.proto.common.CorpusPath
-	12, // 17: kythe.proto.serving.FileDecorations.file:type_name -> kythe.proto.serving.File
-	25, // 18: kythe.proto.serving.FileDecorations.decoration:type_name -> kythe.proto.serving.FileDecorations.Decoration
-	4,  // 19: kythe.proto.serving.FileDecorations.target:type_name -> kythe.proto.serving.Node
-	14, // 20: kythe.proto.serving.FileDecorations.target_definitions:type_name -> kythe.proto.serving.ExpandedAnchor
-	26, // 21: kythe.proto.serving.FileDecorations.target_override:type_name -> kythe.proto.serving.FileDecorations.Override
-	36, // 22: kythe.proto.serving.FileDecorations.diagnostic:type_name -> kythe.proto.common.Diagnostic
-	15, // 23: kythe.proto.serving.FileDecorations.file_info:type_name -> kythe.proto.serving.FileInfo
-	4,  // 24: kythe.proto.serving.PagedCrossReferences.source_node:type_name -> kythe.proto.serving.Node
-	29, // 25: kythe.proto.serving.PagedCrossReferences.group:type_name -> kythe.proto.serving.PagedCrossReferences.Group
-	31, // 26: kythe.proto.serving.PagedCrossReferences.page_index:type_name -> kythe.proto.serving.PagedCrossReferences.PageIndex
-	37, // 27: kythe.proto.serving.PagedCrossReferences.marked_source:type_name -> kythe.proto.common.MarkedSource
-	37, // 28: kythe.proto.serving.Document.marked_source:type_name -> kythe.proto.common.MarkedSource
-	38, // 29: kythe.proto.serving.Document.link:type_name -> kythe.proto.common.Link
-	4,  // 30: kythe.proto.serving.Document.node:type_name -> kythe.proto.serving.Node
-	32, // 31: kythe.proto.serving.IdentifierMatch.node:type_name -> kythe.proto.serving.IdentifierMatch.Node
+	39, // 0: kythe.proto.serving.Node.fact:type_name -> kythe.proto.common.Fact
+	15, // 1: kythe.proto.serving.Node.definition_location:type_name -> kythe.proto.serving.ExpandedAnchor
+	5,  // 2: kythe.proto.serving.Edge.source:type_name -> kythe.proto.serving.Node
+	5,  // 3: kythe.proto.serving.Edge.target:type_name -> kythe.proto.serving.Node
+	39, // 4: kythe.proto.serving.Edge.fact:type_name -> kythe.proto.common.Fact
+	26, // 5: kythe.proto.serving.EdgeGroup.edge:type_name -> kythe.proto.serving.EdgeGroup.Edge
+	5,  // 6: kythe.proto.serving.PagedEdgeSet.source:type_name -> kythe.proto.serving.Node
+	7,  // 7: kythe.proto.serving.PagedEdgeSet.group:type_name -> kythe.proto.serving.EdgeGroup
+	9,  // 8: kythe.proto.serving.PagedEdgeSet.page_index:type_name -> kythe.proto.serving.PageIndex
+	7,  // 9: kythe.proto.serving.EdgePage.edges_group:type_name -> kythe.proto.serving.EdgeGroup
+	27, // 10: kythe.proto.serving.FileDirectory.entry:type_name -> kythe.proto.serving.FileDirectory.Entry
+	28, // 11: kythe.proto.serving.CorpusRoots.corpus:type_name -> kythe.proto.serving.CorpusRoots.Corpus
+	16, // 12: kythe.proto.serving.File.info:type_name -> kythe.proto.serving.FileInfo
+	40, // 13: kythe.proto.serving.ExpandedAnchor.span:type_name -> kythe.proto.common.Span
+	40, // 14: kythe.proto.serving.ExpandedAnchor.snippet_span:type_name -> kythe.proto.common.Span
+	16, // 15: kythe.proto.serving.ExpandedAnchor.file_info:type_name -> kythe.proto.serving.FileInfo
+	41, // 16: kythe.proto.serving.FileInfo.corpus_path:type_name -> kythe.proto.common.CorpusPath
+	13, // 17: kythe.proto.serving.FileDecorations.file:type_name -> kythe.proto.serving.File
+	29, // 18: kythe.proto.serving.FileDecorations.decoration:type_name -> kythe.proto.serving.FileDecorations.Decoration
+	5,  // 19: kythe.proto.serving.FileDecorations.target:type_name -> kythe.proto.serving.Node
+	15, // 20: kythe.proto.serving.FileDecorations.target_definitions:type_name -> kythe.proto.serving.ExpandedAnchor
+	30, // 21: kythe.proto.serving.FileDecorations.target_override:type_name -> kythe.proto.serving.FileDecorations.Override
+	42, // 22: kythe.proto.serving.FileDecorations.diagnostic:type_name -> kythe.proto.common.Diagnostic
+	16, // 23: kythe.proto.serving.FileDecorations.file_info:type_name -> kythe.proto.serving.FileInfo
+	5,  // 24: kythe.proto.serving.PagedCrossReferences.source_node:type_name -> kythe.proto.serving.Node
+	33, // 25: kythe.proto.serving.PagedCrossReferences.group:type_name -> kythe.proto.serving.PagedCrossReferences.Group
+	35, // 26: kythe.proto.serving.PagedCrossReferences.page_index:type_name -> kythe.proto.serving.PagedCrossReferences.PageIndex
+	43, // 27: kythe.proto.serving.PagedCrossReferences.marked_source:type_name -> kythe.proto.common.MarkedSource
+	43, // 28: kythe.proto.serving.Document.marked_source:type_name -> kythe.proto.common.MarkedSource
+	44, // 29: kythe.proto.serving.Document.link:type_name -> kythe.proto.common.Link
+	5,  // 30: kythe.proto.serving.Document.node:type_name -> kythe.proto.serving.Node
+	36, // 31: kythe.proto.serving.IdentifierMatch.node:type_name -> kythe.proto.serving.IdentifierMatch.Node
 	2,  // 32: kythe.proto.serving.Relatives.type:type_name -> kythe.proto.serving.Relatives.Type
 	3,  // 33: kythe.proto.serving.Callgraph.type:type_name -> kythe.proto.serving.Callgraph.Type
-	4,  // 34: kythe.proto.serving.EdgeGroup.Edge.target:type_name -> kythe.proto.serving.Node
-	0,  // 35: kythe.proto.serving.FileDirectory.Entry.kind:type_name -> kythe.proto.serving.FileDirectory.Kind
-	13, // 36: kythe.proto.serving.FileDecorations.Decoration.anchor:type_name -> kythe.proto.serving.RawAnchor
-	1,  // 37: kythe.proto.serving.FileDecorations.Override.kind:type_name -> kythe.proto.serving.FileDecorations.Override.Kind
-	37, // 38: kythe.proto.serving.FileDecorations.Override.marked_source:type_name -> kythe.proto.common.MarkedSource
-	4,  // 39: kythe.proto.serving.PagedCrossReferences.RelatedNode.node:type_name -> kythe.proto.serving.Node
-	14, // 40: kythe.proto.serving.PagedCrossReferences.Caller.caller:type_name -> kythe.proto.serving.ExpandedAnchor
-	37, // 41: kythe.proto.serving.PagedCrossReferences.Caller.marked_source:type_name -> kythe.proto.common.MarkedSource
-	14, // 42: kythe.proto.serving.PagedCrossReferences.Caller.callsite:type_name -> kythe.proto.serving.ExpandedAnchor
-	14, // 43: kythe.proto.serving.PagedCrossReferences.Group.anchor:type_name -> kythe.proto.serving.ExpandedAnchor
-	27, // 44: kythe.proto.serving.PagedCrossReferences.Group.related_node:type_name -> kythe.proto.serving.PagedCrossReferences.RelatedNode
-	28, // 45: kythe.proto.serving.PagedCrossReferences.Group.caller:type_name -> kythe.proto.serving.PagedCrossReferences.Caller
-	15, // 46: kythe.proto.serving.PagedCrossReferences.Group.file_info:type_name -> kythe.proto.serving.FileInfo
-	29, // 47: kythe.proto.serving.PagedCrossReferences.Page.group:type_name -> kythe.proto.serving.PagedCrossReferences.Group
-	48, // [48:48] is the sub-list for method output_type
-	48, // [48:48] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	4,  // 34: kythe.proto.serving.TypeRelatives.type:type_name -> kythe.proto.serving.TypeRelatives.Type
+	38, // 35: kythe.proto.serving.FunctionParameters.parameter:type_name -> kythe.proto.serving.FunctionParameters.Parameter
+	37, // 36: kythe.proto.serving.FunctionParameters.return_value:type_name -> kythe.proto.serving.FunctionParameters.Node
+	5,  // 37: kythe.proto.serving.EdgeGroup.Edge.target:type_name -> kythe.proto.serving.Node
+	0,  // 38: kythe.proto.serving.FileDirectory.Entry.kind:type_name -> kythe.proto.serving.FileDirectory.Kind
+	14, // 39: kythe.proto.serving.FileDecorations.Decoration.anchor:type_name -> kythe.proto.serving.RawAnchor
+	1,  // 40: kythe.proto.serving.FileDecorations.Override.kind:type_name -> kythe.proto.serving.FileDecorations.Override.Kind
+	43, // 41: kythe.proto.serving.FileDecorations.Override.marked_source:type_name -> kythe.proto.common.MarkedSource
+	5,  // 42: kythe.proto.serving.PagedCrossReferences.RelatedNode.node:type_name -> kythe.proto.serving.Node
+	15, // 43: kythe.proto.serving.PagedCrossReferences.Caller.caller:type_name -> kythe.proto.serving.ExpandedAnchor
+	43, // 44: kythe.proto.serving.PagedCrossReferences.Caller.marked_source:type_name -> kythe.proto.common.MarkedSource
+	15, // 45: kythe.proto.serving.PagedCrossReferences.Caller.callsite:type_name -> kythe.proto.serving.ExpandedAnchor
+	15, // 46: kythe.proto.serving.PagedCrossReferences.Group.anchor:type_name -> kythe.proto.serving.ExpandedAnchor
+	31, // 47: kythe.proto.serving.PagedCrossReferences.Group.related_node:type_name -> kythe.proto.serving.PagedCrossReferences.RelatedNode
+	32, // 48: kythe.proto.serving.PagedCrossReferences.Group.caller:type_name -> kythe.proto.serving.PagedCrossReferences.Caller
+	16, // 49: kythe.proto.serving.PagedCrossReferences.Group.file_info:type_name -> kythe.proto.serving.FileInfo
+	33, // 50: kythe.proto.serving.PagedCrossReferences.Page.group:type_name -> kythe.proto.serving.PagedCrossReferences.Group
+	43, // 51: kythe.proto.serving.FunctionParameters.Node.marked_source:type_name -> kythe.proto.common.MarkedSource
+	37, // 52: kythe.proto.serving.FunctionParameters.Parameter.node:type_name -> kythe.proto.serving.FunctionParameters.Node
+	53, // [53:53] is the sub-list for method output_type
+	53, // [53:53] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_kythe_proto_serving_proto_init() }
@@ -2961,7 +3345,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Relatives); i {
+			switch v := v.(*IdentifierPostings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2973,7 +3357,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Callgraph); i {
+			switch v := v.(*Relatives); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2985,7 +3369,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EdgeGroup_Edge); i {
+			switch v := v.(*Callgraph); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2997,7 +3381,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileDirectory_Entry); i {
+			switch v := v.(*TypeRelatives); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3009,7 +3393,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CorpusRoots_Corpus); i {
+			switch v := v.(*FunctionParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3021,7 +3405,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileDecorations_Decoration); i {
+			switch v := v.(*EdgeGroup_Edge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3033,7 +3417,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileDecorations_Override); i {
+			switch v := v.(*FileDirectory_Entry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3045,7 +3429,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedCrossReferences_RelatedNode); i {
+			switch v := v.(*CorpusRoots_Corpus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3057,7 +3441,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedCrossReferences_Caller); i {
+			switch v := v.(*FileDecorations_Decoration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3069,7 +3453,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedCrossReferences_Group); i {
+			switch v := v.(*FileDecorations_Override); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3081,7 +3465,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedCrossReferences_Page); i {
+			switch v := v.(*PagedCrossReferences_RelatedNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3093,7 +3477,7 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PagedCrossReferences_PageIndex); i {
+			switch v := v.(*PagedCrossReferences_Caller); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3105,6 +3489,42 @@ func file_kythe_proto_serving_proto_init() {
 			}
 		}
 		file_kythe_proto_serving_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedCrossReferences_Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kythe_proto_serving_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedCrossReferences_Page); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kythe_proto_serving_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PagedCrossReferences_PageIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kythe_proto_serving_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IdentifierMatch_Node); i {
 			case 0:
 				return &v.state
@@ -3116,14 +3536,38 @@ func file_kythe_proto_serving_proto_init() {
 				return nil
 			}
 		}
+		file_kythe_proto_serving_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionParameters_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kythe_proto_serving_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionParameters_Parameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kythe_proto_serving_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   29,
+			NumEnums:      5,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},